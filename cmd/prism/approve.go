@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/pkg/prism"
+	"github.com/spf13/cobra"
+)
+
+var approveCmd = &cobra.Command{
+	Use:   "approve [version]",
+	Short: "Lock a version as the approved structure",
+	Long: `Copy a structure version to approved.json and lock it.
+
+The approved copy gets locked: true, locked_at, approved_by and a freshly
+computed checksum, so 'prism validate' can detect later edits. Structures
+that fail validation cannot be approved. Like show and list, the project
+comes from --project.
+
+Examples:
+  # Approve v2
+  prism approve v2 --by "Jane" --project ./my-dashboard
+
+  # Approve the latest version
+  prism approve --by "Jane" --project ./my-dashboard
+
+  # Get JSON output
+  prism approve v2 --by "Jane" --project ./my-dashboard --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runApprove,
+}
+
+func init() {
+	approveCmd.Flags().String("by", "", "Name of the approver (required)")
+}
+
+func runApprove(cmd *cobra.Command, args []string) error {
+	// Get flags
+	projectPath, _ := cmd.Parent().PersistentFlags().GetString("project")
+	versionFlag := "latest"
+	if len(args) > 0 {
+		versionFlag = args[0]
+	}
+
+	approvedBy, _ := cmd.Flags().GetString("by")
+	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
+
+	approvedBy = strings.TrimSpace(approvedBy)
+	if approvedBy == "" {
+		return commandError(outputJSON, "", fmt.Errorf("approver is required (use --by)"))
+	}
+	if versionFlag == "approved" {
+		return commandError(outputJSON, "", fmt.Errorf("choose a version to approve (e.g. v2), not 'approved'"))
+	}
+
+	// Find and validate the structure file
+	structurePath := filepath.Join(projectPath, "phase1-structure")
+	structureFile, err := findStructureFile(structurePath, versionFlag)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+
+	data, err := readStructureFile(structureFile)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to read %s: %w", structureFile, err))
+	}
+
+	structure, err := prism.ParseAndValidateStructure(data)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("cannot approve %s: %w", structureFile, err))
+	}
+
+	// Note the version being replaced, if any
+	outputFile := filepath.Join(structurePath, "approved.json")
+	replaces := ""
+	if existing, err := os.ReadFile(outputFile); err == nil {
+		if previous, err := types.ParseStructure(existing); err == nil {
+			replaces = previous.Version
+		}
+	}
+
+	// Lock the structure; the checksum is computed last so it covers every other field
+	lockedAt := time.Now().UTC()
+	structure.Locked = true
+	structure.LockedAt = &lockedAt
+	structure.ApprovedBy = approvedBy
+	structure.Checksum = types.ComputeChecksum(structure)
+
+	if err := writeStructure(outputFile, structure, data); err != nil {
+		return commandError(outputJSON, structureFile, err)
+	}
+
+	if outputJSON {
+		result := map[string]interface{}{
+			"status":      "success",
+			"command":     "approve",
+			"file":        structureFile,
+			"output":      outputFile,
+			"version":     structure.Version,
+			"approved_by": structure.ApprovedBy,
+			"locked_at":   lockedAt,
+			"checksum":    structure.Checksum,
+		}
+		if replaces != "" {
+			result["replaces"] = replaces
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	fmt.Printf("🔒 Approved %s\n", structureFile)
+	fmt.Printf("   Version: %s\n", structure.Version)
+	fmt.Printf("   Approved By: %s\n", structure.ApprovedBy)
+	fmt.Printf("   Locked At: %s\n", lockedAt.Format(time.RFC3339))
+	fmt.Printf("   Checksum: %s\n", structure.Checksum)
+	if replaces != "" {
+		fmt.Printf("   Replaces: previously approved %s\n", replaces)
+	}
+	fmt.Printf("   Output: %s\n", outputFile)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestApprove(t *testing.T) {
+	project := t.TempDir()
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	structure := `{
+		"version": "v2",
+		"phase": "structure",
+		"intent": {"purpose": "Sign in"},
+		"layout": {"type": "stack"},
+		"components": [{"id": "title", "type": "text", "content": "Sign in"}]
+	}`
+	if err := os.WriteFile(filepath.Join(structurePath, "v2.json"), []byte(structure), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The approver is required
+	stdout, code := runPrism(t, "approve", "v2", "--json", "--project", project)
+	if code == 0 || !strings.Contains(string(stdout), "approver is required") {
+		t.Errorf("Expected a missing-approver error, got %d: %s", code, stdout)
+	}
+	if _, err := os.Stat(filepath.Join(structurePath, "approved.json")); !os.IsNotExist(err) {
+		t.Error("Expected nothing written without an approver")
+	}
+
+	stdout, code = runPrism(t, "approve", "v2", "--by", "Jane", "--json", "--project", project)
+	if code != 0 {
+		t.Fatalf("Expected approve to succeed, got %d: %s", code, stdout)
+	}
+	var result struct {
+		Checksum string `json:"checksum"`
+	}
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("Failed to parse output: %v: %s", err, stdout)
+	}
+
+	data, err := os.ReadFile(filepath.Join(structurePath, "approved.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	approved, err := types.ParseStructure(data)
+	if err != nil {
+		t.Fatalf("Failed to parse approved.json: %v", err)
+	}
+	if !approved.Locked || approved.LockedAt == nil || approved.ApprovedBy != "Jane" {
+		t.Errorf("Expected a locked structure approved by Jane, got locked %v at %v by %q", approved.Locked, approved.LockedAt, approved.ApprovedBy)
+	}
+	if approved.Checksum == "" || approved.Checksum != result.Checksum || types.ComputeChecksum(approved) != approved.Checksum {
+		t.Errorf("Expected the written checksum %q to match the file, got %q", result.Checksum, approved.Checksum)
+	}
+}
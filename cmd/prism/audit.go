@@ -3,11 +3,14 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/johanbellander/prism/internal/types"
 	"github.com/johanbellander/prism/internal/validate"
+	"github.com/johanbellander/prism/pkg/prism"
 	"github.com/spf13/cobra"
 )
 
@@ -26,7 +29,7 @@ Phase 1 Validators (Structural):
   ✓ Choice Overload        - Hick's Law (max 7 nav items, 5 form fields)
 
 Phase 2 Validators (Visual Design):
-  ✓ Color Contrast         - WCAG AA (4.5:1 text, 3:1 large text/UI)
+  ✓ Color Contrast         - WCAG AA (4.5:1 text, 3:1 large text/UI), or AAA with --wcag AAA
   ✓ Typography Scale       - Consistent ratios, 8-10 sizes
   ✓ Spacing (8pt Grid)     - Multiples of 4 or 8 pixels
   ✓ Shadow & Elevation     - 3-4 elevation levels, appropriate usage
@@ -68,12 +71,28 @@ Examples:
   # Get JSON output for CI/CD pipeline
   prism audit ./my-dashboard --json
 
+  # Generate a markdown report to paste into a pull request
+  prism audit ./my-dashboard --format markdown > audit.md
+
+  # Write a JUnit XML report for Jenkins or GitLab test reports
+  prism audit ./my-dashboard --format junit --output audit.xml
+
   # Audit Phase 2 design (includes all Phase 1 + Phase 2 validators)
   prism audit ./my-dashboard --phase 2
 
   # Audit specific version
   prism audit ./my-dashboard --version v2
 
+  # Hold contrast to WCAG AAA (7:1 text, 4.5:1 large text)
+  prism audit ./my-dashboard --wcag AAA
+
+  # Explain the principle behind each issue and how to fix it
+  prism audit ./my-dashboard --explain
+
+  # Focus the audit on a subset of validators
+  prism audit ./my-dashboard --skip dark-mode,elevation
+  prism audit ./my-dashboard --only contrast,hierarchy
+
 For individual validators, use: prism validate ./my-dashboard --hierarchy
 For documentation, see: VALIDATION_RULES.md, TESTING_STRATEGY.md`,
 	Args: cobra.MaximumNArgs(1),
@@ -82,6 +101,14 @@ For documentation, see: VALIDATION_RULES.md, TESTING_STRATEGY.md`,
 
 func init() {
 	auditCmd.Flags().Int("phase", 1, "Phase to validate against (1 or 2)")
+	auditCmd.Flags().String("format", "console", "Report format: console, markdown or junit (ignored with --json)")
+	auditCmd.Flags().StringP("output", "o", "", "Write the markdown or junit report to this file instead of stdout")
+	auditCmd.Flags().StringSlice("only", nil, "Run only these validators (comma-separated, e.g. contrast,hierarchy)")
+	auditCmd.Flags().String("wcag", "AA", "WCAG contrast level to enforce: AA or AAA")
+	auditCmd.Flags().StringSlice("skip", nil, "Skip these validators (comma-separated, e.g. dark-mode,elevation)")
+	auditCmd.Flags().Bool("explain", false, "Explain the principle behind each issue category and how to fix it")
+	auditCmd.RegisterFlagCompletionFunc("only", completeAuditValidators)
+	auditCmd.RegisterFlagCompletionFunc("skip", completeAuditValidators)
 }
 
 func runAudit(cmd *cobra.Command, args []string) error {
@@ -92,165 +119,139 @@ func runAudit(cmd *cobra.Command, args []string) error {
 	}
 
 	phase, _ := cmd.Flags().GetInt("phase")
+	format, _ := cmd.Flags().GetString("format")
+	outputPath, _ := cmd.Flags().GetString("output")
+	only, _ := cmd.Flags().GetStringSlice("only")
+	skip, _ := cmd.Flags().GetStringSlice("skip")
+	wcagLevel, _ := cmd.Flags().GetString("wcag")
+	explain, _ := cmd.Flags().GetBool("explain")
 	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
 
+	if format != "console" && format != "markdown" && format != "junit" {
+		return fmt.Errorf("invalid format '%s' (must be console, markdown or junit)", format)
+	}
+	if outputPath != "" && format == "console" {
+		return fmt.Errorf("--output requires --format markdown or junit")
+	}
+
+	rules := projectConfig.AuditRules()
+	contrastRule, err := validate.ContrastRuleForLevel(wcagLevel)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+	rules.Contrast = contrastRule
+	if len(only) > 0 || len(skip) > 0 {
+		selected, err := validate.SelectAuditValidators(only, skip)
+		if err != nil {
+			return commandError(outputJSON, "", err)
+		}
+		rules.Validators = selected
+	}
+
 	// Only Phase 1 validation is currently supported
 	if phase != 1 {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Phase %d validation not yet implemented", phase),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("phase %d validation not yet implemented", phase)
+		return commandError(outputJSON, "", fmt.Errorf("phase %d validation not yet implemented", phase))
 	}
 
 	// Find the structure file
 	structurePath := filepath.Join(projectPath, "phase1-structure")
 	
 	var structureFile string
-	if _, err := os.Stat(filepath.Join(structurePath, "approved.json")); err == nil {
-		structureFile = filepath.Join(structurePath, "approved.json")
+	if _, err := os.Stat(structureFilePath(structurePath, "approved")); err == nil {
+		structureFile = structureFilePath(structurePath, "approved")
 	} else {
 		// Find latest version
-		files, err := filepath.Glob(filepath.Join(structurePath, "v*.json"))
-		if err != nil || len(files) == 0 {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  "No structure files found in " + structurePath,
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("no structure files found in %s", structurePath)
+		latest, err := resolveLatestVersion(structurePath)
+		if err != nil {
+			return commandError(outputJSON, "", err)
 		}
-		structureFile = files[len(files)-1]
+		structureFile = latest
 	}
 
 	// Load and parse the structure
-	data, err := os.ReadFile(structureFile)
+	data, err := readStructureFile(structureFile)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to read file: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read file: %w", err)
+		return commandError(outputJSON, "", fmt.Errorf("failed to read file: %w", err))
 	}
 
-	var structure types.Structure
-	if err := json.Unmarshal(data, &structure); err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to parse JSON: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to parse JSON: %w", err)
+	structure, err := types.ParseStructure(data)
+	if err != nil {
+		return commandError(outputJSON, "", err)
 	}
 
 	// Run all validations
-	hierarchyResult := validate.ValidateHierarchy(&structure, validate.DefaultHierarchyRule())
-	touchTargetsResult := validate.ValidateTouchTargets(&structure, validate.DefaultTouchTargetRule())
-	gestaltResult := validate.ValidateGestalt(&structure, validate.DefaultGestaltRule())
-	a11yResult := validate.ValidateAccessibility(&structure, validate.DefaultA11yRule())
-	choiceResult := validate.ValidateChoiceOverload(&structure, validate.DefaultChoiceRule())
-	contrastResult := validate.ValidateContrast(&structure, validate.DefaultContrastRule())
-	spacingResult := validate.ValidateSpacing(&structure, validate.DefaultSpacingRule())
-	typographyResult := validate.ValidateTypography(&structure, validate.DefaultTypographyRule())
-	elevationResult := validate.ValidateElevation(&structure, validate.DefaultElevationRule())
-	loadingStatesResult := validate.ValidateLoadingStates(&structure, validate.DefaultLoadingStateRule())
-	responsiveResult := validate.ValidateResponsive(&structure, validate.DefaultResponsiveRule())
-	focusResult := validate.ValidateFocus(&structure, validate.DefaultFocusRule())
-	darkModeResult := validate.ValidateDarkMode(&structure, validate.DefaultDarkModeRule())
-
-	// Calculate overall pass/fail
-	allPassed := hierarchyResult.Passed && touchTargetsResult.Passed && gestaltResult.Passed &&
-		a11yResult.Passed && choiceResult.Passed && contrastResult.Passed &&
-		spacingResult.Passed && typographyResult.Passed && elevationResult.Passed &&
-		loadingStatesResult.Passed && responsiveResult.Passed && focusResult.Passed &&
-		darkModeResult.Passed
+	report := prism.RunAudit(structure, rules)
+	allPassed := report.Passed
+
+	passedCount := 0
+	for _, entry := range report.Entries {
+		if entry.Passed {
+			passedCount++
+		}
+	}
 
 	if outputJSON {
+		audits := map[string]interface{}{}
+		for _, entry := range report.Entries {
+			audits[entry.Name] = map[string]interface{}{
+				"status": func() string { if entry.Passed { return "passed" } else { return "failed" } }(),
+				"score":  entry.Score,
+				"issues": entry.Issues,
+			}
+			if explain {
+				attachGuidance(audits, entry.Name, entry.Issues)
+			}
+		}
+
 		result := map[string]interface{}{
-			"file":       structureFile,
-			"version":    structure.Version,
-			"phase":      structure.Phase,
-			"status":     func() string { if allPassed { return "passed" } else { return "failed" } }(),
-			"components": len(structure.Components),
-			"audits": map[string]interface{}{
-				"hierarchy": map[string]interface{}{
-					"status": func() string { if hierarchyResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": hierarchyResult.Issues,
-				},
-				"touch_targets": map[string]interface{}{
-					"status": func() string { if touchTargetsResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": touchTargetsResult.Issues,
-				},
-				"gestalt": map[string]interface{}{
-					"status": func() string { if gestaltResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": gestaltResult.Issues,
-				},
-				"accessibility": map[string]interface{}{
-					"status": func() string { if a11yResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": a11yResult.Issues,
-				},
-				"choice_overload": map[string]interface{}{
-					"status": func() string { if choiceResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": choiceResult.Issues,
-				},
-				"contrast": map[string]interface{}{
-					"status": func() string { if contrastResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": contrastResult.Issues,
-				},
-				"spacing": map[string]interface{}{
-					"status": func() string { if spacingResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": spacingResult.Issues,
-				},
-				"typography": map[string]interface{}{
-					"status": func() string { if typographyResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": typographyResult.Issues,
-				},
-				"elevation": map[string]interface{}{
-					"status": func() string { if elevationResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": elevationResult.Issues,
-				},
-				"loading_states": map[string]interface{}{
-					"status": func() string { if loadingStatesResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": loadingStatesResult.Issues,
-				},
-				"responsive": map[string]interface{}{
-					"status": func() string { if responsiveResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": responsiveResult.Issues,
-				},
-				"focus": map[string]interface{}{
-					"status": func() string { if focusResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": focusResult.Issues,
-				},
-				"dark_mode": map[string]interface{}{
-					"status": func() string { if darkModeResult.Passed { return "passed" } else { return "failed" } }(),
-					"issues": darkModeResult.Issues,
-				},
+			"file":          structureFile,
+			"version":       structure.Version,
+			"phase":         structure.Phase,
+			"status":        func() string { if allPassed { return "passed" } else { return "failed" } }(),
+			"components":    len(structure.Components),
+			"overall_score": report.OverallScore,
+			"audits":        audits,
+			"summary": map[string]interface{}{
+				"total_validators": len(report.Entries),
+				"passed":           passedCount,
+				"failed":           len(report.Entries) - passedCount,
+				"skipped":          len(report.Skipped),
 			},
 		}
+		if len(report.Skipped) > 0 {
+			result["skipped"] = report.Skipped
+		}
 		
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(result)
 	}
 
+	if format == "markdown" || format == "junit" {
+		var w io.Writer = os.Stdout
+		if outputPath != "" {
+			f, err := os.Create(outputPath)
+			if err != nil {
+				return fmt.Errorf("failed to create report: %w", err)
+			}
+			defer f.Close()
+			w = f
+		}
+
+		if format == "junit" {
+			if err := prism.WriteJUnit(w, structureFile, report); err != nil {
+				return fmt.Errorf("failed to write JUnit report: %w", err)
+			}
+		} else {
+			writeAuditMarkdown(w, structureFile, structure, report)
+		}
+
+		if outputPath != "" {
+			fmt.Fprintf(os.Stderr, "Wrote %s report to %s\n", format, outputPath)
+		}
+		return nil
+	}
+
 	// Console output
 	fmt.Printf("🔍 Design Audit for %s\n", structureFile)
 	fmt.Printf("   Version: %s\n", structure.Version)
@@ -270,40 +271,34 @@ func runAudit(cmd *cobra.Command, args []string) error {
 	fmt.Println("\n═══════════════════════════════════════════════════════")
 	
 	// Print summary
-	printAuditCategory("Visual Hierarchy", hierarchyResult.Passed, len(hierarchyResult.Issues))
-	printAuditCategory("Touch Targets (Fitts's Law)", touchTargetsResult.Passed, len(touchTargetsResult.Issues))
-	printAuditCategory("Gestalt Principles", gestaltResult.Passed, len(gestaltResult.Issues))
-	printAuditCategory("Accessibility (WCAG)", a11yResult.Passed, len(a11yResult.Issues))
-	printAuditCategory("Choice Overload (Hick's Law)", choiceResult.Passed, len(choiceResult.Issues))
-	printAuditCategory("Color Contrast", contrastResult.Passed, len(contrastResult.Issues))
-	printAuditCategory("Spacing Scale (8pt Grid)", spacingResult.Passed, len(spacingResult.Issues))
-	printAuditCategory("Typography Scale", typographyResult.Passed, len(typographyResult.Issues))
-	printAuditCategory("Shadow & Elevation", elevationResult.Passed, len(elevationResult.Issues))
-	printAuditCategory("Loading States", loadingStatesResult.Passed, len(loadingStatesResult.Issues))
-	printAuditCategory("Responsive Breakpoints", responsiveResult.Passed, len(responsiveResult.Issues))
-	printAuditCategory("Focus Indicators", focusResult.Passed, len(focusResult.Issues))
-	printAuditCategory("Dark Mode Support", darkModeResult.Passed, len(darkModeResult.Issues))
+	for _, entry := range report.Entries {
+		printAuditCategory(entry.Title, entry.Passed, entry.IssueCount)
+		if explain {
+			printGuidance(entry.Issues, "")
+		}
+	}
 	
 	fmt.Println("═══════════════════════════════════════════════════════")
 	
+	fmt.Printf("   Validators: %d passed, %d failed", passedCount, len(report.Entries)-passedCount)
+	if len(report.Skipped) > 0 {
+		fmt.Printf(", %d skipped (%s)", len(report.Skipped), strings.Join(report.Skipped, ", "))
+	}
+	fmt.Println()
+	fmt.Printf("   Overall Score: %d/100\n", report.OverallScore)
+	
 	if allPassed {
 		fmt.Println("\n✅ Overall: PASSED - All design principles validated")
 	} else {
 		fmt.Println("\n⚠️  Overall: ISSUES FOUND - Review recommendations above")
 		fmt.Println("\nRun individual validations for detailed issue breakdown:")
-		fmt.Println("  prism validate --hierarchy")
-		fmt.Println("  prism validate --touch-targets")
-		fmt.Println("  prism validate --gestalt")
-		fmt.Println("  prism validate --accessibility")
-		fmt.Println("  prism validate --choice-overload")
-		fmt.Println("  prism validate --contrast")
-		fmt.Println("  prism validate --spacing")
-		fmt.Println("  prism validate --typography")
-		fmt.Println("  prism validate --elevation")
-		fmt.Println("  prism validate --loading-states")
-		fmt.Println("  prism validate --responsive")
-		fmt.Println("  prism validate --focus")
-		fmt.Println("  prism validate --dark-mode")
+		for _, entry := range report.Entries {
+			hint := "--" + strings.ReplaceAll(entry.Name, "_", "-")
+			if entry.Name == "contrast" && rules.Contrast.RequireWCAG_AAA {
+				hint = "--wcag AAA"
+			}
+			fmt.Printf("  prism validate %s\n", hint)
+		}
 	}
 	
 	return nil
@@ -318,3 +313,83 @@ func printAuditCategory(name string, passed bool, issueCount int) {
 	}
 	fmt.Printf("%s %-35s %s\n", status, name, statusText)
 }
+
+// writeAuditMarkdown writes the audit report as markdown suitable for a pull request description
+func writeAuditMarkdown(w io.Writer, structureFile string, structure *types.Structure, report prism.AuditReport) {
+	status := "✅ Passed"
+	if !report.Passed {
+		status = "⚠️ Issues found"
+	}
+
+	title := structure.Intent.Purpose
+	if title == "" {
+		title = filepath.Base(structureFile)
+	}
+
+	fmt.Fprintf(w, "# Design Audit: %s\n\n", markdownEscape(title))
+	fmt.Fprintf(w, "- **File:** `%s`\n", structureFile)
+	fmt.Fprintf(w, "- **Version:** %s\n", structure.Version)
+	fmt.Fprintf(w, "- **Phase:** %s\n", structure.Phase)
+	fmt.Fprintf(w, "- **Components:** %d\n", types.ComputeStats(structure).TotalComponents)
+	fmt.Fprintf(w, "- **Overall Score:** %d/100\n", report.OverallScore)
+	if len(report.Skipped) > 0 {
+		fmt.Fprintf(w, "- **Skipped:** %s\n", strings.Join(report.Skipped, ", "))
+	}
+	fmt.Fprintf(w, "- **Status:** %s\n\n", status)
+
+	fmt.Fprintln(w, "| Validator | Score | Status | Errors | Warnings | Info |")
+	fmt.Fprintln(w, "|-----------|------:|--------|-------:|---------:|-----:|")
+	for _, entry := range report.Entries {
+		entryStatus := "✅ Passed"
+		if !entry.Passed {
+			entryStatus = "⚠️ Failed"
+		}
+		fmt.Fprintf(w, "| %s | %d | %s | %d | %d | %d |\n",
+			markdownEscape(entry.Title), entry.Score, entryStatus, entry.Errors, entry.Warnings, entry.Infos)
+	}
+
+	for _, entry := range report.Entries {
+		issues := entry.IssueList()
+		if len(issues) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n<details>\n<summary><strong>%s</strong> (%d/100, %d issues)</summary>\n", entry.Title, entry.Score, len(issues))
+		for _, severity := range []string{"error", "warning", "info"} {
+			var lines []string
+			for _, issue := range issues {
+				if issue.Severity != severity {
+					continue
+				}
+				line := "- " + markdownEscape(issue.Message)
+				if issue.ComponentID != "" {
+					line += fmt.Sprintf(" (`%s`)", issue.ComponentID)
+				}
+				lines = append(lines, line)
+			}
+			if len(lines) == 0 {
+				continue
+			}
+			fmt.Fprintf(w, "\n**%s**\n\n%s\n", auditSeverityHeading(severity), strings.Join(lines, "\n"))
+		}
+		fmt.Fprintln(w, "\n</details>")
+	}
+}
+
+// auditSeverityHeading returns the markdown group heading for a severity
+func auditSeverityHeading(severity string) string {
+	switch severity {
+	case "error":
+		return "❌ Errors"
+	case "warning":
+		return "⚠️ Warnings"
+	default:
+		return "ℹ️ Info"
+	}
+}
+
+// markdownEscape keeps issue text from breaking tables and lists
+func markdownEscape(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.ReplaceAll(text, "\n", " ")
+}
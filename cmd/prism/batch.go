@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/pkg/prism"
+)
+
+// batchFailureMessages describes each batch stage in JSON error output
+var batchFailureMessages = map[string]string{
+	"read":   "Failed to read file",
+	"parse":  "Failed to parse structure",
+	"render": "Render failed",
+	"save":   "Failed to save file",
+}
+
+// batchRender renders a set of structure files from one directory to auto-named PNGs
+type batchRender struct {
+	structurePath string
+	outDir        string
+	projectName   string
+	opts          prism.RenderOptions
+	keepImages    bool // keep each image in memory after saving (for contact sheets)
+	progress      *batchProgress
+}
+
+// versionRender is the outcome of rendering one structure file in a batch
+type versionRender struct {
+	name    string // version name, e.g. "v3"
+	file    string
+	output  string
+	result  *render.RenderResult
+	metrics renderMetrics
+	stage   string // failing stage ("read", "parse", "render", "save") when err is set
+	err     error
+}
+
+// run renders files on up to jobs workers (GOMAXPROCS when jobs <= 0) and
+// returns the outcomes in the same order as files
+func (b batchRender) run(files []string, jobs int) []versionRender {
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	if jobs > len(files) {
+		jobs = len(files)
+	}
+
+	renders := make([]versionRender, len(files))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				renders[i] = b.renderFile(files[i])
+			}
+		}()
+	}
+	for i := range files {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return renders
+}
+
+// renderFile reads, parses, renders and saves a single structure file
+func (b batchRender) renderFile(jsonFile string) versionRender {
+	name, _ := structureName(jsonFile)
+	version := versionRender{
+		name: name,
+		file: filepath.Join(b.structurePath, jsonFile),
+	}
+	if b.progress != nil {
+		b.progress.step("rendering", version.name)
+	}
+
+	data, err := readStructureFile(version.file)
+	if err != nil {
+		version.stage, version.err = "read", err
+		return version
+	}
+
+	structure, err := prism.ParseAndValidateStructure(data)
+	if err != nil {
+		version.stage, version.err = "parse", err
+		return version
+	}
+
+	result, metrics, err := measureRender(structure, b.opts)
+	version.metrics = metrics
+	if err != nil {
+		version.stage, version.err = "render", err
+		return version
+	}
+
+	version.output = filepath.Join(b.outDir, fmt.Sprintf("%s-phase1-%s.png", b.projectName, version.name))
+	if err := result.SavePNG(version.output); err != nil {
+		version.stage, version.err = "save", err
+		return version
+	}
+
+	if !b.keepImages {
+		result.Image = nil // a long history would otherwise hold every image until the summary
+	}
+	version.result = result
+	return version
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/johanbellander/prism/pkg/prism"
+)
+
+func TestBatchRender_RendersAllVersionsInOrder(t *testing.T) {
+	dir := t.TempDir()
+	structurePath := filepath.Join(dir, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	files := []string{"v1.json", "v2.json", "v3.json", "v4.json", "v5.json", "broken.json"}
+	for i, name := range files {
+		content := fmt.Sprintf(`{
+  "version": "v%d",
+  "phase": "structure",
+  "created_at": "2025-10-25T12:30:00Z",
+  "intent": {"purpose": "Batch test", "primary_action": "Save"},
+  "layout": {"type": "stack", "direction": "vertical", "spacing": 16},
+  "components": [{"id": "title", "type": "text", "content": "Screen %d"}]
+}`, i+1, i+1)
+		if name == "broken.json" {
+			content = "{"
+		}
+		if err := os.WriteFile(filepath.Join(structurePath, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	batch := batchRender{
+		structurePath: structurePath,
+		outDir:        dir,
+		projectName:   "demo",
+		opts:          prism.RenderOptions{Width: 400},
+		progress:      newBatchProgress(nil, len(files)),
+	}
+	renders := batch.run(files, 3)
+
+	if len(renders) != len(files) {
+		t.Fatalf("Expected %d outcomes, got %d", len(files), len(renders))
+	}
+	for i, version := range renders[:5] {
+		if want := fmt.Sprintf("v%d", i+1); version.name != want {
+			t.Errorf("Outcome %d: expected %s, got %s", i, want, version.name)
+		}
+		if version.err != nil {
+			t.Errorf("Expected %s to render, got %s error: %v", version.name, version.stage, version.err)
+			continue
+		}
+		if _, err := os.Stat(version.output); err != nil {
+			t.Errorf("Expected %s to be saved: %v", version.output, err)
+		}
+		if version.metrics.components != 1 || version.metrics.pixels == 0 {
+			t.Errorf("Expected render metrics for %s, got %+v", version.name, version.metrics)
+		}
+		if version.result.Image != nil {
+			t.Errorf("Expected %s's image to be released without a contact sheet", version.name)
+		}
+	}
+	if broken := renders[5]; broken.stage != "parse" {
+		t.Errorf("Expected broken.json to fail parsing, got stage %q (%v)", broken.stage, broken.err)
+	}
+	if batch.progress.done != len(files) {
+		t.Errorf("Expected progress for all %d files, got %d", len(files), batch.progress.done)
+	}
+}
@@ -3,14 +3,11 @@ package main
 import (
 	"encoding/json"
 	"fmt"
-	"image"
-	"image/draw"
-	"image/png"
 	"os"
 	"path/filepath"
 
 	"github.com/johanbellander/prism/internal/render"
-	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/pkg/prism"
 	"github.com/spf13/cobra"
 )
 
@@ -19,13 +16,17 @@ var compareCmd = &cobra.Command{
 	Short: "Compare two versions side-by-side",
 	Long: `Compare two versions of a Phase 1 structure by rendering them side-by-side.
 
-This command renders two versions and places them next to each other in a single PNG
-for easy visual comparison of changes between versions.
+This command renders both versions at the same width and places them next to each
+other in a single PNG, labeled with their versions and separated by a divider. The
+shorter render is padded to the height of the taller one.
 
 Examples:
   prism compare ./my-dashboard --from v1 --to v2
   prism compare ./my-dashboard --from v1 --to v2 --json
-  prism compare ./my-dashboard --from v1 --to v2 --output comparison.png`,
+  prism compare ./my-dashboard --from v1 --to v2 --output comparison.png
+
+  # Also compare audit scores per validator
+  prism compare ./my-dashboard --from v1 --to v2 --audit`,
 	RunE: runCompare,
 }
 
@@ -33,12 +34,14 @@ var (
 	compareFrom   string
 	compareTo     string
 	compareOutput string
+	compareAudit  bool
 )
 
 func init() {
 	compareCmd.Flags().StringVar(&compareFrom, "from", "v1", "Source version to compare from")
 	compareCmd.Flags().StringVar(&compareTo, "to", "v2", "Target version to compare to")
 	compareCmd.Flags().StringVarP(&compareOutput, "output", "o", "", "Output file path (default: {project}-compare-{from}-{to}.png)")
+	compareCmd.Flags().BoolVar(&compareAudit, "audit", false, "Run the full audit on both versions and report per-validator score deltas")
 }
 
 func runCompare(cmd *cobra.Command, args []string) error {
@@ -59,8 +62,8 @@ func runCompare(cmd *cobra.Command, args []string) error {
 	projectName := filepath.Base(absProjectPath)
 
 	// Find structure files
-	fromFile := filepath.Join(absProjectPath, "phase1-structure", compareFrom+".json")
-	toFile := filepath.Join(absProjectPath, "phase1-structure", compareTo+".json")
+	fromFile := structureFilePath(filepath.Join(absProjectPath, "phase1-structure"), compareFrom)
+	toFile := structureFilePath(filepath.Join(absProjectPath, "phase1-structure"), compareTo)
 
 	// Check if files exist
 	if _, err := os.Stat(fromFile); os.IsNotExist(err) {
@@ -71,69 +74,47 @@ func runCompare(cmd *cobra.Command, args []string) error {
 	}
 
 	// Load both structures
-	fromData, err := os.ReadFile(fromFile)
+	fromData, err := readStructureFile(fromFile)
 	if err != nil {
 		return fmt.Errorf("failed to read %s: %w", compareFrom, err)
 	}
 
-	fromStructure, err := types.ParseAndValidateStructure(fromData)
+	fromStructure, err := prism.ParseAndValidateStructure(fromData)
 	if err != nil {
 		return fmt.Errorf("failed to parse %s: %w", compareFrom, err)
 	}
 
-	toData, err := os.ReadFile(toFile)
+	toData, err := readStructureFile(toFile)
 	if err != nil {
 		return fmt.Errorf("failed to read %s: %w", compareTo, err)
 	}
 
-	toStructure, err := types.ParseAndValidateStructure(toData)
+	toStructure, err := prism.ParseAndValidateStructure(toData)
 	if err != nil {
 		return fmt.Errorf("failed to parse %s: %w", compareTo, err)
 	}
 
-	// Render both versions
-	width := 1200
-	height := 800
-
-	opts := render.RenderOptions{
-		Width:    width,
-		Height:   height,
+	// Render both versions at the same width; each takes the height its content needs
+	opts := prism.RenderOptions{
+		Width:    1200,
 		Scale:    1,
 		Viewport: "desktop",
+		Log:      verboseLog(cmd),
 	}
-	renderer := render.NewRenderer(opts)
-
-	fromResult, err := renderer.Render(fromStructure)
+	
+	fromResult, err := prism.Render(fromStructure, opts)
 	if err != nil {
 		return fmt.Errorf("failed to render %s: %w", compareFrom, err)
 	}
 
-	toResult, err := renderer.Render(toStructure)
+	toResult, err := prism.Render(toStructure, opts)
 	if err != nil {
 		return fmt.Errorf("failed to render %s: %w", compareTo, err)
 	}
 
-	// Get images from results
-	fromImg := fromResult.Image
-	toImg := toResult.Image
-
 	// Create side-by-side comparison image
-	gap := 20 // pixels between images
-	compWidth := fromImg.Bounds().Dx() + gap + toImg.Bounds().Dx()
-	compHeight := fromImg.Bounds().Dy()
-	if toImg.Bounds().Dy() > compHeight {
-		compHeight = toImg.Bounds().Dy()
-	}
-
-	compImg := image.NewRGBA(image.Rect(0, 0, compWidth, compHeight))
-	
-	// Fill with white background
-	draw.Draw(compImg, compImg.Bounds(), image.White, image.Point{}, draw.Src)
-
-	// Draw both images
-	draw.Draw(compImg, fromImg.Bounds(), fromImg, image.Point{}, draw.Src)
-	toOffset := image.Pt(fromImg.Bounds().Dx()+gap, 0)
-	draw.Draw(compImg, toImg.Bounds().Add(toOffset), toImg, image.Point{}, draw.Src)
+	comparison := render.ComposeComparison(fromResult, toResult, compareFrom, compareTo, opts.Scale)
+	divider := comparison.Width - fromResult.Width - toResult.Width
 
 	// Determine output filename
 	outputFile := compareOutput
@@ -142,14 +123,17 @@ func runCompare(cmd *cobra.Command, args []string) error {
 	}
 
 	// Save comparison image
-	out, err := os.Create(outputFile)
-	if err != nil {
-		return fmt.Errorf("failed to create output file: %w", err)
+	if err := comparison.SavePNG(outputFile); err != nil {
+		return fmt.Errorf("failed to save comparison: %w", err)
 	}
-	defer out.Close()
 
-	if err := png.Encode(out, compImg); err != nil {
-		return fmt.Errorf("failed to encode PNG: %w", err)
+	// Compare audit scores if requested
+	var auditDeltas []auditDelta
+	var fromReport, toReport prism.AuditReport
+	if compareAudit {
+		fromReport = prism.RunAudit(fromStructure, projectConfig.AuditRules())
+		toReport = prism.RunAudit(toStructure, projectConfig.AuditRules())
+		auditDeltas = compareAuditReports(fromReport, toReport)
 	}
 
 	// Output result
@@ -164,26 +148,26 @@ func runCompare(cmd *cobra.Command, args []string) error {
 			"from": map[string]interface{}{
 				"version": compareFrom,
 				"file":    fromFile,
-				"width":   fromImg.Bounds().Dx(),
-				"height":  fromImg.Bounds().Dy(),
+				"width":   fromResult.Width,
+				"height":  fromResult.Height,
 			},
 			"to": map[string]interface{}{
 				"version": compareTo,
 				"file":    toFile,
-				"width":   toImg.Bounds().Dx(),
-				"height":  toImg.Bounds().Dy(),
+				"width":   toResult.Width,
+				"height":  toResult.Height,
 			},
 			"output": map[string]interface{}{
 				"file":   outputFile,
 				"format": "png",
 				"dimensions": map[string]interface{}{
-					"width":  compWidth,
-					"height": compHeight,
+					"width":  comparison.Width,
+					"height": comparison.Height,
 				},
 			},
 			"summary": map[string]interface{}{
 				"viewport":     "desktop",
-				"gap_pixels":   gap,
+				"gap_pixels":   divider,
 				"layout":       "side-by-side",
 				"from_purpose": fromStructure.Intent.Purpose,
 				"to_purpose":   toStructure.Intent.Purpose,
@@ -192,6 +176,14 @@ func runCompare(cmd *cobra.Command, args []string) error {
 				"same_phase":   fromStructure.Phase == toStructure.Phase,
 			},
 		}
+		if compareAudit {
+			result["audit"] = map[string]interface{}{
+				"from_score": fromReport.OverallScore,
+				"to_score":   toReport.OverallScore,
+				"delta":      toReport.OverallScore - fromReport.OverallScore,
+				"validators": auditDeltas,
+			}
+		}
 
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
@@ -199,13 +191,63 @@ func runCompare(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("✅ Compared %s vs %s\n", compareFrom, compareTo)
-	fmt.Printf("   From: %s (%dx%d)\n", compareFrom, fromImg.Bounds().Dx(), fromImg.Bounds().Dy())
-	fmt.Printf("   To: %s (%dx%d)\n", compareTo, toImg.Bounds().Dx(), toImg.Bounds().Dy())
-	fmt.Printf("   Output: %s (%dx%d)\n", outputFile, compWidth, compHeight)
-	fmt.Printf("   Layout: Side-by-side with %dpx gap\n", gap)
+	fmt.Printf("   From: %s (%dx%d)\n", compareFrom, fromResult.Width, fromResult.Height)
+	fmt.Printf("   To: %s (%dx%d)\n", compareTo, toResult.Width, toResult.Height)
+	fmt.Printf("   Output: %s (%dx%d)\n", outputFile, comparison.Width, comparison.Height)
+	fmt.Printf("   Layout: Side-by-side with a %dpx divider\n", divider)
 	if toStructure.ChangeSummary != "" {
 		fmt.Printf("   Changes: %s\n", toStructure.ChangeSummary)
 	}
 
+	if compareAudit {
+		fmt.Printf("\n📊 Audit Scores (%s → %s):\n", compareFrom, compareTo)
+		fmt.Printf("   %-30s %6s %6s %7s\n", "Validator", compareFrom, compareTo, "Delta")
+		for _, delta := range auditDeltas {
+			fmt.Printf("   %-30s %6d %6d %7s\n", delta.Title, delta.From, delta.To, formatScoreDelta(delta.Delta))
+		}
+		fmt.Printf("   %-30s %6d %6d %7s\n", "Overall", fromReport.OverallScore, toReport.OverallScore,
+			formatScoreDelta(toReport.OverallScore-fromReport.OverallScore))
+	}
+
 	return nil
 }
+
+// auditDelta is the score change of a single validator between two versions
+type auditDelta struct {
+	Name  string `json:"name"`
+	Title string `json:"-"`
+	From  int    `json:"from"`
+	To    int    `json:"to"`
+	Delta int    `json:"delta"`
+}
+
+// compareAuditReports pairs validator scores from two audits in report order
+func compareAuditReports(from, to prism.AuditReport) []auditDelta {
+	deltas := []auditDelta{}
+	for _, fromEntry := range from.Entries {
+		toEntry, ok := to.Entry(fromEntry.Name)
+		if !ok {
+			continue
+		}
+		deltas = append(deltas, auditDelta{
+			Name:  fromEntry.Name,
+			Title: fromEntry.Title,
+			From:  fromEntry.Score,
+			To:    toEntry.Score,
+			Delta: toEntry.Score - fromEntry.Score,
+		})
+	}
+	return deltas
+}
+
+// formatScoreDelta formats a score change with an explicit sign and direction marker
+func formatScoreDelta(delta int) string {
+	switch {
+	case delta > 0:
+		return fmt.Sprintf("▲ +%d", delta)
+	case delta < 0:
+		return fmt.Sprintf("▼ %d", delta)
+	default:
+		return "="
+	}
+}
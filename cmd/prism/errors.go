@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+)
+
+// reportedError is an error already written to stdout as a JSON error object. The
+// command still fails, but main must not print it a second time.
+type reportedError struct {
+	err error
+}
+
+func (e *reportedError) Error() string { return e.err.Error() }
+func (e *reportedError) Unwrap() error { return e.err }
+
+// commandError reports an error as a JSON error object when --json is set, otherwise returns it
+func commandError(outputJSON bool, file string, err error) error {
+	fields := map[string]interface{}{}
+	if file != "" {
+		fields["file"] = file
+	}
+	return commandErrorFields(outputJSON, fields, err)
+}
+
+// commandErrorFields is commandError with extra fields for the JSON error object,
+// e.g. the directory searched. fields may override "status" (validation failures
+// report "failed"). Either way the command exits non-zero.
+func commandErrorFields(outputJSON bool, fields map[string]interface{}, err error) error {
+	if !outputJSON {
+		return err
+	}
+	writeJSONError(fields, err)
+	return &reportedError{err: err}
+}
+
+// writeJSONError writes {"status": "error", "error": ...} plus fields to stdout
+func writeJSONError(fields map[string]interface{}, err error) {
+	writeJSON(jsonError(fields, err))
+}
+
+// jsonError builds the JSON error object writeJSONError prints
+func jsonError(fields map[string]interface{}, err error) map[string]interface{} {
+	result := map[string]interface{}{
+		"status": "error",
+		"error":  err.Error(),
+	}
+	for key, value := range fields {
+		result[key] = value
+	}
+	return result
+}
+
+// writeJSON writes an indented JSON document to stdout
+func writeJSON(doc interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// reportError prints a command's error: as a JSON error object with --json, unless
+// the command already wrote one, otherwise as text on stderr
+func reportError(err error, outputJSON bool) {
+	var reported *reportedError
+	switch {
+	case errors.As(err, &reported):
+	case outputJSON:
+		writeJSONError(nil, err)
+	default:
+		os.Stderr.WriteString(err.Error() + "\n")
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestMain lets tests run the CLI as a subprocess of the test binary, so exit codes
+// and stdout can be checked exactly as a caller sees them
+func TestMain(m *testing.M) {
+	if os.Getenv("PRISM_TEST_RUN_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// runPrism runs the CLI with args and returns its stdout and exit code
+func runPrism(t *testing.T, args ...string) ([]byte, int) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(), "PRISM_TEST_RUN_MAIN=1")
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return stdout.Bytes(), 0
+	case errors.As(err, &exitErr):
+		return stdout.Bytes(), exitErr.ExitCode()
+	default:
+		t.Fatalf("Failed to run prism: %v", err)
+		return nil, 0
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	empty := t.TempDir()
+	project := t.TempDir()
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(`{"version": "v1", "phase": "structure"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		status string
+	}{
+		{"render without structures", []string{"render", empty}, "error"},
+		{"render with conflicting flags", []string{"render", project, "--all", "--manifest", "m.json"}, "error"},
+		{"render an invalid structure", []string{"render", project, "--version", "v1"}, "error"},
+		{"validate an invalid structure", []string{"validate", project, "--version", "v1"}, "failed"},
+		{"validate a missing version", []string{"validate", project, "--version", "v9"}, "error"},
+		{"audit an unimplemented phase", []string{"audit", project, "--phase", "2"}, "error"},
+		{"suggest without structures", []string{"suggest", empty}, "error"},
+		{"list without structures", []string{"list", "--project", empty}, "error"},
+		{"show a missing version", []string{"show", "v9", "--project", project}, "error"},
+		{"compare a missing version", []string{"compare", project, "--from", "v1", "--to", "v9"}, "error"},
+		{"stats a missing version", []string{"stats", project, "--version", "v9"}, "error"},
+		{"fix without fixes", []string{"fix", project}, "error"},
+		{"approve without an approver", []string{"approve", "v1", "--project", project}, "error"},
+		{"onboard an unknown template", []string{"onboard", "--project", filepath.Join(empty, "new"), "--template", "nope"}, "error"},
+		{"unknown flag", []string{"render", project, "--no-such-flag"}, "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout, code := runPrism(t, append(tt.args, "--json")...)
+			if code == 0 {
+				t.Errorf("Expected a non-zero exit code, got 0 with output: %s", stdout)
+			}
+
+			var result map[string]interface{}
+			dec := json.NewDecoder(bytes.NewReader(stdout))
+			if err := dec.Decode(&result); err != nil {
+				t.Fatalf("Expected a JSON error object on stdout, got %q: %v", stdout, err)
+			}
+			if dec.More() {
+				t.Errorf("Expected a single JSON object, got %s", stdout)
+			}
+			if result["status"] != tt.status {
+				t.Errorf("Expected status %q, got %v", tt.status, result["status"])
+			}
+			if msg, _ := result["error"].(string); msg == "" {
+				t.Errorf("Expected an error message, got %v", result)
+			}
+		})
+	}
+}
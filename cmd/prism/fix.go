@@ -0,0 +1,227 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/internal/validate"
+	"github.com/spf13/cobra"
+)
+
+var fixCmd = &cobra.Command{
+	Use:   "fix [project-path]",
+	Short: "Automatically fix validation issues in a structure",
+	Long: `Apply the suggestions produced by validators directly to a structure file.
+
+By default the fixed structure is written as a new version (e.g. v3.json when
+v2.json is the latest), with parent_version and change_summary filled in.
+Use --in-place to overwrite the source file instead.
+
+Available Fixes:
+  --spacing     Snap off-grid padding, gap and margin values to the 8pt grid
+  --contrast    Replace failing text colors with WCAG AA compliant suggestions
+                (Phase 2 design structures only)
+
+Examples:
+  # Snap spacing values in the latest version and write a new version
+  prism fix ./my-dashboard --spacing
+
+  # Apply contrast suggestions to a Phase 2 design
+  prism fix ./my-dashboard --contrast
+
+  # Fix a specific version in place
+  prism fix ./my-dashboard --spacing --version v2 --in-place
+
+  # Get JSON output for tooling
+  prism fix ./my-dashboard --spacing --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runFix,
+}
+
+func init() {
+	fixCmd.Flags().StringP("version", "v", "latest", "Version to fix (v1, v2, approved, latest)")
+	fixCmd.Flags().Bool("in-place", false, "Overwrite the source file instead of writing a new version")
+	fixCmd.Flags().Bool("spacing", false, "Snap off-grid spacing values to the 8pt grid")
+	fixCmd.Flags().Bool("contrast", false, "Apply suggested colors to text failing WCAG AA contrast (Phase 2 only)")
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	// Get flags
+	projectPath := "./"
+	if len(args) > 0 {
+		projectPath = args[0]
+	}
+
+	versionFlag, _ := cmd.Flags().GetString("version")
+	inPlace, _ := cmd.Flags().GetBool("in-place")
+	spacingFix, _ := cmd.Flags().GetBool("spacing")
+	contrastFix, _ := cmd.Flags().GetBool("contrast")
+	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
+
+	if !spacingFix && !contrastFix {
+		return commandError(outputJSON, "", fmt.Errorf("no fixes selected (use --spacing and/or --contrast)"))
+	}
+
+	// Find the structure file
+	structurePath := filepath.Join(projectPath, "phase1-structure")
+	structureFile, err := findStructureFile(structurePath, versionFlag)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+
+	data, err := readStructureFile(structureFile)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to read %s: %w", structureFile, err))
+	}
+
+	structure, err := types.ParseStructure(data)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to parse structure: %w", err))
+	}
+
+	if inPlace && structure.Locked {
+		return commandError(outputJSON, structureFile, fmt.Errorf("%s is locked; fix it into a new version instead of --in-place", structureFile))
+	}
+
+	// Colors are restricted to grayscale in Phase 1, so contrast fixes only apply to designs
+	if contrastFix && structure.Phase != "design" {
+		return commandError(outputJSON, structureFile, fmt.Errorf("--contrast requires a Phase 2 (design) structure; %s is phase '%s'", structureFile, structure.Phase))
+	}
+
+	// Apply fixes
+	spacingFixes := []validate.SpacingFix{}
+	if spacingFix {
+		spacingFixes = validate.FixSpacing(structure, projectConfig.SpacingRule())
+	}
+	contrastFixes := []validate.ContrastFix{}
+	if contrastFix {
+		contrastFixes = validate.FixContrast(structure, validate.DefaultContrastRule())
+	}
+	changed := len(spacingFixes) + len(contrastFixes)
+
+	// Write the result
+	outputFile := ""
+	if changed > 0 {
+		outputFile = structureFile
+		if !inPlace {
+			nextVersion, err := nextVersionName(structurePath)
+			if err != nil {
+				return commandError(outputJSON, structureFile, err)
+			}
+			structure.ParentVersion = structure.Version
+			structure.Version = nextVersion
+			structure.CreatedAt = time.Now().UTC()
+			structure.Locked = false
+			structure.LockedAt = nil
+			structure.ApprovedBy = ""
+			structure.Checksum = ""
+			structure.ChangeSummary = fixChangeSummary(len(spacingFixes), len(contrastFixes))
+			outputFile = filepath.Join(structurePath, nextVersion+".json")
+		}
+
+		if err := writeStructure(outputFile, structure, data); err != nil {
+			return commandError(outputJSON, structureFile, err)
+		}
+	}
+
+	if outputJSON {
+		result := map[string]interface{}{
+			"status":   "success",
+			"command":  "fix",
+			"file":     structureFile,
+			"output":   outputFile,
+			"changed":  changed,
+			"spacing":  spacingFixes,
+			"contrast": contrastFixes,
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	if changed == 0 {
+		fmt.Printf("✅ Nothing to fix in %s\n", structureFile)
+		return nil
+	}
+
+	fmt.Printf("🔧 Fixed %s\n", structureFile)
+	if len(spacingFixes) > 0 {
+		fmt.Println("\n📏 Spacing (8pt grid):")
+		for _, fix := range spacingFixes {
+			fmt.Printf("   %s.%s: %dpx → %dpx\n", fix.ComponentID, fix.Property, fix.From, fix.To)
+		}
+	}
+	if len(contrastFixes) > 0 {
+		fmt.Println("\n🎨 Contrast (WCAG AA):")
+		for _, fix := range contrastFixes {
+			fmt.Printf("   %s.color: %s → %s (%.1f:1 on %s)\n", fix.ComponentID, fix.From, fix.To, fix.Ratio, fix.Background)
+		}
+	}
+	fmt.Printf("\n   Changed: %d value(s)\n", changed)
+	fmt.Printf("   Output: %s\n", outputFile)
+
+	return nil
+}
+
+// fixChangeSummary describes the applied fixes for the new version's change_summary
+func fixChangeSummary(spacingCount, contrastCount int) string {
+	parts := []string{}
+	if spacingCount > 0 {
+		parts = append(parts, fmt.Sprintf("snapped %d off-grid spacing value(s) to the 8pt grid", spacingCount))
+	}
+	if contrastCount > 0 {
+		parts = append(parts, fmt.Sprintf("replaced %d low-contrast text color(s)", contrastCount))
+	}
+	return "Auto-fix: " + strings.Join(parts, "; ")
+}
+
+// findStructureFile resolves a version name (v1, approved, latest) to a structure file path
+func findStructureFile(structurePath, version string) (string, error) {
+	if version != "latest" {
+		path := structureFilePath(structurePath, version)
+		if _, err := os.Stat(path); err != nil {
+			return "", fmt.Errorf("version '%s' not found at %s", version, path)
+		}
+		return path, nil
+	}
+
+	return resolveLatestVersion(structurePath)
+}
+
+// nextVersionName returns the next free vN name in the structure directory
+func nextVersionName(structurePath string) (string, error) {
+	entries, err := os.ReadDir(structurePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read directory %s: %w", structurePath, err)
+	}
+
+	latestVersion := 0
+	for _, entry := range entries {
+		if v, ok := versionNumber(entry.Name()); ok && v > latestVersion {
+			latestVersion = v
+		}
+	}
+
+	return fmt.Sprintf("v%d", latestVersion+1), nil
+}
+
+// writeStructure writes a structure as indented JSON, keeping the schema's field order.
+// Compared with source, the JSON the structure was parsed from, components declared
+// as refs are written as those refs again and empty fields the author left out stay
+// out (see types.MarshalEdited).
+func writeStructure(path string, structure *types.Structure, source []byte) error {
+	data, err := types.MarshalEdited(source, structure)
+	if err != nil {
+		return err
+	}
+
+	if err := writeStructureFile(path, append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
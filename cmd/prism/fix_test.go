@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFixAndApprove_KeepRefs(t *testing.T) {
+	writeProject := func(cardPadding int) string {
+		project := t.TempDir()
+		structurePath := filepath.Join(project, "phase1-structure")
+		if err := os.Mkdir(structurePath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		structure := `{
+			"version": "v1",
+			"phase": "structure",
+			"intent": {"purpose": "Pricing"},
+			"layout": {"type": "stack"},
+			"definitions": {
+				"card": {"id": "card", "type": "box", "layout": {"display": "flex", "padding": ` + strconv.Itoa(cardPadding) + `}, "children": [
+					{"id": "title", "type": "text", "content": "Plan"}
+				]}
+			},
+			"components": [
+				{"id": "header", "type": "box", "layout": {"padding": 13}, "children": [
+					{"id": "heading", "type": "text", "content": "Pricing"}
+				]},
+				{"ref": "card", "id": "basic"},
+				{"ref": "card", "id": "pro"}
+			]
+		}`
+		if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(structure), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return project
+	}
+
+	// readComponents returns the components of a written file as raw JSON objects
+	readComponents := func(path string) []map[string]interface{} {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var doc struct {
+			Components []map[string]interface{} `json:"components"`
+		}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Fatalf("Failed to parse %s: %v", path, err)
+		}
+		return doc.Components
+	}
+
+	project := writeProject(16)
+	if stdout, code := runPrism(t, "fix", project, "--spacing"); code != 0 {
+		t.Fatalf("Expected fix to succeed, got %d: %s", code, stdout)
+	}
+	fixed := readComponents(filepath.Join(project, "phase1-structure", "v2.json"))
+	if len(fixed) != 3 || fixed[1]["ref"] != "card" || fixed[2]["ref"] != "card" || fixed[1]["children"] != nil {
+		t.Errorf("Expected fix to keep both card refs, got %v", fixed)
+	}
+	if padding := fixed[0]["layout"].(map[string]interface{})["padding"]; padding == 13.0 {
+		t.Error("Expected the header padding to be fixed")
+	}
+
+	if stdout, code := runPrism(t, "approve", "v2", "--by", "Jane", "--project", project); code != 0 {
+		t.Fatalf("Expected approve to succeed, got %d: %s", code, stdout)
+	}
+	approved := readComponents(filepath.Join(project, "phase1-structure", "approved.json"))
+	if len(approved) != 3 || approved[1]["ref"] != "card" || approved[2]["ref"] != "card" {
+		t.Errorf("Expected approve to keep both card refs, got %v", approved)
+	}
+	// The checksum covers the expanded structure, so it still verifies
+	if stdout, code := runPrism(t, "validate", project, "--version", "approved"); code != 0 {
+		t.Errorf("Expected the approved structure to verify, got %d: %s", code, stdout)
+	}
+
+	// Fixing spacing inside a definition would be lost in the instances, so it is refused
+	project = writeProject(13)
+	stdout, code := runPrism(t, "fix", project, "--spacing", "--json")
+	if code == 0 || !strings.Contains(string(stdout), "definitions.card") {
+		t.Errorf("Expected fix to refuse changing expanded refs, got %d: %s", code, stdout)
+	}
+}
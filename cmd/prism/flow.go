@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/pkg/prism"
+)
+
+// renderFlowDiagram renders every screen listed in the project's flow.json and
+// saves them as one left-to-right flow diagram
+func renderFlowDiagram(projectPath, outputPath, outDir string, opts prism.RenderOptions, outputJSON bool) error {
+	flowFile := filepath.Join(projectPath, types.FlowFileName)
+	data, err := os.ReadFile(flowFile)
+	if err != nil {
+		return commandError(outputJSON, flowFile, fmt.Errorf("failed to read flow: %w", err))
+	}
+
+	flow, err := prism.ParseFlow(data)
+	if err != nil {
+		return commandError(outputJSON, flowFile, err)
+	}
+
+	steps := make([]prism.FlowStep, len(flow.Screens))
+	for i, screen := range flow.Screens {
+		screenFile := filepath.Join(projectPath, screen.File)
+		screenData, err := readStructureFile(screenFile)
+		if err != nil {
+			return commandError(outputJSON, screenFile, fmt.Errorf("screen '%s': failed to read %s: %w", screen.Name, screen.File, err))
+		}
+		structure, err := prism.ParseAndValidateStructure(screenData)
+		if err != nil {
+			return commandError(outputJSON, screenFile, fmt.Errorf("screen '%s': %w", screen.Name, err))
+		}
+		steps[i] = prism.FlowStep{Label: screen.Name, Structure: structure}
+	}
+
+	result, err := prism.RenderFlow(steps, opts)
+	if err != nil {
+		return commandError(outputJSON, flowFile, fmt.Errorf("rendering failed: %w", err))
+	}
+
+	if outputPath == "" {
+		baseName := filepath.Base(projectPath)
+		if baseName == "." || baseName == "/" {
+			baseName = "mockup"
+		}
+		dir, err := resolveOutputDir(projectPath, outDir)
+		if err != nil {
+			return commandError(outputJSON, flowFile, err)
+		}
+		outputPath = filepath.Join(dir, baseName+"-flow.png")
+	}
+
+	if err := result.SavePNG(outputPath); err != nil {
+		return commandError(outputJSON, flowFile, fmt.Errorf("failed to save PNG: %w", err))
+	}
+
+	if outputJSON {
+		screens := make([]map[string]interface{}, len(flow.Screens))
+		for i, screen := range flow.Screens {
+			screens[i] = map[string]interface{}{
+				"name":    screen.Name,
+				"file":    screen.File,
+				"version": steps[i].Structure.Version,
+				"width":   result.Screens[i].Width,
+				"height":  result.Screens[i].Height,
+			}
+		}
+		successResult := map[string]interface{}{
+			"status":  "success",
+			"file":    flowFile,
+			"output":  outputPath,
+			"width":   result.Width,
+			"height":  result.Height,
+			"screens": screens,
+		}
+		if flow.Name != "" {
+			successResult["flow"] = flow.Name
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(successResult)
+	}
+
+	title := flow.Name
+	if title == "" {
+		title = flowFile
+	}
+	fmt.Printf("✅ Rendered flow %s\n", title)
+	fmt.Printf("   Output: %s\n", outputPath)
+	fmt.Printf("   Dimensions: %dx%d\n", result.Width, result.Height)
+	for i, screen := range flow.Screens {
+		fmt.Printf("   %d. %s (%s)\n", i+1, screen.Name, screen.File)
+	}
+
+	return nil
+}
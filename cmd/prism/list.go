@@ -6,7 +6,6 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
-	"strings"
 	"time"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -44,47 +43,32 @@ func runList(cmd *cobra.Command, args []string) error {
 	
 	// Check if directory exists
 	if _, err := os.Stat(structurePath); os.IsNotExist(err) {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status":   "error",
-				"error":    "No phase1-structure directory found",
-				"path":     structurePath,
-				"versions": []VersionInfo{},
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("no phase1-structure directory found in %s", projectPath)
+		return commandErrorFields(outputJSON, map[string]interface{}{
+			"path":     structurePath,
+			"versions": []VersionInfo{},
+		}, fmt.Errorf("no phase1-structure directory found in %s", projectPath))
 	}
 
 	// Read directory
 	entries, err := os.ReadDir(structurePath)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to read directory: %v", err),
-				"path":   structurePath,
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read directory %s: %w", structurePath, err)
+		return commandErrorFields(outputJSON, map[string]interface{}{
+			"path": structurePath,
+		}, fmt.Errorf("failed to read directory %s: %w", structurePath, err))
 	}
 
 	// Collect version information
 	var versions []VersionInfo
 	for _, entry := range entries {
-		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+		versionName, ok := structureName(entry.Name())
+		if entry.IsDir() || !ok {
 			continue
 		}
 
 		filePath := filepath.Join(structurePath, entry.Name())
 		
 		// Read and parse the file
-		data, err := os.ReadFile(filePath)
+		data, err := readStructureFile(filePath)
 		if err != nil {
 			continue // Skip files we can't read
 		}
@@ -94,9 +78,6 @@ func runList(cmd *cobra.Command, args []string) error {
 			continue // Skip files we can't parse
 		}
 
-		// Extract version name from filename
-		versionName := strings.TrimSuffix(entry.Name(), ".json")
-		
 		versions = append(versions, VersionInfo{
 			Version:   versionName,
 			File:      entry.Name(),
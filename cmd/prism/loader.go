@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gzipMagic starts every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// readStructureFile reads a structure file, decompressing it first when it is
+// gzip-compressed (v2.json.gz). Compression is detected from the content, so a
+// compressed file without the .gz extension loads too.
+func readStructureFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	data, err = io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", path, err)
+	}
+	return data, nil
+}
+
+// writeStructureFile writes structure JSON to path, gzip-compressing it when the
+// path ends in .gz so a compressed file stays compressed
+func writeStructureFile(path string, data []byte) error {
+	if !strings.HasSuffix(path, gzipExt) {
+		return os.WriteFile(path, data, 0644)
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
+
+// structureFilePath returns the path of a named version in dir, preferring
+// name.json and falling back to name.json.gz when only the compressed file exists
+func structureFilePath(dir, name string) string {
+	path := filepath.Join(dir, name+".json")
+	if _, err := os.Stat(path); err != nil {
+		if _, gzErr := os.Stat(path + gzipExt); gzErr == nil {
+			return path + gzipExt
+		}
+	}
+	return path
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+const loaderStructure = `{
+  "version": "v2",
+  "phase": "structure",
+  "intent": {"purpose": "Sign in", "primary_action": "submit"},
+  "layout": {"type": "stack", "direction": "vertical", "spacing": 16},
+  "components": [
+    {"id": "title", "type": "text", "content": "Sign in", "size": "2xl"},
+    {"id": "submit", "type": "button", "content": "Continue"}
+  ]
+}`
+
+func TestReadStructureFile_Gzip(t *testing.T) {
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "v1.json")
+	if err := os.WriteFile(plainPath, []byte(loaderStructure), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gzipPath := filepath.Join(dir, "v2.json.gz")
+	if err := writeStructureFile(gzipPath, []byte(loaderStructure)); err != nil {
+		t.Fatalf("writeStructureFile failed: %v", err)
+	}
+
+	raw, err := os.ReadFile(gzipPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(raw, gzipMagic) {
+		t.Fatal("Expected a .gz path to be written compressed")
+	}
+
+	parse := func(path string) *types.Structure {
+		t.Helper()
+		data, err := readStructureFile(path)
+		if err != nil {
+			t.Fatalf("readStructureFile(%s) failed: %v", path, err)
+		}
+		structure, err := types.ParseAndValidateStructure(data)
+		if err != nil {
+			t.Fatalf("ParseAndValidateStructure(%s) failed: %v", path, err)
+		}
+		return structure
+	}
+	if plain, compressed := parse(plainPath), parse(gzipPath); !reflect.DeepEqual(plain, compressed) {
+		t.Errorf("Compressed structure parsed differently:\n%+v\n%+v", compressed, plain)
+	}
+
+	// Detection is by content, so the extension is not required
+	renamed := filepath.Join(dir, "v3.json")
+	if err := os.WriteFile(renamed, raw, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if data, err := readStructureFile(renamed); err != nil || string(data) != loaderStructure {
+		t.Errorf("Expected gzip content without the .gz extension to be decompressed, got err %v", err)
+	}
+
+	// A truncated stream is reported, not parsed as garbage
+	truncated := filepath.Join(dir, "v4.json.gz")
+	if err := os.WriteFile(truncated, raw[:len(raw)/2], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readStructureFile(truncated); err == nil {
+		t.Error("Expected an error for a truncated gzip file")
+	}
+}
+
+func TestStructureFilePath(t *testing.T) {
+	dir := t.TempDir()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte("{}"))
+	gz.Close()
+	for name, data := range map[string][]byte{"v1.json": []byte("{}"), "v2.json.gz": buf.Bytes()} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := map[string]string{
+		"v1": "v1.json",
+		"v2": "v2.json.gz",
+		"v3": "v3.json", // missing files keep the plain name for the error message
+	}
+	for version, want := range tests {
+		if got := structureFilePath(dir, version); got != filepath.Join(dir, want) {
+			t.Errorf("structureFilePath(%s) = %s, expected %s", version, got, want)
+		}
+	}
+
+	latest, err := resolveLatestVersion(dir)
+	if err != nil || latest != filepath.Join(dir, "v2.json.gz") {
+		t.Errorf("Expected the compressed v2 to be the latest version, got %s (%v)", latest, err)
+	}
+	if name, ok := structureName("v2.json.gz"); !ok || name != "v2" {
+		t.Errorf("structureName(v2.json.gz) = %q, %v", name, ok)
+	}
+	if _, ok := structureName("v2.gz"); ok {
+		t.Error("Expected a .gz file that is not JSON to be ignored")
+	}
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
-	"fmt"
+	"io"
 	"os"
+	"slices"
 
+	"github.com/johanbellander/prism/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -14,9 +16,48 @@ var (
 	date    = "unknown"
 )
 
+// projectConfig holds the defaults loaded from --config (or ~/.prism) before any command runs
+var projectConfig *config.Config
+
+// loadConfig reads the config file so commands can apply it beneath their own flags
+func loadConfig(cmd *cobra.Command, args []string) error {
+	// With --json, main reports errors as JSON objects; cobra's text would only add noise
+	if outputJSON, _ := cmd.Flags().GetBool("json"); outputJSON {
+		cmd.SilenceErrors = true
+		cmd.SilenceUsage = true
+	}
+
+	path, _ := cmd.Flags().GetString("config")
+	cfg, err := config.Load(path)
+	if err != nil {
+		return err
+	}
+	projectConfig = cfg
+	return nil
+}
+
+// verboseLog returns stderr when --verbose is set, so debug output never mixes with --json on stdout
+func verboseLog(cmd *cobra.Command) io.Writer {
+	if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+		return os.Stderr
+	}
+	return nil
+}
+
+// progressLog returns where batch progress lines go: stderr, which --quiet turns off
+// for scripted and CI runs that only want the final report
+func progressLog(cmd *cobra.Command) io.Writer {
+	if quiet, _ := cmd.Flags().GetBool("quiet"); quiet {
+		return nil
+	}
+	return os.Stderr
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
+		// A flag error stops parsing before --json is read, so look for it directly
+		outputJSON, _ := rootCmd.PersistentFlags().GetBool("json")
+		reportError(err, outputJSON || slices.Contains(os.Args[1:], "--json"))
 		os.Exit(1)
 	}
 }
@@ -29,7 +70,8 @@ of Phase 1 structural mockups from your AI Design Agent process.
 
 It takes JSON structure files created in Phase 1 and renders them as 
 black-and-white wireframe images for easy review and approval.`,
-	Version: version,
+	Version:           version,
+	PersistentPreRunE: loadConfig,
 }
 
 func init() {
@@ -37,6 +79,7 @@ func init() {
 	rootCmd.PersistentFlags().Bool("json", false, "Output in JSON format")
 	rootCmd.PersistentFlags().StringP("project", "p", "./", "Project directory path")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Suppress non-essential output")
+	rootCmd.PersistentFlags().Bool("verbose", false, "Log layout and render decisions to stderr")
 	rootCmd.PersistentFlags().String("config", "", "Config file path (default: ~/.prism)")
 
 	// Add subcommands
@@ -48,4 +91,9 @@ func init() {
 	rootCmd.AddCommand(showCmd)
 	rootCmd.AddCommand(compareCmd)
 	rootCmd.AddCommand(onboardCmd)
+	rootCmd.AddCommand(fixCmd)
+	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(approveCmd)
+	rootCmd.AddCommand(schemaCmd)
+	rootCmd.AddCommand(validatorsCmd)
 }
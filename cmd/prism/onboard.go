@@ -16,24 +16,98 @@ var onboardCmd = &cobra.Command{
 This command creates:
 - Project directory structure (phase1-structure/, mockups/)
 - DESIGNPROCESS.md with two-phase workflow guide
-- Example Phase 1 structure file
+- Example Phase 1 structure file, or a starting v1.json with --template
 - .gitignore for mockup outputs
 
-Run this once when starting a new UI design project.`,
+Run this once when starting a new UI design project. Use --dry-run to preview
+what would be created or overwritten without writing anything.
+
+Templates:
+  login      Sign-in form with email, password and a reset link
+  form       Contact form with labeled fields and actions
+  landing    Marketing page with hero, feature cards and footer
+  dashboard  Metric cards and a recent activity list`,
 	RunE: runOnboard,
 }
 
 func init() {
 	onboardCmd.Flags().BoolP("force", "f", false, "Overwrite existing files")
+	onboardCmd.Flags().Bool("dry-run", false, "Print what would be created or overwritten without writing anything")
+	onboardCmd.Flags().String("template", "", "Start phase1-structure/v1.json from a template (login, form, landing, dashboard)")
 }
 
 func runOnboard(cmd *cobra.Command, args []string) error {
 	force, _ := cmd.Flags().GetBool("force")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
 	projectPath, _ := cmd.Flags().GetString("project")
+	template, _ := cmd.Flags().GetString("template")
+
+	if template != "" {
+		if _, err := structureTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		fmt.Println("🔍 Previewing PRISM setup (dry run)...")
+	} else {
+		fmt.Println("🎨 Setting up PRISM in your project...")
+	}
+	fmt.Println()
 
-	fmt.Println("🎨 Setting up PRISM in your project...")
+	if err := scaffoldProject(projectPath, template, force, dryRun); err != nil {
+		return err
+	}
+
+	if dryRun {
+		fmt.Println()
+		fmt.Println("🔍 Dry run: nothing was written. Run without --dry-run to apply.")
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("🎉 PRISM setup complete!")
+	fmt.Println()
+	fmt.Println("📚 FOR AI AGENTS: You should now read and understand the workflow:")
+	fmt.Println()
+	fmt.Println("   CRITICAL: Read the DESIGNPROCESS.md file that was just created.")
+	fmt.Println("   This file contains the complete two-phase design workflow that")
+	fmt.Println("   you MUST follow when creating UI structures.")
+	fmt.Println()
+	fmt.Println("   File location: " + filepath.Join(projectPath, "DESIGNPROCESS.md"))
+	fmt.Println()
+	fmt.Println("   Key points:")
+	fmt.Println("   - Phase 1: Structure only (black/white, no styling)")
+	fmt.Println("   - Phase 2: Design tokens only (after Phase 1 approval)")
+	fmt.Println("   - Always validate with: prism validate .")
+	fmt.Println("   - Always render with: prism render .")
+	fmt.Println()
+	fmt.Println("Next steps for humans:")
+	fmt.Println("  1. Read DESIGNPROCESS.md to understand the two-phase workflow")
+	if template != "" {
+		fmt.Printf("  2. Review phase1-structure/v1.json (from the %s template)\n", template)
+		fmt.Println("  3. Render it: prism render . --version v1")
+	} else {
+		fmt.Println("  2. Review phase1-structure/example.json")
+		fmt.Println("  3. Render the example: prism render . --version example")
+	}
+	fmt.Println("  4. Start creating your Phase 1 structures in phase1-structure/")
+	fmt.Println()
+	fmt.Println("Quick commands:")
+	fmt.Println("  prism render .              # Render latest version")
+	fmt.Println("  prism validate .            # Validate structure")
+	fmt.Println("  prism list                  # List all versions")
+	fmt.Println("  prism compare --from v1 --to v2  # Compare versions")
 	fmt.Println()
 
+	return nil
+}
+
+// scaffoldProject creates the PRISM directories and starter files in projectPath,
+// leaving existing files alone unless force is set. A named template replaces the
+// example structure with a starting v1.json. With dryRun it only reports what it
+// would create or overwrite.
+func scaffoldProject(projectPath, template string, force, dryRun bool) error {
 	// Create directory structure
 	dirs := []string{
 		"phase1-structure",
@@ -44,6 +118,14 @@ func runOnboard(cmd *cobra.Command, args []string) error {
 
 	for _, dir := range dirs {
 		path := filepath.Join(projectPath, dir)
+		if dryRun {
+			if info, err := os.Stat(path); err == nil && info.IsDir() {
+				fmt.Printf("   %s/ already exists\n", dir)
+			} else {
+				fmt.Printf("📝 Would create %s/\n", dir)
+			}
+			continue
+		}
 		if err := os.MkdirAll(path, 0755); err != nil {
 			return fmt.Errorf("failed to create directory %s: %w", dir, err)
 		}
@@ -57,21 +139,33 @@ func runOnboard(cmd *cobra.Command, args []string) error {
 	if _, err := os.Stat(designProcessPath); err == nil && !force {
 		fmt.Printf("⚠️  DESIGNPROCESS.md already exists. Use --force to overwrite.\n")
 	} else {
-		if err := createDesignProcessFile(designProcessPath); err != nil {
+		if err := createDesignProcessFile(designProcessPath, dryRun); err != nil {
 			return err
 		}
-		fmt.Println("✅ Created DESIGNPROCESS.md")
+		reportScaffoldFile(designProcessPath, "DESIGNPROCESS.md", dryRun)
 	}
 
-	// Create example structure
-	examplePath := filepath.Join(projectPath, "phase1-structure", "example.json")
-	if _, err := os.Stat(examplePath); err == nil && !force {
-		fmt.Printf("⚠️  example.json already exists. Use --force to overwrite.\n")
+	// Create example structure, or the template's first version
+	if template != "" {
+		v1Path := filepath.Join(projectPath, "phase1-structure", "v1.json")
+		if _, err := os.Stat(v1Path); err == nil && !force {
+			fmt.Printf("⚠️  v1.json already exists. Use --force to overwrite.\n")
+		} else {
+			if err := createTemplateStructure(v1Path, template, dryRun); err != nil {
+				return err
+			}
+			reportScaffoldFile(v1Path, "phase1-structure/v1.json", dryRun)
+		}
 	} else {
-		if err := createExampleStructure(examplePath); err != nil {
-			return err
+		examplePath := filepath.Join(projectPath, "phase1-structure", "example.json")
+		if _, err := os.Stat(examplePath); err == nil && !force {
+			fmt.Printf("⚠️  example.json already exists. Use --force to overwrite.\n")
+		} else {
+			if err := createExampleStructure(examplePath, dryRun); err != nil {
+				return err
+			}
+			reportScaffoldFile(examplePath, "phase1-structure/example.json", dryRun)
 		}
-		fmt.Println("✅ Created phase1-structure/example.json")
 	}
 
 	// Create .gitignore
@@ -79,55 +173,47 @@ func runOnboard(cmd *cobra.Command, args []string) error {
 	if _, err := os.Stat(gitignorePath); err == nil && !force {
 		fmt.Printf("⚠️  .gitignore already exists. Use --force to overwrite.\n")
 	} else {
-		if err := createGitignore(gitignorePath); err != nil {
+		if err := createGitignore(gitignorePath, dryRun); err != nil {
 			return err
 		}
-		fmt.Println("✅ Created .gitignore")
+		reportScaffoldFile(gitignorePath, ".gitignore", dryRun)
 	}
 
 	// Create README.md if it doesn't exist
 	readmePath := filepath.Join(projectPath, "README.md")
 	if _, err := os.Stat(readmePath); os.IsNotExist(err) {
-		if err := createProjectReadme(readmePath); err != nil {
+		if err := createProjectReadme(readmePath, dryRun); err != nil {
 			return err
 		}
-		fmt.Println("✅ Created README.md")
+		reportScaffoldFile(readmePath, "README.md", dryRun)
 	}
 
-	fmt.Println()
-	fmt.Println("🎉 PRISM setup complete!")
-	fmt.Println()
-	fmt.Println("📚 FOR AI AGENTS: You should now read and understand the workflow:")
-	fmt.Println()
-	fmt.Println("   CRITICAL: Read the DESIGNPROCESS.md file that was just created.")
-	fmt.Println("   This file contains the complete two-phase design workflow that")
-	fmt.Println("   you MUST follow when creating UI structures.")
-	fmt.Println()
-	fmt.Println("   File location: " + filepath.Join(projectPath, "DESIGNPROCESS.md"))
-	fmt.Println()
-	fmt.Println("   Key points:")
-	fmt.Println("   - Phase 1: Structure only (black/white, no styling)")
-	fmt.Println("   - Phase 2: Design tokens only (after Phase 1 approval)")
-	fmt.Println("   - Always validate with: prism validate .")
-	fmt.Println("   - Always render with: prism render .")
-	fmt.Println()
-	fmt.Println("Next steps for humans:")
-	fmt.Println("  1. Read DESIGNPROCESS.md to understand the two-phase workflow")
-	fmt.Println("  2. Review phase1-structure/example.json")
-	fmt.Println("  3. Render the example: prism render . --version example")
-	fmt.Println("  4. Start creating your Phase 1 structures in phase1-structure/")
-	fmt.Println()
-	fmt.Println("Quick commands:")
-	fmt.Println("  prism render .              # Render latest version")
-	fmt.Println("  prism validate .            # Validate structure")
-	fmt.Println("  prism list                  # List all versions")
-	fmt.Println("  prism compare --from v1 --to v2  # Compare versions")
-	fmt.Println()
-
 	return nil
 }
 
-func createDesignProcessFile(path string) error {
+// reportScaffoldFile prints the outcome for a starter file; in a dry run it says
+// whether the file would be created or overwritten
+func reportScaffoldFile(path, label string, dryRun bool) {
+	if !dryRun {
+		fmt.Printf("✅ Created %s\n", label)
+		return
+	}
+	if _, err := os.Stat(path); err == nil {
+		fmt.Printf("📝 Would overwrite %s\n", label)
+	} else {
+		fmt.Printf("📝 Would create %s\n", label)
+	}
+}
+
+// writeScaffoldFile writes a starter file, or does nothing in a dry run
+func writeScaffoldFile(path, content string, dryRun bool) error {
+	if dryRun {
+		return nil
+	}
+	return os.WriteFile(path, []byte(content), 0644)
+}
+
+func createDesignProcessFile(path string, dryRun bool) error {
 	content := `# Two-Phase Design Process with PRISM
 
 ## Overview
@@ -235,10 +321,10 @@ Benefits:
 - Full Design Process Guide: See repository DESIGNPROCESS.md
 `
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return writeScaffoldFile(path, content, dryRun)
 }
 
-func createExampleStructure(path string) error {
+func createExampleStructure(path string, dryRun bool) error {
 	content := `{
   "version": "example",
   "phase": "structure",
@@ -330,10 +416,19 @@ func createExampleStructure(path string) error {
 }
 `
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return writeScaffoldFile(path, content, dryRun)
 }
 
-func createGitignore(path string) error {
+// createTemplateStructure writes the named bundled template as the first structure version
+func createTemplateStructure(path, template string, dryRun bool) error {
+	data, err := structureTemplate(template)
+	if err != nil {
+		return err
+	}
+	return writeScaffoldFile(path, string(data), dryRun)
+}
+
+func createGitignore(path string, dryRun bool) error {
 	content := `# PRISM generated mockups
 mockups/*.png
 !mockups/.gitkeep
@@ -344,10 +439,10 @@ mockups/*.png
 Thumbs.db
 `
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return writeScaffoldFile(path, content, dryRun)
 }
 
-func createProjectReadme(path string) error {
+func createProjectReadme(path string, dryRun bool) error {
 	content := `# UI Design Project
 
 This project uses the two-phase design process with PRISM.
@@ -382,5 +477,5 @@ prism list
 See ` + "`DESIGNPROCESS.md`" + ` for the full two-phase workflow guide.
 `
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return writeScaffoldFile(path, content, dryRun)
 }
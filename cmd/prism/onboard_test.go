@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScaffoldProject_DryRunWritesNothing(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, ".gitignore")
+	if err := os.WriteFile(existing, []byte("keep\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := scaffoldProject(dir, "", true, true); err != nil {
+		t.Fatalf("scaffoldProject failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("Expected only the existing .gitignore after a dry run, found %d entries", len(entries))
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "keep\n" {
+		t.Errorf("Dry run with --force overwrote .gitignore: %q", data)
+	}
+}
+
+func TestScaffoldProject_CreatesFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := scaffoldProject(dir, "", false, false); err != nil {
+		t.Fatalf("scaffoldProject failed: %v", err)
+	}
+
+	for _, name := range []string{"phase1-structure/example.json", "DESIGNPROCESS.md", ".gitignore", "README.md", "mockups"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("Expected %s to be created: %v", name, err)
+		}
+	}
+}
+
+func TestScaffoldProject_Template(t *testing.T) {
+	dir := t.TempDir()
+	if err := scaffoldProject(dir, "login", false, false); err != nil {
+		t.Fatalf("scaffoldProject failed: %v", err)
+	}
+
+	want, _ := structureTemplate("login")
+	got, err := os.ReadFile(filepath.Join(dir, "phase1-structure", "v1.json"))
+	if err != nil {
+		t.Fatalf("Expected the template as v1.json: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Error("Expected v1.json to match the login template")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "phase1-structure", "example.json")); err == nil {
+		t.Error("Expected no example.json when starting from a template")
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// batchProgress prints "[3/12] rendering v3..." lines as a batch command works
+// through its versions and times the whole batch. It is safe for concurrent use.
+type batchProgress struct {
+	w     io.Writer // nil when progress is suppressed
+	total int
+	start time.Time
+
+	mu   sync.Mutex
+	done int
+}
+
+// newBatchProgress starts timing a batch of total items, reporting to w (nil for silence)
+func newBatchProgress(w io.Writer, total int) *batchProgress {
+	return &batchProgress{w: w, total: total, start: time.Now()}
+}
+
+// step reports that the next item has started, e.g. step("rendering", "v3")
+func (p *batchProgress) step(verb, name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	if p.w != nil {
+		fmt.Fprintf(p.w, "[%d/%d] %s %s...\n", p.done, p.total, verb, name)
+	}
+}
+
+// elapsed returns the time since the batch started, rounded for display
+func (p *batchProgress) elapsed() time.Duration {
+	return time.Since(p.start).Round(time.Millisecond)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBatchProgress_Steps(t *testing.T) {
+	var buf bytes.Buffer
+	progress := newBatchProgress(&buf, 3)
+	progress.step("rendering", "v1")
+	progress.step("rendering", "v2")
+
+	want := "[1/3] rendering v1...\n[2/3] rendering v2...\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if progress.elapsed() < 0 {
+		t.Errorf("Expected a non-negative elapsed time, got %v", progress.elapsed())
+	}
+}
+
+func TestBatchProgress_Quiet(t *testing.T) {
+	progress := newBatchProgress(nil, 2)
+	progress.step("validating", "v1") // must not panic without a writer
+	if progress.done != 1 {
+		t.Errorf("Expected steps to be counted in quiet mode, got %d", progress.done)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/pkg/prism"
 	"github.com/spf13/cobra"
 )
 
@@ -32,17 +34,27 @@ Viewport Presets:
   ultrawide   1920px - Ultra-wide displays
 
 Flags:
-  -v, --version         Version to render (v1, v2, approved, latest)
+  -v, --version         Version to render (v1, v2, approved, latest) or a range
+                        (">=3", "<5", "range:2-5") to render each version in it
   -o, --output          Output file path (default: auto-generated)
+      --out-dir         Directory for auto-named output (default: project mockups/ if present)
   -w, --width           Canvas width in pixels (overrides viewport)
-      --height          Canvas height in pixels (0 for auto-calculated)
+      --height          Canvas height in pixels (0 for auto-calculated; taller content is faded out)
   -s, --scale           Scale factor for high-DPI (1x, 2x, 3x)
       --viewport        Viewport preset (mobile, tablet, desktop, wide, ultrawide)
-  -a, --annotations     Include component IDs and dimensions
+  -a, --annotations     Include component IDs and dimensions, plus a legend strip
   -g, --grid            Show layout grid overlay
+      --show-focus      Preview focus rings around interactive components
+      --tab-order       Number interactive components in keyboard focus order
+      --no-bg           Leave the canvas transparent (PNG alpha) for compositing
+      --component       Render only the subtree rooted at this component ID
+      --manifest        Also write a JSON manifest of component IDs and their boxes
   -f, --format          Output format (png, svg, pdf)
       --theme           Color theme (bw, wireframe, blueprint)
       --all             Render all versions in phase1-structure/
+      --flow            Render the screens listed in flow.json as one flow diagram
+      --contact-sheet   With --all, also write a thumbnail grid of every version
+      --columns         Thumbnails per row on the contact sheet (default 3)
 
 Examples:
   # Render latest version at default size (1200px desktop)
@@ -51,15 +63,43 @@ Examples:
   # Render specific version
   prism render ./my-dashboard --version v2
 
+  # Re-render only recent versions (ranges are inclusive)
+  prism render ./my-dashboard --version ">=3"
+  prism render ./my-dashboard --version range:2-5 --all
+
   # Render for mobile viewport (375px)
   prism render ./my-dashboard --viewport mobile
 
   # Render at custom width
   prism render ./my-dashboard --width 1440
 
+  # Present a 375px mobile layout centered on a 1920px slide
+  prism render ./my-dashboard --width 1920 --max-width-canvas 375
+
+  # Transparent canvas for overlaying on slides or design docs
+  prism render ./my-dashboard --no-bg
+
   # Render with annotations and grid overlay
   prism render ./my-dashboard --annotations --grid
 
+  # Preview focus indicators on buttons and inputs
+  prism render ./my-dashboard --show-focus
+
+  # Check the keyboard journey: numbered badges joined by arrows
+  prism render ./my-dashboard --tab-order
+
+  # Spot alignment drift: guides through component edges, red where edges nearly meet
+  prism render ./my-dashboard --guides
+
+  # Render just one card (nested IDs work too), cropped to its box
+  prism render ./my-dashboard --component metrics
+
+  # Map image regions back to components (boxes are canvas pixels, after --scale)
+  prism render ./my-dashboard --scale 2 --manifest mockup-manifest.json
+
+  # Report layout notes such as ragged grid rows
+  prism render ./my-dashboard --check-layout
+
   # Render as SVG for web
   prism render ./my-dashboard --format svg
 
@@ -69,9 +109,18 @@ Examples:
   # Render all versions for comparison
   prism render ./my-dashboard --all
 
+  # Combine all versions into one labeled contact sheet, 4 per row
+  prism render ./my-dashboard --all --contact-sheet sheet.png --columns 4
+
+  # Render the user journey in flow.json, screens left to right with arrows
+  prism render ./my-app --flow
+
   # Custom output path
   prism render ./my-dashboard -o ./mockups/dashboard-v3.png
 
+  # Write all versions into a specific directory (created if missing)
+  prism render ./my-dashboard --all --out-dir ./review
+
   # High-resolution PDF for presentation
   prism render ./my-dashboard --format pdf --scale 2 -o presentation.pdf
 
@@ -79,6 +128,9 @@ Output Naming (when --output not specified):
   {project-name}-phase1-{version}.{format}
   Examples: my-dashboard-phase1-v1.png, my-dashboard-phase1-approved.svg
 
+  Files are written to --out-dir, else the project's mockups/ directory if it
+  exists, else the current directory.
+
 Related Commands:
   prism validate    Validate before rendering
   prism audit       Full validation report
@@ -91,17 +143,32 @@ For design process, see: DESIGNPROCESS.md`,
 
 func init() {
 	// Render-specific flags
-	renderCmd.Flags().StringP("version", "v", "latest", "Version to render (v1, v2, approved, latest)")
+	renderCmd.Flags().StringP("version", "v", "latest", "Version to render (v1, v2, approved, latest) or a range (>=3, range:2-5)")
 	renderCmd.Flags().StringP("output", "o", "", "Output file path (default: {project}-phase1-{version}.png)")
+	renderCmd.Flags().String("out-dir", "", "Directory for auto-named output files (default: {project}/mockups if it exists)")
 	renderCmd.Flags().IntP("width", "w", 1200, "Canvas width in pixels")
+	renderCmd.Flags().Int("max-width-canvas", 0, "Content column width in pixels, centered on the --width canvas (0 for the structure's layout.max_width)")
 	renderCmd.Flags().Int("height", 0, "Canvas height in pixels (0 for auto)")
 	renderCmd.Flags().IntP("scale", "s", 1, "Scale factor for high-DPI displays")
 	renderCmd.Flags().String("viewport", "desktop", "Target viewport (mobile, tablet, desktop)")
-	renderCmd.Flags().BoolP("annotations", "a", false, "Include annotations (IDs, dimensions)")
+	renderCmd.Flags().BoolP("annotations", "a", false, "Include annotations (IDs, dimensions) and a legend")
 	renderCmd.Flags().BoolP("grid", "g", false, "Show layout grid overlay")
+	renderCmd.Flags().Bool("show-focus", false, "Draw a 2px focus-ring preview around interactive components")
+	renderCmd.Flags().Bool("tab-order", false, "Draw numbered badges and arrows in keyboard focus order (tabindex, then document order)")
+	renderCmd.Flags().Bool("guides", false, "Draw alignment guides through top-level component edges (blue: shared, faint: single, red: misaligned)")
+	renderCmd.Flags().Bool("image-labels", false, "Label image placeholders with their component ID instead of \"IMAGE\"")
+	renderCmd.Flags().String("background", "", "Canvas fill color, e.g. #111827 for dark-mode previews (default white)")
+	renderCmd.Flags().Bool("no-bg", false, "Leave the canvas transparent instead of filling it, for compositing the PNG elsewhere")
+	renderCmd.Flags().String("component", "", "Render only the subtree rooted at this component ID, cropped to its box")
+	renderCmd.Flags().String("manifest", "", "Write a JSON manifest of rendered components and their boxes (canvas pixels, after --scale)")
+	renderCmd.Flags().Bool("check-layout", false, "Report layout notes (e.g. grids with a ragged last row)")
 	renderCmd.Flags().StringP("format", "f", "png", "Output format (png, svg, pdf)")
 	renderCmd.Flags().String("theme", "bw", "Color theme (bw, wireframe, blueprint)")
 	renderCmd.Flags().Bool("all", false, "Render all versions found in phase1-structure directory")
+	renderCmd.Flags().Bool("flow", false, "Render the screens listed in flow.json left to right as a flow diagram")
+	renderCmd.Flags().String("contact-sheet", "", "With --all, write a contact sheet of all versions to this PNG path")
+	renderCmd.Flags().Int("columns", 3, "Thumbnails per row on the contact sheet")
+	renderCmd.Flags().Int("jobs", 0, "With --all or a version range, render this many versions at once (default: GOMAXPROCS)")
 }
 
 func runRender(cmd *cobra.Command, args []string) error {
@@ -113,18 +180,86 @@ func runRender(cmd *cobra.Command, args []string) error {
 
 	versionFlag, _ := cmd.Flags().GetString("version")
 	outputPath, _ := cmd.Flags().GetString("output")
+	outDir, _ := cmd.Flags().GetString("out-dir")
 	width, _ := cmd.Flags().GetInt("width")
+	contentWidth, _ := cmd.Flags().GetInt("max-width-canvas")
 	height, _ := cmd.Flags().GetInt("height")
 	scale, _ := cmd.Flags().GetInt("scale")
 	viewport, _ := cmd.Flags().GetString("viewport")
 	annotations, _ := cmd.Flags().GetBool("annotations")
 	grid, _ := cmd.Flags().GetBool("grid")
+	showFocus, _ := cmd.Flags().GetBool("show-focus")
+	tabOrder, _ := cmd.Flags().GetBool("tab-order")
+	guides, _ := cmd.Flags().GetBool("guides")
+	imageLabels, _ := cmd.Flags().GetBool("image-labels")
+	background, _ := cmd.Flags().GetString("background")
+	noBackground, _ := cmd.Flags().GetBool("no-bg")
+	checkLayout, _ := cmd.Flags().GetBool("check-layout")
+	component, _ := cmd.Flags().GetString("component")
+	manifestPath, _ := cmd.Flags().GetString("manifest")
 	renderAll, _ := cmd.Flags().GetBool("all")
+	contactSheet, _ := cmd.Flags().GetString("contact-sheet")
+	renderFlow, _ := cmd.Flags().GetBool("flow")
+	columns, _ := cmd.Flags().GetInt("columns")
 	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
 
-	// If --all flag is set, render all versions
-	if renderAll {
-		return renderAllVersions(cmd, projectPath, width, height, scale, viewport, annotations, grid, outputJSON)
+	versions, isRange, err := parseVersionRange(versionFlag)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+
+	if contactSheet != "" && !renderAll && !isRange {
+		return fmt.Errorf("--contact-sheet requires --all or a version range")
+	}
+	if columns < 1 {
+		return fmt.Errorf("--columns must be at least 1")
+	}
+	if component != "" && (renderAll || isRange) {
+		return fmt.Errorf("--component cannot be combined with --all or a version range")
+	}
+	if renderFlow && (renderAll || isRange || component != "") {
+		return fmt.Errorf("--flow cannot be combined with --all, a version range or --component")
+	}
+	if manifestPath != "" && (renderAll || isRange || renderFlow) {
+		return fmt.Errorf("--manifest cannot be combined with --all, a version range or --flow")
+	}
+	if _, ok := render.ParseColor(background); background != "" && !ok {
+		return commandError(outputJSON, "", fmt.Errorf("invalid --background color '%s' (use a hex value such as #111827)", background))
+	}
+	if noBackground && background != "" {
+		return commandError(outputJSON, "", fmt.Errorf("--no-bg cannot be combined with --background"))
+	}
+	if contentWidth < 0 {
+		return commandError(outputJSON, "", fmt.Errorf("--max-width-canvas must not be negative"))
+	}
+
+	if renderFlow {
+		opts := prism.RenderOptions{
+			Width:        viewportWidth(viewport, width),
+			Height:       height,
+			Scale:        scale,
+			Viewport:     viewport,
+			Annotations:  annotations,
+			Grid:         grid,
+			ShowFocus:    showFocus,
+			TabOrder:     tabOrder,
+			Guides:       guides,
+			ImageLabels:  imageLabels,
+			Background:   background,
+			Transparent:  noBackground,
+			ContentWidth: contentWidth,
+			Log:          verboseLog(cmd),
+		}
+		return renderFlowDiagram(projectPath, outputPath, outDir, opts, outputJSON)
+	}
+
+	// If --all flag is set, render all versions; a version range renders just those
+	if renderAll || isRange {
+		var only *versionRange
+		if isRange {
+			only = &versions
+		}
+		return renderAllVersions(cmd, projectPath, outDir, width, height, scale, viewport, annotations, grid, showFocus, tabOrder, checkLayout, outputJSON, contactSheet, columns, only)
 	}
 
 	// Find the structure file
@@ -132,117 +267,58 @@ func runRender(cmd *cobra.Command, args []string) error {
 	
 	var structureFile string
 	if versionFlag == "approved" {
-		structureFile = filepath.Join(structurePath, "approved.json")
+		structureFile = structureFilePath(structurePath, "approved")
 	} else if versionFlag == "latest" {
 		// Find the highest version number
-		entries, err := os.ReadDir(structurePath)
+		latest, err := resolveLatestVersion(structurePath)
 		if err != nil {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  fmt.Sprintf("Failed to read directory: %v", err),
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("failed to read directory %s: %w", structurePath, err)
-		}
-
-		latestVersion := 0
-		for _, entry := range entries {
-			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
-				var version int
-				if _, err := fmt.Sscanf(entry.Name(), "v%d.json", &version); err == nil {
-					if version > latestVersion {
-						latestVersion = version
-						structureFile = filepath.Join(structurePath, entry.Name())
-					}
-				}
-			}
+			return commandError(outputJSON, "", err)
 		}
+		structureFile = latest
 	} else {
 		// Specific version
-		structureFile = filepath.Join(structurePath, versionFlag+".json")
+		structureFile = structureFilePath(structurePath, versionFlag)
 	}
 
 	if structureFile == "" {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  "No structure file found",
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("no structure file found in %s", structurePath)
+		return commandError(outputJSON, "", fmt.Errorf("no structure file found in %s", structurePath))
 	}
 
 	// Read and parse the structure
-	data, err := os.ReadFile(structureFile)
+	data, err := readStructureFile(structureFile)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"file":   structureFile,
-				"error":  fmt.Sprintf("Failed to read file: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read %s: %w", structureFile, err)
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to read %s: %w", structureFile, err))
 	}
 
-	structure, err := types.ParseAndValidateStructure(data)
+	structure, err := prism.ParseAndValidateStructure(data)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"file":   structureFile,
-				"error":  fmt.Sprintf("Failed to parse structure: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to parse structure: %w", err)
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to parse structure: %w", err))
 	}
 
-	// Adjust width based on viewport
-	if viewport == "mobile" {
-		width = 375
-	} else if viewport == "tablet" {
-		width = 768
-	} else if viewport == "desktop" && width == 1200 {
-		// Keep default
+	// Render options
+	width = viewportWidth(viewport, width)
+	opts := prism.RenderOptions{
+		Width:        width,
+		Height:       height,
+		Scale:        scale,
+		Viewport:     viewport,
+		Annotations:  annotations,
+		Grid:         grid,
+		ShowFocus:    showFocus,
+		TabOrder:     tabOrder,
+		Guides:       guides,
+		ImageLabels:  imageLabels,
+		Component:    component,
+		Background:   background,
+		Transparent:  noBackground,
+		ContentWidth: contentWidth,
+		Log:          verboseLog(cmd),
 	}
-
-	// Create renderer
-	opts := render.RenderOptions{
-		Width:       width,
-		Height:      height,
-		Scale:       scale,
-		Viewport:    viewport,
-		Annotations: annotations,
-		Grid:        grid,
-	}
-	renderer := render.NewRenderer(opts)
-
+	
 	// Render the structure
-	result, err := renderer.Render(structure)
+	result, metrics, err := measureRender(structure, opts)
 	if err != nil {
-		if outputJSON {
-			errResult := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Rendering failed: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(errResult)
-		}
-		return fmt.Errorf("rendering failed: %w", err)
+		return commandError(outputJSON, structureFile, fmt.Errorf("rendering failed: %w", err))
 	}
 
 	// Determine output path
@@ -251,21 +327,25 @@ func runRender(cmd *cobra.Command, args []string) error {
 		if baseName == "." || baseName == "/" {
 			baseName = "mockup"
 		}
-		outputPath = fmt.Sprintf("%s-phase1-%s.png", baseName, structure.Version)
+		dir, err := resolveOutputDir(projectPath, outDir)
+		if err != nil {
+			return commandError(outputJSON, structureFile, err)
+		}
+		name := fmt.Sprintf("%s-phase1-%s", baseName, structure.Version)
+		if component != "" {
+			name += "-" + component
+		}
+		outputPath = filepath.Join(dir, name+".png")
 	}
 
 	// Save the result
 	if err := result.SavePNG(outputPath); err != nil {
-		if outputJSON {
-			errResult := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to save PNG: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(errResult)
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to save PNG: %w", err))
+	}
+	if manifestPath != "" {
+		if err := writeManifest(manifestPath, render.BuildManifest(structure, result, scale)); err != nil {
+			return commandError(outputJSON, structureFile, err)
 		}
-		return fmt.Errorf("failed to save PNG: %w", err)
 	}
 
 	// Success
@@ -278,6 +358,19 @@ func runRender(cmd *cobra.Command, args []string) error {
 			"width":   result.Width,
 			"height":  result.Height,
 		}
+		if component != "" {
+			successResult["component"] = component
+		}
+		if manifestPath != "" {
+			successResult["manifest"] = manifestPath
+		}
+		metrics.addTo(successResult)
+		if result.Overflow > 0 {
+			successResult["overflow"] = result.Overflow
+		}
+		if checkLayout {
+			successResult["layout_warnings"] = layoutWarnings(result)
+		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
 		return enc.Encode(successResult)
@@ -285,14 +378,116 @@ func runRender(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("✅ Rendered %s\n", structureFile)
 	fmt.Printf("   Output: %s\n", outputPath)
+	if component != "" {
+		fmt.Printf("   Component: %s\n", component)
+	}
 	fmt.Printf("   Dimensions: %dx%d\n", result.Width, result.Height)
 	fmt.Printf("   Viewport: %s\n", viewport)
+	if manifestPath != "" {
+		fmt.Printf("   Manifest: %s\n", manifestPath)
+	}
+	if opts.Log != nil {
+		fmt.Printf("   Render: %s\n", metrics)
+	}
+	if result.Overflow > 0 {
+		fmt.Printf("   Overflow: ⚠️  %dpx of content continues below the fixed height\n", result.Overflow)
+	}
+	if checkLayout {
+		printLayoutWarnings(result)
+	}
+
+	return nil
+}
 
+// writeManifest saves the component manifest as indented JSON
+func writeManifest(path string, manifest render.Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
 	return nil
 }
 
-// renderAllVersions renders all JSON files found in the phase1-structure directory
-func renderAllVersions(cmd *cobra.Command, projectPath string, width, height, scale int, viewport string, annotations, grid, outputJSON bool) error {
+// renderMetrics records the cost of one render for profiling large structures
+type renderMetrics struct {
+	elapsed    time.Duration
+	pixels     int // canvas pixels, including the scale factor
+	components int
+}
+
+// measureRender renders the structure and times the call
+func measureRender(structure *types.Structure, opts prism.RenderOptions) (*render.RenderResult, renderMetrics, error) {
+	start := time.Now()
+	result, err := prism.Render(structure, opts)
+	metrics := renderMetrics{
+		elapsed:    time.Since(start),
+		components: types.ComputeStats(structure).TotalComponents,
+	}
+	if err != nil {
+		return nil, metrics, err
+	}
+	bounds := result.Image.Bounds()
+	metrics.pixels = bounds.Dx() * bounds.Dy()
+	return result, metrics, nil
+}
+
+// addTo adds render_ms, pixels and components to a JSON result
+func (m renderMetrics) addTo(entry map[string]interface{}) {
+	entry["render_ms"] = m.elapsed.Milliseconds()
+	entry["pixels"] = m.pixels
+	entry["components"] = m.components
+}
+
+// String formats the metrics for --verbose console output
+func (m renderMetrics) String() string {
+	return fmt.Sprintf("%s for %d components, %d pixels", m.elapsed.Round(time.Microsecond), m.components, m.pixels)
+}
+
+// layoutWarnings returns the layout notes of a render result, never nil
+func layoutWarnings(result *render.RenderResult) []render.LayoutWarning {
+	if result.Warnings == nil {
+		return []render.LayoutWarning{}
+	}
+	return result.Warnings
+}
+
+// printLayoutWarnings prints layout notes for console output
+func printLayoutWarnings(result *render.RenderResult) {
+	if len(result.Warnings) == 0 {
+		fmt.Println("   Layout: ✅ No layout notes")
+		return
+	}
+	fmt.Println("\n   Layout Notes:")
+	for _, warning := range result.Warnings {
+		fmt.Printf("     %s  %s\n", layoutNoteIcon(warning), warning.Message)
+	}
+}
+
+// layoutNoteIcon returns the console icon for a layout note's severity
+func layoutNoteIcon(warning render.LayoutWarning) string {
+	if warning.Severity == "warning" {
+		return "⚠️"
+	}
+	return "ℹ️"
+}
+
+// layoutStatus returns "warning" when any layout note has warning severity, otherwise
+// "passed"; info notes alone do not fail the layout check
+func layoutStatus(warnings []render.LayoutWarning) string {
+	for _, warning := range warnings {
+		if warning.Severity == "warning" {
+			return "warning"
+		}
+	}
+	return "passed"
+}
+
+// renderAllVersions renders all JSON files found in the phase1-structure directory,
+// or only the vN.json files inside the range when only is set
+func renderAllVersions(cmd *cobra.Command, projectPath, outDir string, width, height, scale int, viewport string, annotations, grid, showFocus, tabOrder, checkLayout, outputJSON bool, contactSheet string, columns int, only *versionRange) error {
 	structurePath := filepath.Join(projectPath, "phase1-structure")
 	
 	// Read all files in the directory
@@ -304,134 +499,136 @@ func renderAllVersions(cmd *cobra.Command, projectPath string, width, height, sc
 	// Collect all JSON files
 	var jsonFiles []string
 	for _, entry := range entries {
-		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
+		if _, ok := structureName(entry.Name()); ok && !entry.IsDir() {
+			if only != nil {
+				if v, ok := versionNumber(entry.Name()); !ok || !only.contains(v) {
+					continue
+				}
+			}
 			jsonFiles = append(jsonFiles, entry.Name())
 		}
 	}
+	sortVersionFiles(jsonFiles)
 
+	if len(jsonFiles) == 0 && only != nil {
+		return commandError(outputJSON, "", fmt.Errorf("no versions in %s match %s", structurePath, only))
+	}
 	if len(jsonFiles) == 0 {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  "No JSON files found in phase1-structure directory",
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("no JSON files found in %s", structurePath)
+		return commandError(outputJSON, "", fmt.Errorf("no JSON files found in %s", structurePath))
+	}
+
+	dir, err := resolveOutputDir(projectPath, outDir)
+	if err != nil {
+		return commandError(outputJSON, "", err)
 	}
 
 	projectName := filepath.Base(projectPath)
 	results := []map[string]interface{}{}
 	successCount := 0
 	failCount := 0
+	var sheetEntries []render.ContactSheetEntry
+	progress := newBatchProgress(progressLog(cmd), len(jsonFiles))
+
+	renderWidth := viewportWidth(viewport, width)
+
+	// Render options
+	imageLabels, _ := cmd.Flags().GetBool("image-labels")
+	background, _ := cmd.Flags().GetString("background")
+	noBackground, _ := cmd.Flags().GetBool("no-bg")
+	contentWidth, _ := cmd.Flags().GetInt("max-width-canvas")
+	guides, _ := cmd.Flags().GetBool("guides")
+	opts := prism.RenderOptions{
+		Width:        renderWidth,
+		Height:       height,
+		Scale:        scale,
+		Viewport:     viewport,
+		Annotations:  annotations,
+		Grid:         grid,
+		ShowFocus:    showFocus,
+		TabOrder:     tabOrder,
+		Guides:       guides,
+		ImageLabels:  imageLabels,
+		Background:   background,
+		Transparent:  noBackground,
+		ContentWidth: contentWidth,
+		Log:          verboseLog(cmd),
+	}
 
-	// Render each file
-	for _, jsonFile := range jsonFiles {
-		structureFile := filepath.Join(structurePath, jsonFile)
-		versionName := jsonFile[:len(jsonFile)-5] // Remove .json extension
-
-		// Read and parse the structure
-		data, err := os.ReadFile(structureFile)
-		if err != nil {
-			if outputJSON {
-				results = append(results, map[string]interface{}{
-					"version": versionName,
-					"status":  "error",
-					"error":   fmt.Sprintf("Failed to read file: %v", err),
-				})
-			} else {
-				fmt.Printf("❌ Failed to render %s: %v\n", versionName, err)
-			}
-			failCount++
-			continue
-		}
-
-		structure, err := types.ParseAndValidateStructure(data)
-		if err != nil {
-			if outputJSON {
-				results = append(results, map[string]interface{}{
-					"version": versionName,
-					"status":  "error",
-					"error":   fmt.Sprintf("Failed to parse structure: %v", err),
-				})
-			} else {
-				fmt.Printf("❌ Failed to render %s: %v\n", versionName, err)
-			}
-			failCount++
-			continue
-		}
-
-		// Adjust width based on viewport
-		renderWidth := width
-		if viewport == "mobile" {
-			renderWidth = 375
-		} else if viewport == "tablet" {
-			renderWidth = 768
-		}
-
-		// Create renderer
-		opts := render.RenderOptions{
-			Width:       renderWidth,
-			Height:      height,
-			Scale:       scale,
-			Viewport:    viewport,
-			Annotations: annotations,
-			Grid:        grid,
-		}
-		renderer := render.NewRenderer(opts)
+	var renderTime time.Duration // summed across workers, so it can exceed the elapsed time
+	jobs, _ := cmd.Flags().GetInt("jobs")
+	batch := batchRender{
+		structurePath: structurePath,
+		outDir:        dir,
+		projectName:   projectName,
+		opts:          opts,
+		keepImages:    contactSheet != "",
+		progress:      progress,
+	}
 
-		// Render to PNG
-		result, err := renderer.Render(structure)
-		if err != nil {
+	// Report each file in version order, whichever worker finished it first
+	for _, version := range batch.run(jsonFiles, jobs) {
+		if version.err != nil {
 			if outputJSON {
 				results = append(results, map[string]interface{}{
-					"version": versionName,
+					"version": version.name,
 					"status":  "error",
-					"error":   fmt.Sprintf("Render failed: %v", err),
+					"error":   fmt.Sprintf("%s: %v", batchFailureMessages[version.stage], version.err),
 				})
+			} else if version.stage == "save" {
+				fmt.Printf("❌ Failed to save %s: %v\n", version.name, version.err)
 			} else {
-				fmt.Printf("❌ Failed to render %s: %v\n", versionName, err)
+				fmt.Printf("❌ Failed to render %s: %v\n", version.name, version.err)
 			}
 			failCount++
 			continue
 		}
 
-		// Save the file
-		outputPath := fmt.Sprintf("%s-phase1-%s.png", projectName, versionName)
-		if err := result.SavePNG(outputPath); err != nil {
-			if outputJSON {
-				results = append(results, map[string]interface{}{
-					"version": versionName,
-					"status":  "error",
-					"error":   fmt.Sprintf("Failed to save file: %v", err),
-				})
-			} else {
-				fmt.Printf("❌ Failed to save %s: %v\n", versionName, err)
-			}
-			failCount++
-			continue
+		result := version.result
+		if contactSheet != "" {
+			sheetEntries = append(sheetEntries, render.ContactSheetEntry{Label: version.name, Image: result.Image})
 		}
 
 		// Success
 		if outputJSON {
-			results = append(results, map[string]interface{}{
-				"version": versionName,
+			entry := map[string]interface{}{
+				"version": version.name,
 				"status":  "success",
-				"file":    structureFile,
-				"output":  outputPath,
+				"file":    version.file,
+				"output":  version.output,
 				"width":   result.Width,
 				"height":  result.Height,
-			})
+			}
+			version.metrics.addTo(entry)
+			if checkLayout {
+				entry["layout_warnings"] = layoutWarnings(result)
+			}
+			results = append(results, entry)
 		} else {
-			fmt.Printf("✅ Rendered %s\n", versionName)
-			fmt.Printf("   Output: %s\n", outputPath)
+			fmt.Printf("✅ Rendered %s\n", version.name)
+			fmt.Printf("   Output: %s\n", version.output)
 			fmt.Printf("   Dimensions: %dx%d\n", result.Width, result.Height)
+			if opts.Log != nil {
+				fmt.Printf("   Render: %s\n", version.metrics)
+			}
+			if checkLayout {
+				printLayoutWarnings(result)
+			}
 		}
+		renderTime += version.metrics.elapsed
 		successCount++
 	}
 
+	// Compose the contact sheet from the successful renders
+	var sheetWidth, sheetHeight int
+	if contactSheet != "" && len(sheetEntries) > 0 {
+		sheet := render.ComposeContactSheet(sheetEntries, render.ContactSheetOptions{Columns: columns})
+		sheetResult := &render.RenderResult{Image: sheet, Width: sheet.Bounds().Dx(), Height: sheet.Bounds().Dy()}
+		if err := sheetResult.SavePNG(contactSheet); err != nil {
+			return commandError(outputJSON, "", fmt.Errorf("failed to save contact sheet: %w", err))
+		}
+		sheetWidth, sheetHeight = sheetResult.Width, sheetResult.Height
+	}
+
 	// Output summary
 	if outputJSON {
 		summary := map[string]interface{}{
@@ -444,20 +641,74 @@ func renderAllVersions(cmd *cobra.Command, projectPath string, width, height, sc
 			"viewport":      viewport,
 			"render_width":  width,
 			"render_height": height,
+			"elapsed_ms":    progress.elapsed().Milliseconds(),
+			"render_ms":     renderTime.Milliseconds(),
 			"results":       results,
 		}
+		if sheetWidth > 0 {
+			summary["contact_sheet"] = map[string]interface{}{
+				"output":  contactSheet,
+				"columns": columns,
+				"width":   sheetWidth,
+				"height":  sheetHeight,
+			}
+		}
 		enc := json.NewEncoder(os.Stdout)
 		enc.SetIndent("", "  ")
-		return enc.Encode(summary)
+		if err := enc.Encode(summary); err != nil {
+			return err
+		}
+		// The summary already reports the failures; only the exit status is left
+		if failCount > 0 && successCount == 0 {
+			return &reportedError{err: fmt.Errorf("all batch renders failed")}
+		}
+		return nil
 	}
 
 	fmt.Printf("\n📊 Batch rendering complete:\n")
 	fmt.Printf("   Total: %d versions\n", len(jsonFiles))
 	fmt.Printf("   Success: %d\n", successCount)
 	fmt.Printf("   Failed: %d\n", failCount)
+	fmt.Printf("   Elapsed: %s\n", progress.elapsed())
+	if opts.Log != nil {
+		fmt.Printf("   Render time: %s (summed across versions)\n", renderTime.Round(time.Millisecond))
+	}
+	if sheetWidth > 0 {
+		fmt.Printf("   Contact sheet: %s (%dx%d)\n", contactSheet, sheetWidth, sheetHeight)
+	}
 
 	if failCount > 0 && successCount == 0 {
 		return fmt.Errorf("all batch renders failed")
 	}
 	return nil
 }
+
+// viewportWidth returns the canvas width for a viewport preset: 375 for mobile, 768
+// for tablet, otherwise the --width value
+func viewportWidth(viewport string, width int) int {
+	switch viewport {
+	case "mobile":
+		return 375
+	case "tablet":
+		return 768
+	}
+	return width
+}
+
+// resolveOutputDir picks the directory for auto-named render output: the explicit
+// --out-dir (created if missing), else the project's mockups/ directory if it
+// exists, else the current directory ("")
+func resolveOutputDir(projectPath, outDir string) (string, error) {
+	if outDir != "" {
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create output directory %s: %w", outDir, err)
+		}
+		return outDir, nil
+	}
+
+	mockups := filepath.Join(projectPath, "mockups")
+	if info, err := os.Stat(mockups); err == nil && info.IsDir() {
+		return mockups, nil
+	}
+	return "", nil
+}
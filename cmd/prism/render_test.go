@@ -0,0 +1,99 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveOutputDir(t *testing.T) {
+	project := t.TempDir()
+
+	// Without --out-dir or a mockups/ directory, output goes to the current directory
+	if dir, err := resolveOutputDir(project, ""); err != nil || dir != "" {
+		t.Errorf("Expected the current directory, got %q (%v)", dir, err)
+	}
+
+	// A project mockups/ directory is used once it exists
+	mockups := filepath.Join(project, "mockups")
+	if err := os.Mkdir(mockups, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if dir, err := resolveOutputDir(project, ""); err != nil || dir != mockups {
+		t.Errorf("Expected %s, got %q (%v)", mockups, dir, err)
+	}
+
+	// An explicit --out-dir wins, and is created when missing
+	explicit := filepath.Join(t.TempDir(), "review", "round-1")
+	if dir, err := resolveOutputDir(project, explicit); err != nil || dir != explicit {
+		t.Errorf("Expected %s, got %q (%v)", explicit, dir, err)
+	}
+	if info, err := os.Stat(explicit); err != nil || !info.IsDir() {
+		t.Errorf("Expected --out-dir to be created, got %v", err)
+	}
+
+	// A file in the way is an error
+	blocked := filepath.Join(project, "blocked")
+	if err := os.WriteFile(blocked, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolveOutputDir(project, filepath.Join(blocked, "out")); err == nil || !strings.Contains(err.Error(), "failed to create output directory") {
+		t.Errorf("Expected a create error, got %v", err)
+	}
+}
+
+func TestRender_OutDir(t *testing.T) {
+	project := filepath.Join(t.TempDir(), "dashboard")
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.MkdirAll(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	structure := `{
+		"version": "v1",
+		"phase": "structure",
+		"intent": {"purpose": "Dashboard"},
+		"layout": {"type": "stack"},
+		"components": [{"id": "title", "type": "text", "content": "Dashboard"}]
+	}`
+	if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(structure), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Default: the project's mockups/ directory
+	if err := os.Mkdir(filepath.Join(project, "mockups"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if stdout, code := runPrism(t, "render", project); code != 0 {
+		t.Fatalf("Expected render to succeed, got %d: %s", code, stdout)
+	}
+	if _, err := os.Stat(filepath.Join(project, "mockups", "dashboard-phase1-v1.png")); err != nil {
+		t.Errorf("Expected the mockup in mockups/: %v", err)
+	}
+
+	// --out-dir names a directory that does not exist yet
+	outDir := filepath.Join(t.TempDir(), "review")
+	if stdout, code := runPrism(t, "render", project, "--out-dir", outDir); code != 0 {
+		t.Fatalf("Expected render to succeed, got %d: %s", code, stdout)
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "dashboard-phase1-v1.png")); err != nil {
+		t.Errorf("Expected the mockup in --out-dir: %v", err)
+	}
+}
+
+func TestViewportWidth(t *testing.T) {
+	for _, tt := range []struct {
+		viewport string
+		width    int
+		expected int
+	}{
+		{"mobile", 1200, 375},
+		{"tablet", 1200, 768},
+		{"desktop", 1200, 1200},
+		{"desktop", 900, 900},
+	} {
+		if got := viewportWidth(tt.viewport, tt.width); got != tt.expected {
+			t.Errorf("viewportWidth(%q, %d) = %d, expected %d", tt.viewport, tt.width, got, tt.expected)
+		}
+	}
+}
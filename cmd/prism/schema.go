@@ -0,0 +1,30 @@
+package main
+
+import (
+	"os"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/spf13/cobra"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Print the JSON Schema for structure files",
+	Long: `Print the JSON Schema (draft-07) describing structure files, so editors can
+autocomplete and validate them inline.
+
+The schema describes the file format. Phase 1 constraints such as the grayscale
+palette are checked by prism validate, not by the schema.
+
+Examples:
+  # Save the schema next to your structures
+  prism schema > prism.schema.json
+
+  # Then map it in VS Code's settings.json:
+  #   "json.schemas": [{"fileMatch": ["phase1-structure/*.json"], "url": "./prism.schema.json"}]`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		_, err := os.Stdout.Write(types.JSONSchema())
+		return err
+	},
+}
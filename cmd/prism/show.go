@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/johanbellander/prism/internal/types"
 	"github.com/spf13/cobra"
@@ -17,118 +19,78 @@ var showCmd = &cobra.Command{
 
 Examples:
   prism show v1
-  prism show v2 --json`,
+  prism show v2 --json
+
+  # Show the full component tree with designer notes
+  prism show v2 --tree
+
+  # Print only the component tree as a JSON array, for other generators
+  prism show v2 --components-only --json`,
 	Args: cobra.ExactArgs(1),
 	RunE: runShow,
 }
 
+func init() {
+	showCmd.Flags().Bool("tree", false, "Show the nested component tree with notes and metadata")
+	showCmd.Flags().Bool("components-only", false, "Print only the component tree as a JSON array, without intent or other metadata")
+}
+
 func runShow(cmd *cobra.Command, args []string) error {
 	// Get flags
 	version := args[0]
 	projectPath, _ := cmd.Parent().PersistentFlags().GetString("project")
 	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
+	showTree, _ := cmd.Flags().GetBool("tree")
+	componentsOnly, _ := cmd.Flags().GetBool("components-only")
+
+	if componentsOnly && showTree {
+		return commandError(outputJSON, "", fmt.Errorf("--components-only cannot be combined with --tree"))
+	}
 
 	// Find the structure file
 	structurePath := filepath.Join(projectPath, "phase1-structure")
 	
 	// Determine the file name
-	var fileName string
-	if version == "approved" || version == "latest" {
-		fileName = version + ".json"
-	} else {
-		fileName = version + ".json"
-	}
-
-	filePath := filepath.Join(structurePath, fileName)
+	filePath := structureFilePath(structurePath, version)
+	fileName := filepath.Base(filePath)
 
 	// If "latest", find the highest version number
 	if version == "latest" {
-		entries, err := os.ReadDir(structurePath)
+		latest, err := resolveLatestVersion(structurePath)
 		if err != nil {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  fmt.Sprintf("Failed to read directory: %v", err),
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("failed to read directory %s: %w", structurePath, err)
-		}
-
-		latestVersion := 0
-		for _, entry := range entries {
-			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
-				var v int
-				if _, err := fmt.Sscanf(entry.Name(), "v%d.json", &v); err == nil {
-					if v > latestVersion {
-						latestVersion = v
-						filePath = filepath.Join(structurePath, entry.Name())
-						fileName = entry.Name()
-					}
-				}
-			}
-		}
-
-		if latestVersion == 0 {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  "No versions found",
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("no versions found in %s", structurePath)
+			return commandError(outputJSON, "", err)
 		}
+		filePath = latest
+		fileName = filepath.Base(latest)
 	}
 
 	// Check if file exists
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Version '%s' not found", version),
-				"path":   filePath,
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("version '%s' not found at %s", version, filePath)
+		return commandErrorFields(outputJSON, map[string]interface{}{
+			"path": filePath,
+		}, fmt.Errorf("version '%s' not found at %s", version, filePath))
 	}
 
 	// Read and parse the file
-	data, err := os.ReadFile(filePath)
+	data, err := readStructureFile(filePath)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"file":   filePath,
-				"error":  fmt.Sprintf("Failed to read file: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read %s: %w", filePath, err)
+		return commandError(outputJSON, filePath, fmt.Errorf("failed to read %s: %w", filePath, err))
 	}
 
 	structure, err := types.ParseStructure(data)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"file":   filePath,
-				"error":  fmt.Sprintf("Failed to parse structure: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
+		return commandError(outputJSON, filePath, fmt.Errorf("failed to parse structure: %w", err))
+	}
+
+	// The bare component array is standalone JSON for transformation pipelines
+	if componentsOnly {
+		components := structure.Components
+		if components == nil {
+			components = []types.Component{}
 		}
-		return fmt.Errorf("failed to parse structure: %w", err)
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(components)
 	}
 
 	// Output results
@@ -187,16 +149,21 @@ func runShow(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("\n--- Components ---\n")
 	fmt.Printf("Total Components: %d\n", len(structure.Components))
-	for i, comp := range structure.Components {
-		fmt.Printf("\n%d. %s (%s)\n", i+1, comp.ID, comp.Type)
-		if comp.Role != "" {
-			fmt.Printf("   Role: %s\n", comp.Role)
-		}
-		if comp.Content != "" {
-			fmt.Printf("   Content: %s\n", comp.Content)
-		}
-		if len(comp.Children) > 0 {
-			fmt.Printf("   Children: %d\n", len(comp.Children))
+	if showTree {
+		fmt.Println()
+		printComponentTree(structure.Components, "")
+	} else {
+		for i, comp := range structure.Components {
+			fmt.Printf("\n%d. %s (%s)\n", i+1, comp.ID, comp.Type)
+			if comp.Role != "" {
+				fmt.Printf("   Role: %s\n", comp.Role)
+			}
+			if comp.Content != "" {
+				fmt.Printf("   Content: %s\n", comp.Content)
+			}
+			if len(comp.Children) > 0 {
+				fmt.Printf("   Children: %d\n", len(comp.Children))
+			}
 		}
 	}
 
@@ -229,3 +196,41 @@ func runShow(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// printComponentTree prints components as an indented tree, with each
+// component's note and metadata beneath it
+func printComponentTree(components []types.Component, prefix string) {
+	for i, comp := range components {
+		branch, indent := "├── ", "│   "
+		if i == len(components)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		line := fmt.Sprintf("%s (%s)", comp.ID, comp.Type)
+		if comp.Role != "" {
+			line += fmt.Sprintf(" [%s]", comp.Role)
+		}
+		if comp.Content != "" {
+			line += fmt.Sprintf(" %q", comp.Content)
+		}
+		fmt.Printf("%s%s%s\n", prefix, branch, line)
+
+		if comp.Note != "" {
+			fmt.Printf("%s%s📝 %s\n", prefix, indent, comp.Note)
+		}
+		if len(comp.Meta) > 0 {
+			keys := make([]string, 0, len(comp.Meta))
+			for key := range comp.Meta {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			pairs := make([]string, len(keys))
+			for j, key := range keys {
+				pairs[j] = key + "=" + comp.Meta[key]
+			}
+			fmt.Printf("%s%s   %s\n", prefix, indent, strings.Join(pairs, ", "))
+		}
+
+		printComponentTree(comp.Children, prefix+indent)
+	}
+}
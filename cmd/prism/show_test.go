@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestShow_ComponentsOnly(t *testing.T) {
+	project := t.TempDir()
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	structure := `{
+		"version": "v2",
+		"phase": "structure",
+		"intent": {"purpose": "Sign in"},
+		"layout": {"type": "stack"},
+		"components": [
+			{"id": "form", "type": "box", "children": [
+				{"id": "email", "type": "input"},
+				{"id": "actions", "type": "box", "children": [{"id": "submit", "type": "button", "content": "Sign in"}]}
+			]},
+			{"id": "footer", "type": "text", "content": "Help"}
+		]
+	}`
+	if err := os.WriteFile(filepath.Join(structurePath, "v2.json"), []byte(structure), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, code := runPrism(t, "show", "v2", "--components-only", "--json", "--project", project)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stdout)
+	}
+
+	// The output is a bare array, with no intent or status fields around it
+	var components []types.Component
+	if err := json.Unmarshal(stdout, &components); err != nil {
+		t.Fatalf("Expected a standalone JSON array, got %v: %s", err, stdout)
+	}
+	if len(components) != 2 || components[0].ID != "form" || components[1].ID != "footer" {
+		t.Fatalf("Expected the top-level components form and footer, got %+v", components)
+	}
+	if children := components[0].Children; len(children) != 2 || children[1].ID != "actions" {
+		t.Fatalf("Expected form's children to nest, got %+v", children)
+	}
+	if grandchildren := components[0].Children[1].Children; len(grandchildren) != 1 || grandchildren[0].Content != "Sign in" {
+		t.Errorf("Expected the submit button nested under actions, got %+v", grandchildren)
+	}
+
+	if _, code := runPrism(t, "show", "v2", "--components-only", "--tree", "--json", "--project", project); code == 0 {
+		t.Error("Expected --components-only with --tree to fail")
+	}
+}
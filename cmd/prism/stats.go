@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/spf13/cobra"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [project-path]",
+	Short: "Summarize a structure's size and consistency",
+	Long: `Print aggregate metrics for a structure without running validators.
+
+Metrics:
+  - Total components and maximum nesting depth
+  - Component counts by type and by role
+  - Number of interactive elements (buttons, inputs)
+  - Distinct colors, text sizes and spacing values in use
+
+Examples:
+  # Stats for the latest version
+  prism stats ./my-dashboard
+
+  # Stats for a specific version
+  prism stats ./my-dashboard --version v2
+
+  # Get JSON output
+  prism stats ./my-dashboard --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().StringP("version", "v", "latest", "Version to summarize (v1, v2, approved, latest)")
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	// Get flags
+	projectPath := "./"
+	if len(args) > 0 {
+		projectPath = args[0]
+	}
+
+	versionFlag, _ := cmd.Flags().GetString("version")
+	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
+
+	// Find and parse the structure file
+	structurePath := filepath.Join(projectPath, "phase1-structure")
+	structureFile, err := findStructureFile(structurePath, versionFlag)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+
+	data, err := readStructureFile(structureFile)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to read %s: %w", structureFile, err))
+	}
+
+	structure, err := types.ParseStructure(data)
+	if err != nil {
+		return commandError(outputJSON, structureFile, fmt.Errorf("failed to parse structure: %w", err))
+	}
+
+	stats := types.ComputeStats(structure)
+
+	if outputJSON {
+		result := map[string]interface{}{
+			"status":  "success",
+			"command": "stats",
+			"file":    structureFile,
+			"version": structure.Version,
+			"stats":   stats,
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	fmt.Printf("📊 Stats for %s\n", structureFile)
+	fmt.Printf("   Version: %s\n", structure.Version)
+	fmt.Printf("   Components: %d\n", stats.TotalComponents)
+	fmt.Printf("   Max Depth: %d (limit %d)\n", stats.MaxDepth, types.MaxNestingDepth)
+	fmt.Printf("   Interactive Elements: %d\n", stats.InteractiveElements)
+
+	fmt.Println("\n   By Type:")
+	printStatsCounts(stats.ByType)
+
+	if len(stats.ByRole) > 0 {
+		fmt.Println("\n   By Role:")
+		printStatsCounts(stats.ByRole)
+	}
+
+	fmt.Printf("\n   Colors (%d): %s\n", len(stats.Colors), strings.Join(stats.Colors, ", "))
+	fmt.Printf("   Text Sizes (%d): %s\n", len(stats.TextSizes), strings.Join(stats.TextSizes, ", "))
+
+	spacing := make([]string, len(stats.SpacingValues))
+	for i, value := range stats.SpacingValues {
+		spacing[i] = fmt.Sprintf("%dpx", value)
+	}
+	fmt.Printf("   Spacing Values (%d): %s\n", len(stats.SpacingValues), strings.Join(spacing, ", "))
+
+	return nil
+}
+
+// printStatsCounts prints a count map sorted by descending count, then name
+func printStatsCounts(counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		fmt.Printf("     %-12s %d\n", key, counts[key])
+	}
+}
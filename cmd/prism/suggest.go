@@ -119,57 +119,30 @@ func runSuggest(cmd *cobra.Command, args []string) error {
 	structurePath := filepath.Join(projectPath, "phase1-structure")
 	
 	var structureFile string
-	if _, err := os.Stat(filepath.Join(structurePath, "approved.json")); err == nil {
-		structureFile = filepath.Join(structurePath, "approved.json")
+	if _, err := os.Stat(structureFilePath(structurePath, "approved")); err == nil {
+		structureFile = structureFilePath(structurePath, "approved")
 	} else {
 		// Find latest version
-		files, err := filepath.Glob(filepath.Join(structurePath, "v*.json"))
-		if err != nil || len(files) == 0 {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  "No structure files found in " + structurePath,
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("no structure files found in %s", structurePath)
+		latest, err := resolveLatestVersion(structurePath)
+		if err != nil {
+			return commandError(outputJSON, "", err)
 		}
-		structureFile = files[len(files)-1]
+		structureFile = latest
 	}
 
 	// Load and parse the structure
-	data, err := os.ReadFile(structureFile)
+	data, err := readStructureFile(structureFile)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to read file: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read file: %w", err)
+		return commandError(outputJSON, "", fmt.Errorf("failed to read file: %w", err))
 	}
 
-	var structure types.Structure
-	if err := json.Unmarshal(data, &structure); err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Failed to parse JSON: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to parse JSON: %w", err)
+	structure, err := types.ParseStructure(data)
+	if err != nil {
+		return commandError(outputJSON, "", err)
 	}
 
 	// Generate suggestions
-	result := validate.GenerateSuggestions(&structure, category)
+	result := validate.GenerateSuggestions(structure, category)
 
 	// Output results
 	if outputJSON {
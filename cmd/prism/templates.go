@@ -0,0 +1,35 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// structureTemplates holds the starting structures offered by onboard --template
+//
+//go:embed templates/*.json
+var structureTemplates embed.FS
+
+// templateNames returns the bundled template names in sorted order
+func templateNames() []string {
+	entries, _ := fs.ReadDir(structureTemplates, "templates")
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// structureTemplate returns the bundled v1 structure for the named template
+func structureTemplate(name string) ([]byte, error) {
+	data, err := structureTemplates.ReadFile(path.Join("templates", name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("unknown template '%s' (must be one of %s)", name, strings.Join(templateNames(), ", "))
+	}
+	return data, nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/pkg/prism"
+)
+
+func TestStructureTemplates_Validate(t *testing.T) {
+	names := templateNames()
+	for _, want := range []string{"dashboard", "form", "landing", "login"} {
+		if _, err := structureTemplate(want); err != nil {
+			t.Errorf("Expected a bundled %s template: %v", want, err)
+		}
+	}
+
+	for _, name := range names {
+		data, err := structureTemplate(name)
+		if err != nil {
+			t.Fatalf("structureTemplate(%q) failed: %v", name, err)
+		}
+		structure, err := prism.ParseAndValidateStructure(data)
+		if err != nil {
+			t.Errorf("Template %s fails validation: %v", name, err)
+			continue
+		}
+		if structure.Version != "v1" {
+			t.Errorf("Template %s should start at v1, got %s", name, structure.Version)
+		}
+	}
+}
+
+func TestStructureTemplate_Unknown(t *testing.T) {
+	if _, err := structureTemplate("checkout"); err == nil {
+		t.Error("Expected an error for an unknown template")
+	}
+}
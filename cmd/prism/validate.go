@@ -1,13 +1,18 @@
 package main
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 	"github.com/johanbellander/prism/internal/validate"
+	"github.com/johanbellander/prism/pkg/prism"
 	"github.com/spf13/cobra"
 )
 
@@ -26,16 +31,24 @@ Validation Categories:
     --gestalt            Gestalt principles (proximity, similarity, continuity)
     --accessibility      WCAG compliance (labels, heading order, focus states)
     --choice-overload    Choice overload (Hick's Law, max 7 nav items)
+    --layout             Layout notes from the render engine (ragged grid rows)
+    --content-length     Text content that is too long for its component's width
+    --images             Image slots with an extreme aspect ratio (e.g. 20:1)
+    --reading-order      Document order that differs from the on-screen order
+    --line-length        Body text running past ~80 characters per line
 
   Phase 2 (Visual Design):
     --contrast           Color contrast (WCAG AA: 4.5:1 text, 3:1 UI)
+    --wcag AAA           Enforce WCAG AAA contrast instead (7:1 text, 4.5:1 large text)
     --typography         Typography scale (consistent ratios, 8-10 sizes)
     --spacing            8pt grid compliance (multiples of 4 or 8)
     --elevation          Shadow/elevation system (3-4 levels)
     --loading-states     Loading indicators and skeleton screens
     --responsive         Responsive breakpoints (mobile, tablet, desktop)
+    --viewport-width 375 Flag fixed widths that overflow this viewport as errors
     --focus              Focus indicator visibility (2px outline, 3:1 contrast)
     --dark-mode          Dark mode support (separate palette, contrast)
+    --palette            Colors outside the declared palette (structure, else config)
 
 Severity Levels:
   🔴 CRITICAL  - Must fix (accessibility violations, WCAG failures)
@@ -63,31 +76,75 @@ Examples:
   # Validate Phase 2 design (contrast, typography, etc.)
   prism validate ./my-dashboard --phase 2 --contrast
 
+  # Gate on WCAG AAA contrast
+  prism validate ./my-dashboard --wcag AAA
+
+  # Explain the principle behind each issue and how to fix it
+  prism validate ./my-dashboard --contrast --spacing --explain
+
   # Run multiple validators
   prism validate ./my-dashboard --hierarchy --touch-targets --gestalt
 
+  # Validate every version from v2 through v5 (inclusive)
+  prism validate ./my-dashboard --version range:2-5
+
 For comprehensive audits, use: prism audit ./my-dashboard
 For documentation, see: VALIDATION_RULES.md`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runValidate,
 }
 
+// validatorFlags lists the validate flags that select a validator
+var validatorFlags = validate.ValidatorFlags()
+
+// applyConfiguredValidators enables the config's default validators when no
+// validator flag was given on the command line
+func applyConfiguredValidators(cmd *cobra.Command) error {
+	flagged := []string{}
+	for _, name := range validatorFlags {
+		if enabled, _ := cmd.Flags().GetBool(name); enabled {
+			flagged = append(flagged, name)
+		}
+	}
+
+	for _, name := range projectConfig.SelectValidators(flagged) {
+		name = strings.ReplaceAll(name, "_", "-")
+		if !slices.Contains(validatorFlags, name) {
+			return fmt.Errorf("unknown validator '%s' in config (known: %s)", name, strings.Join(validatorFlags, ", "))
+		}
+		if err := cmd.Flags().Set(name, "true"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func init() {
 	// Validate-specific flags
 	validateCmd.Flags().Int("phase", 1, "Phase to validate against (1 or 2)")
+	validateCmd.Flags().StringP("version", "v", "", "Version to validate (v1, approved, latest) or a range (>=3, range:2-5); default approved, else latest")
 	validateCmd.Flags().Bool("hierarchy", false, "Run visual hierarchy validation")
 	validateCmd.Flags().Bool("touch-targets", false, "Run touch target and spacing validation")
 	validateCmd.Flags().Bool("gestalt", false, "Run Gestalt principles validation (proximity and similarity)")
 	validateCmd.Flags().Bool("accessibility", false, "Run accessibility (WCAG) validation")
 	validateCmd.Flags().Bool("choice-overload", false, "Run choice overload (Hick's Law) validation")
 	validateCmd.Flags().Bool("contrast", false, "Run color contrast (WCAG) validation")
+	validateCmd.Flags().String("wcag", "AA", "WCAG contrast level to enforce: AA or AAA (implies --contrast when set)")
 	validateCmd.Flags().Bool("spacing", false, "Run spacing scale (8pt grid) validation")
 	validateCmd.Flags().Bool("typography", false, "Run typography scale validation")
 	validateCmd.Flags().Bool("elevation", false, "Run shadow/elevation system validation")
 	validateCmd.Flags().Bool("loading-states", false, "Run loading states and skeleton screen validation")
 	validateCmd.Flags().Bool("responsive", false, "Run responsive breakpoint validation (mobile, tablet, desktop)")
+	validateCmd.Flags().Int("viewport-width", 0, "Target viewport width; fixed-width components wider than it are errors (implies --responsive when set)")
 	validateCmd.Flags().Bool("focus", false, "Run focus indicator validation for interactive elements")
 	validateCmd.Flags().Bool("dark-mode", false, "Run dark mode support validation")
+	validateCmd.Flags().Bool("palette", false, "Run palette adherence validation (the structure's palette, else the config palette)")
+	validateCmd.Flags().Bool("content-length", false, "Run content length validation (text too wide for its component)")
+	validateCmd.Flags().Bool("images", false, "Run image dimension validation (extreme aspect ratios)")
+	validateCmd.Flags().Bool("reading-order", false, "Run reading order validation (document order vs. visual order)")
+	validateCmd.Flags().Bool("line-length", false, "Run line length validation (body text over ~80 characters per line)")
+	validateCmd.Flags().Bool("layout", false, "Report layout notes from the render engine (e.g. grids with a ragged last row)")
+	validateCmd.Flags().Bool("explain", false, "Explain the principle behind each issue category and how to fix it")
 }
 
 func runValidate(cmd *cobra.Command, args []string) error {
@@ -99,119 +156,206 @@ func runValidate(cmd *cobra.Command, args []string) error {
 
 	phase, _ := cmd.Flags().GetInt("phase")
 	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
-	hierarchyCheck, _ := cmd.Flags().GetBool("hierarchy")
-	touchTargetsCheck, _ := cmd.Flags().GetBool("touch-targets")
-	gestaltCheck, _ := cmd.Flags().GetBool("gestalt")
-	a11yCheck, _ := cmd.Flags().GetBool("accessibility")
-	choiceCheck, _ := cmd.Flags().GetBool("choice-overload")
-	contrastCheck, _ := cmd.Flags().GetBool("contrast")
-	spacingCheck, _ := cmd.Flags().GetBool("spacing")
-	typographyCheck, _ := cmd.Flags().GetBool("typography")
-	elevationCheck, _ := cmd.Flags().GetBool("elevation")
-	loadingStatesCheck, _ := cmd.Flags().GetBool("loading-states")
-	responsiveCheck, _ := cmd.Flags().GetBool("responsive")
-	focusCheck, _ := cmd.Flags().GetBool("focus")
-	darkModeCheck, _ := cmd.Flags().GetBool("dark-mode")
+	if err := applyConfiguredValidators(cmd); err != nil {
+		return commandError(outputJSON, "", err)
+	}
+	versionFlag, _ := cmd.Flags().GetString("version")
 
 	// Only Phase 1 validation is currently supported
 	if phase != 1 {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  fmt.Sprintf("Phase %d validation not yet implemented", phase),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("phase %d validation not yet implemented", phase)
+		return commandError(outputJSON, "", fmt.Errorf("phase %d validation not yet implemented", phase))
 	}
 
 	// Find the structure file
 	structurePath := filepath.Join(projectPath, "phase1-structure")
-	
-	// Try to find the latest version or approved.json
+
+	// A version range validates each version inside it in turn
+	versions, isRange, err := parseVersionRange(versionFlag)
+	if err != nil {
+		return commandError(outputJSON, "", err)
+	}
+	if isRange {
+		files, err := resolveVersionRange(structurePath, versions)
+		if err != nil {
+			return commandError(outputJSON, "", err)
+		}
+		return validateVersionRange(cmd, files, versions, outputJSON)
+	}
+
 	var structureFile string
-	if _, err := os.Stat(filepath.Join(structurePath, "approved.json")); err == nil {
-		structureFile = filepath.Join(structurePath, "approved.json")
-	} else if _, err := os.Stat(filepath.Join(structurePath, "v1.json")); err == nil {
-		// Find the highest version number
-		entries, err := os.ReadDir(structurePath)
+	if versionFlag != "" {
+		structureFile, err = findStructureFile(structurePath, versionFlag)
 		if err != nil {
-			if outputJSON {
-				result := map[string]interface{}{
-					"status": "error",
-					"error":  fmt.Sprintf("Failed to read directory: %v", err),
-				}
-				enc := json.NewEncoder(os.Stdout)
-				enc.SetIndent("", "  ")
-				return enc.Encode(result)
-			}
-			return fmt.Errorf("failed to read directory %s: %w", structurePath, err)
-		}
-
-		// Find latest version
-		latestVersion := 0
-		for _, entry := range entries {
-			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
-				var version int
-				if _, err := fmt.Sscanf(entry.Name(), "v%d.json", &version); err == nil {
-					if version > latestVersion {
-						latestVersion = version
-						structureFile = filepath.Join(structurePath, entry.Name())
-					}
-				}
-			}
+			return commandError(outputJSON, "", err)
 		}
+	} else if _, err := os.Stat(structureFilePath(structurePath, "approved")); err == nil {
+		// Try to find the latest version or approved.json
+		structureFile = structureFilePath(structurePath, "approved")
+	} else if latest, err := resolveLatestVersion(structurePath); err == nil {
+		structureFile = latest
 	}
 
 	if structureFile == "" {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"error":  "No structure file found in " + structurePath,
+		return commandError(outputJSON, "", fmt.Errorf("no structure file found in %s", structurePath))
+	}
+
+	return validateStructureFile(cmd, structureFile, outputJSON)
+}
+
+// validateVersionRange validates each structure file of a version range, printing
+// one report per version (with --json, one document listing every version's report)
+func validateVersionRange(cmd *cobra.Command, files []string, versions versionRange, outputJSON bool) error {
+	failed := 0
+	reports := []map[string]interface{}{}
+	progress := newBatchProgress(progressLog(cmd), len(files))
+	for i, structureFile := range files {
+		if i > 0 && !outputJSON {
+			fmt.Println()
+		}
+		name, _ := structureName(filepath.Base(structureFile))
+		progress.step("validating", name)
+		report, err := validateStructure(cmd, structureFile, outputJSON)
+		if err != nil {
+			if !outputJSON {
+				fmt.Printf("❌ %s: %v\n", structureFile, err)
 			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
+			failed++
+		}
+		if report != nil {
+			reports = append(reports, report)
+		}
+	}
+
+	var err error
+	if failed > 0 {
+		err = fmt.Errorf("%d of %d versions failed validation", failed, len(files))
+	}
+	if outputJSON {
+		status := "success"
+		if failed > 0 {
+			status = "failed"
+		}
+		writeJSON(map[string]interface{}{
+			"status":   status,
+			"range":    versions.String(),
+			"versions": reports,
+			"failed":   failed,
+		})
+		if err != nil {
+			return &reportedError{err: err}
 		}
-		return fmt.Errorf("no structure file found in %s", structurePath)
+		return nil
+	}
+
+	fmt.Printf("\n📊 Validated %d versions (%s): %d passed, %d failed in %s\n", len(files), versions, len(files)-failed, failed, progress.elapsed())
+	return err
+}
+
+// validateStructureFile validates a single structure file with the validators selected by the flags
+func validateStructureFile(cmd *cobra.Command, structureFile string, outputJSON bool) error {
+	report, err := validateStructure(cmd, structureFile, outputJSON)
+	if report != nil {
+		writeJSON(report)
+		if err != nil {
+			return &reportedError{err: err}
+		}
+	}
+	return err
+}
+
+// validateStructure validates a single structure file. With --json the report (or
+// the JSON error object) is returned rather than printed, so a version range can
+// combine the reports into one document; otherwise the report is printed.
+func validateStructure(cmd *cobra.Command, structureFile string, outputJSON bool) (report map[string]interface{}, err error) {
+	if outputJSON {
+		defer func() {
+			if err != nil && report == nil {
+				report = jsonError(map[string]interface{}{"file": structureFile}, err)
+			}
+		}()
+	}
+
+	hierarchyCheck, _ := cmd.Flags().GetBool("hierarchy")
+	touchTargetsCheck, _ := cmd.Flags().GetBool("touch-targets")
+	gestaltCheck, _ := cmd.Flags().GetBool("gestalt")
+	a11yCheck, _ := cmd.Flags().GetBool("accessibility")
+	choiceCheck, _ := cmd.Flags().GetBool("choice-overload")
+	contrastCheck, _ := cmd.Flags().GetBool("contrast")
+	wcagLevel, _ := cmd.Flags().GetString("wcag")
+	contrastCheck = contrastCheck || cmd.Flags().Changed("wcag")
+	contrastRule, err := validate.ContrastRuleForLevel(wcagLevel)
+	if err != nil {
+		return nil, err
+	}
+	wcagLevel = strings.ToUpper(wcagLevel)
+	spacingCheck, _ := cmd.Flags().GetBool("spacing")
+	typographyCheck, _ := cmd.Flags().GetBool("typography")
+	elevationCheck, _ := cmd.Flags().GetBool("elevation")
+	loadingStatesCheck, _ := cmd.Flags().GetBool("loading-states")
+	responsiveCheck, _ := cmd.Flags().GetBool("responsive")
+	viewportWidth, _ := cmd.Flags().GetInt("viewport-width")
+	responsiveCheck = responsiveCheck || cmd.Flags().Changed("viewport-width")
+	if viewportWidth < 0 {
+		return nil, fmt.Errorf("--viewport-width must not be negative")
 	}
+	responsiveRule := validate.DefaultResponsiveRule()
+	responsiveRule.ViewportWidth = viewportWidth
+	focusCheck, _ := cmd.Flags().GetBool("focus")
+	darkModeCheck, _ := cmd.Flags().GetBool("dark-mode")
+	paletteCheck, _ := cmd.Flags().GetBool("palette")
+	contentLengthCheck, _ := cmd.Flags().GetBool("content-length")
+	imagesCheck, _ := cmd.Flags().GetBool("images")
+	readingOrderCheck, _ := cmd.Flags().GetBool("reading-order")
+	lineLengthCheck, _ := cmd.Flags().GetBool("line-length")
+	layoutCheck, _ := cmd.Flags().GetBool("layout")
+	explain, _ := cmd.Flags().GetBool("explain")
 
 	// Read the file
-	data, err := os.ReadFile(structureFile)
+	data, err := readStructureFile(structureFile)
 	if err != nil {
-		if outputJSON {
-			result := map[string]interface{}{
-				"status": "error",
-				"file":   structureFile,
-				"error":  fmt.Sprintf("Failed to read file: %v", err),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
-		}
-		return fmt.Errorf("failed to read %s: %w", structureFile, err)
+		return nil, fmt.Errorf("failed to read %s: %w", structureFile, err)
 	}
 
 	// Parse and validate
-	structure, err := types.ParseAndValidateStructure(data)
+	structure, err := prism.ParseAndValidateStructure(data)
 	if err != nil {
 		if outputJSON {
-			result := map[string]interface{}{
+			return jsonError(map[string]interface{}{
 				"status":     "failed",
 				"file":       structureFile,
 				"validation": "failed",
-				"error":      err.Error(),
-			}
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			return enc.Encode(result)
+			}, err), err
 		}
 		fmt.Printf("❌ Validation failed for %s\n", structureFile)
-		return fmt.Errorf("validation error: %w", err)
+		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
+	// Locked structures must still match the checksum recorded at approval
+	checksumStatus := "unlocked"
+	if err := structure.VerifyChecksum(); err != nil {
+		var vErr *types.ValidationError
+		if !errors.As(err, &vErr) || vErr.Code != types.ErrCodeMissingChecksum {
+			if outputJSON {
+				return jsonError(map[string]interface{}{
+					"status":     "failed",
+					"file":       structureFile,
+					"validation": "failed",
+					"checksum":   "mismatch",
+				}, err), err
+			}
+			fmt.Printf("❌ Validation failed for %s\n", structureFile)
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		checksumStatus = "missing"
+	} else if structure.Locked {
+		checksumStatus = "verified"
+	}
+
+	// The validation block is author-asserted; check its "passed" claims still hold
+	declaredRules := projectConfig.AuditRules()
+	declaredRules.Contrast = contrastRule
+	declaredResult := validate.ValidateDeclared(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), declaredRules)
+
 	// Success
 	if outputJSON {
 		result := map[string]interface{}{
@@ -221,11 +365,21 @@ func runValidate(cmd *cobra.Command, args []string) error {
 			"version":    structure.Version,
 			"phase":      structure.Phase,
 			"components": len(structure.Components),
+			"checksum":   checksumStatus,
+			"declared_validation": map[string]interface{}{
+				"status": func() string {
+					if declaredResult.Passed {
+						return "passed"
+					}
+					return "stale"
+				}(),
+				"issues": declaredResult.Issues,
+			},
 		}
 		
 		// Run hierarchy validation if requested
 		if hierarchyCheck {
-			hierarchyResult := validate.ValidateHierarchy(structure, validate.DefaultHierarchyRule())
+			hierarchyResult := validate.ValidateHierarchyWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultHierarchyRule())
 			result["hierarchy"] = map[string]interface{}{
 				"status": func() string {
 					if hierarchyResult.Passed {
@@ -239,7 +393,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		
 		// Run touch target validation if requested
 		if touchTargetsCheck {
-			touchResult := validate.ValidateTouchTargets(structure, validate.DefaultTouchTargetRule())
+			touchResult := validate.ValidateTouchTargetsWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultTouchTargetRule())
 			result["touch_targets"] = map[string]interface{}{
 				"status": func() string {
 					if touchResult.Passed {
@@ -253,7 +407,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		
 		// Run Gestalt principles validation if requested
 		if gestaltCheck {
-			gestaltResult := validate.ValidateGestalt(structure, validate.DefaultGestaltRule())
+			gestaltResult := validate.ValidateGestaltWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultGestaltRule())
 			result["gestalt"] = map[string]interface{}{
 				"status": func() string {
 					if gestaltResult.Passed {
@@ -281,7 +435,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		
 		// Run choice overload validation if requested
 		if choiceCheck {
-			choiceResult := validate.ValidateChoiceOverload(structure, validate.DefaultChoiceRule())
+			choiceResult := validate.ValidateChoiceOverload(structure, projectConfig.ChoiceRule())
 			result["choice_overload"] = map[string]interface{}{
 				"status": func() string {
 					if choiceResult.Passed {
@@ -291,12 +445,16 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				}(),
 				"issues": choiceResult.Issues,
 			}
+			if explain {
+				attachGuidance(result, "choice_overload", choiceResult.Issues)
+			}
 		}
 		
 		// Run contrast validation if requested
 		if contrastCheck {
-			contrastResult := validate.ValidateContrast(structure, validate.DefaultContrastRule())
+			contrastResult := validate.ValidateContrast(structure, contrastRule)
 			result["contrast"] = map[string]interface{}{
+				"level": wcagLevel,
 				"status": func() string {
 					if contrastResult.Passed {
 						return "passed"
@@ -305,11 +463,14 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				}(),
 				"issues": contrastResult.Issues,
 			}
+			if explain {
+				attachGuidance(result, "contrast", contrastResult.Issues)
+			}
 		}
 		
 		// Run spacing validation if requested
 		if spacingCheck {
-			spacingResult := validate.ValidateSpacing(structure, validate.DefaultSpacingRule())
+			spacingResult := validate.ValidateSpacing(structure, projectConfig.SpacingRule())
 			result["spacing"] = map[string]interface{}{
 				"status": func() string {
 					if spacingResult.Passed {
@@ -319,11 +480,14 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				}(),
 				"issues": spacingResult.Issues,
 			}
+			if explain {
+				attachGuidance(result, "spacing", spacingResult.Issues)
+			}
 		}
 		
 		// Run typography validation if requested
 		if typographyCheck {
-			typographyResult := validate.ValidateTypography(structure, validate.DefaultTypographyRule())
+			typographyResult := validate.ValidateTypography(structure, projectConfig.TypographyRule())
 			result["typography"] = map[string]interface{}{
 				"status": func() string {
 					if typographyResult.Passed {
@@ -365,7 +529,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		
 		// Run responsive breakpoint validation if requested
 		if responsiveCheck {
-			responsiveResult := validate.ValidateResponsive(structure, validate.DefaultResponsiveRule())
+			responsiveResult := validate.ValidateResponsive(structure, responsiveRule)
 			result["responsive"] = map[string]interface{}{
 				"status": func() string {
 					if responsiveResult.Passed {
@@ -404,10 +568,90 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				"issues": darkModeResult.Issues,
 			}
 		}
+
+		// Run palette adherence validation if requested
+		if paletteCheck {
+			paletteResult := validate.ValidatePalette(structure, projectConfig.PaletteRule())
+			result["palette"] = map[string]interface{}{
+				"status": func() string {
+					if paletteResult.Passed {
+						return "passed"
+					}
+					return "failed"
+				}(),
+				"issues": paletteResult.Issues,
+			}
+		}
 		
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		return enc.Encode(result)
+		// Run content length validation if requested
+		if contentLengthCheck {
+			contentLengthResult := validate.ValidateContentLength(structure, validate.DefaultContentLengthRule())
+			result["content_length"] = map[string]interface{}{
+				"status": func() string {
+					if contentLengthResult.Passed {
+						return "passed"
+					}
+					return "failed"
+				}(),
+				"issues": contentLengthResult.Issues,
+			}
+		}
+		
+		// Run image dimension validation if requested
+		if imagesCheck {
+			imageResult := validate.ValidateImages(structure, validate.DefaultImageRule())
+			result["images"] = map[string]interface{}{
+				"status": func() string {
+					if imageResult.Passed {
+						return "passed"
+					}
+					return "failed"
+				}(),
+				"issues": imageResult.Issues,
+			}
+		}
+
+		// Run reading order validation if requested
+		if readingOrderCheck {
+			readingOrderResult := validate.ValidateReadingOrder(structure, calculateLayoutBoxes(structure, verboseLog(cmd)))
+			result["reading_order"] = map[string]interface{}{
+				"status": func() string {
+					if readingOrderResult.Passed {
+						return "passed"
+					}
+					return "failed"
+				}(),
+				"issues": readingOrderResult.Issues,
+			}
+		}
+
+		// Run line length validation if requested
+		if lineLengthCheck {
+			lineLengthResult := validate.ValidateLineLength(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultLineLengthRule())
+			result["line_length"] = map[string]interface{}{
+				"status": func() string {
+					if lineLengthResult.Passed {
+						return "passed"
+					}
+					return "failed"
+				}(),
+				"issues": lineLengthResult.Issues,
+			}
+		}
+		
+		// Report layout notes if requested
+		if layoutCheck {
+			layoutWarnings, err := calculateLayoutWarnings(structure, verboseLog(cmd))
+			if err != nil {
+				return nil, fmt.Errorf("layout calculation failed: %w", err)
+			}
+			result["layout"] = map[string]interface{}{
+				"status": layoutStatus(layoutWarnings),
+				"issues": layoutWarnings,
+			}
+		}
+		
+		return result, nil
 	}
 
 	fmt.Printf("✅ Validation passed for %s\n", structureFile)
@@ -416,14 +660,26 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("   Components: %d\n", len(structure.Components))
 	if structure.Locked {
 		fmt.Println("   Status: Locked (approved)")
+		switch checksumStatus {
+		case "verified":
+			fmt.Println("   Checksum: ✅ Verified")
+		case "missing":
+			fmt.Println("   Checksum: ⚠️  None recorded - cannot verify the approved structure is unchanged")
+		}
 	} else {
 		fmt.Println("   Status: Draft")
 	}
+	if !declaredResult.Passed {
+		fmt.Println("   Declared validation: ⚠️  Out of date")
+		for _, issue := range declaredResult.Issues {
+			fmt.Printf("     ⚠️  %s\n", issue.Message)
+		}
+	}
 
 	// Run hierarchy validation if requested
 	if hierarchyCheck {
 		fmt.Println("\n📊 Visual Hierarchy Validation:")
-		hierarchyResult := validate.ValidateHierarchy(structure, validate.DefaultHierarchyRule())
+		hierarchyResult := validate.ValidateHierarchyWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultHierarchyRule())
 		
 		if hierarchyResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -475,7 +731,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	// Run touch target validation if requested
 	if touchTargetsCheck {
 		fmt.Println("\n👆 Touch Target & Spacing Validation:")
-		touchResult := validate.ValidateTouchTargets(structure, validate.DefaultTouchTargetRule())
+		touchResult := validate.ValidateTouchTargetsWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultTouchTargetRule())
 		
 		if touchResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -527,7 +783,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	// Run Gestalt principles validation if requested
 	if gestaltCheck {
 		fmt.Println("\n🎨 Gestalt Principles Validation:")
-		gestaltResult := validate.ValidateGestalt(structure, validate.DefaultGestaltRule())
+		gestaltResult := validate.ValidateGestaltWithLayout(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultGestaltRule())
 		
 		if gestaltResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -631,7 +887,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	// Run choice overload validation if requested
 	if choiceCheck {
 		fmt.Println("\n🎯 Choice Overload (Hick's Law) Validation:")
-		choiceResult := validate.ValidateChoiceOverload(structure, validate.DefaultChoiceRule())
+		choiceResult := validate.ValidateChoiceOverload(structure, projectConfig.ChoiceRule())
 		
 		if choiceResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -678,12 +934,15 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				fmt.Printf("     ℹ️  %s\n", issue.Message)
 			}
 		}
+		if explain {
+			printGuidance(choiceResult.Issues, "Why it matters:")
+		}
 	}
 
 	// Run contrast validation if requested
 	if contrastCheck {
-		fmt.Println("\n🎨 Color Contrast (WCAG) Validation:")
-		contrastResult := validate.ValidateContrast(structure, validate.DefaultContrastRule())
+		fmt.Printf("\n🎨 Color Contrast (WCAG %s) Validation:\n", wcagLevel)
+		contrastResult := validate.ValidateContrast(structure, contrastRule)
 		
 		if contrastResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -730,12 +989,15 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				fmt.Printf("     ℹ️  %s\n", issue.Message)
 			}
 		}
+		if explain {
+			printGuidance(contrastResult.Issues, "Why it matters:")
+		}
 	}
 
 	// Run spacing validation if requested
 	if spacingCheck {
 		fmt.Println("\n📏 Spacing Scale (8pt Grid) Validation:")
-		spacingResult := validate.ValidateSpacing(structure, validate.DefaultSpacingRule())
+		spacingResult := validate.ValidateSpacing(structure, projectConfig.SpacingRule())
 		
 		if spacingResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -782,12 +1044,15 @@ func runValidate(cmd *cobra.Command, args []string) error {
 				fmt.Printf("     ℹ️  %s\n", issue.Message)
 			}
 		}
+		if explain {
+			printGuidance(spacingResult.Issues, "Why it matters:")
+		}
 	}
 
 	// Run typography validation if requested
 	if typographyCheck {
 		fmt.Println("\n🔤 Typography Scale Validation:")
-		typographyResult := validate.ValidateTypography(structure, validate.DefaultTypographyRule())
+		typographyResult := validate.ValidateTypography(structure, projectConfig.TypographyRule())
 		
 		if typographyResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -943,7 +1208,7 @@ func runValidate(cmd *cobra.Command, args []string) error {
 	// Run responsive breakpoint validation if requested
 	if responsiveCheck {
 		fmt.Println("\n📱 Responsive Breakpoint Validation:")
-		responsiveResult := validate.ValidateResponsive(structure, validate.DefaultResponsiveRule())
+		responsiveResult := validate.ValidateResponsive(structure, responsiveRule)
 		
 		if responsiveResult.Passed {
 			fmt.Println("   Status: ✅ Passed")
@@ -1096,5 +1361,218 @@ func runValidate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	return nil
+	// Run palette adherence validation if requested
+	if paletteCheck {
+		fmt.Println("\n🎨 Palette Adherence Validation:")
+		paletteResult := validate.ValidatePalette(structure, projectConfig.PaletteRule())
+
+		if paletteResult.Passed {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			fmt.Println("   Status: ⚠️  Issues Found")
+		}
+
+		// Group issues by severity
+		errors := []validate.PaletteIssue{}
+		warnings := []validate.PaletteIssue{}
+		infos := []validate.PaletteIssue{}
+
+		for _, issue := range paletteResult.Issues {
+			switch issue.Severity {
+			case "error":
+				errors = append(errors, issue)
+			case "warning":
+				warnings = append(warnings, issue)
+			case "info":
+				infos = append(infos, issue)
+			}
+		}
+
+		if len(errors) > 0 {
+			fmt.Println("\n   Errors:")
+			for _, issue := range errors {
+				fmt.Printf("     ❌ %s\n", issue.Message)
+			}
+		}
+		if len(warnings) > 0 {
+			fmt.Println("\n   Warnings:")
+			for _, issue := range warnings {
+				fmt.Printf("     ⚠️  %s\n", issue.Message)
+			}
+		}
+		if len(infos) > 0 {
+			fmt.Println("\n   Info:")
+			for _, issue := range infos {
+				fmt.Printf("     ℹ️  %s\n", issue.Message)
+			}
+		}
+	}
+
+	// Run content length validation if requested
+	if contentLengthCheck {
+		fmt.Println("\n📏 Content Length Validation:")
+		contentLengthResult := validate.ValidateContentLength(structure, validate.DefaultContentLengthRule())
+		
+		if contentLengthResult.Passed {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			fmt.Println("   Status: ⚠️  Issues Found")
+		}
+		
+		// Group issues by severity
+		warnings := []validate.ContentLengthIssue{}
+		infos := []validate.ContentLengthIssue{}
+		
+		for _, issue := range contentLengthResult.Issues {
+			switch issue.Severity {
+			case "warning":
+				warnings = append(warnings, issue)
+			case "info":
+				infos = append(infos, issue)
+			}
+		}
+		
+		// Print warnings
+		if len(warnings) > 0 {
+			fmt.Println("\n   Warnings:")
+			for _, issue := range warnings {
+				fmt.Printf("     ⚠️  %s\n", issue.Message)
+			}
+		}
+		
+		// Print info
+		if len(infos) > 0 {
+			fmt.Println("\n   Info:")
+			for _, issue := range infos {
+				fmt.Printf("     ℹ️  %s\n", issue.Message)
+			}
+		}
+	}
+
+	// Run image dimension validation if requested
+	if imagesCheck {
+		fmt.Println("\n🖼️  Image Dimension Validation:")
+		imageResult := validate.ValidateImages(structure, validate.DefaultImageRule())
+		
+		if imageResult.Passed {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			fmt.Println("   Status: ⚠️  Issues Found")
+			fmt.Println("\n   Warnings:")
+			for _, issue := range imageResult.Issues {
+				fmt.Printf("     ⚠️  %s\n", issue.Message)
+			}
+		}
+	}
+
+	// Run reading order validation if requested
+	if readingOrderCheck {
+		fmt.Println("\n🔢 Reading Order Validation:")
+		readingOrderResult := validate.ValidateReadingOrder(structure, calculateLayoutBoxes(structure, verboseLog(cmd)))
+
+		if readingOrderResult.Passed {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			fmt.Println("   Status: ⚠️  Issues Found")
+			fmt.Println("\n   Warnings:")
+			for _, issue := range readingOrderResult.Issues {
+				fmt.Printf("     ⚠️  %s\n", issue.Message)
+			}
+		}
+	}
+
+	// Run line length validation if requested
+	if lineLengthCheck {
+		fmt.Println("\n📖 Line Length Validation:")
+		lineLengthResult := validate.ValidateLineLength(structure, calculateLayoutBoxes(structure, verboseLog(cmd)), validate.DefaultLineLengthRule())
+
+		if lineLengthResult.Passed {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			fmt.Println("   Status: ⚠️  Issues Found")
+			fmt.Println("\n   Warnings:")
+			for _, issue := range lineLengthResult.Issues {
+				fmt.Printf("     ⚠️  %s\n", issue.Message)
+			}
+		}
+	}
+
+	// Report layout notes if requested
+	if layoutCheck {
+		fmt.Println("\n📐 Layout Notes:")
+		layoutWarnings, err := calculateLayoutWarnings(structure, verboseLog(cmd))
+		if err != nil {
+			return nil, fmt.Errorf("layout calculation failed: %w", err)
+		}
+		
+		if len(layoutWarnings) == 0 {
+			fmt.Println("   Status: ✅ Passed")
+		} else {
+			if layoutStatus(layoutWarnings) == "warning" {
+				fmt.Println("   Status: ⚠️  Issues Found")
+			} else {
+				fmt.Println("   Status: ✅ Passed (with notes)")
+			}
+			fmt.Println("\n   Notes:")
+			for _, warning := range layoutWarnings {
+				fmt.Printf("     %s  %s\n", layoutNoteIcon(warning), warning.Message)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// calculateLayoutBoxes runs the render layout engine at desktop width and returns the
+// computed boxes, or nil when the layout cannot be calculated
+func calculateLayoutBoxes(structure *types.Structure, log io.Writer) map[string]render.LayoutBox {
+	engine := render.NewLayoutEngine(1)
+	engine.SetLog(log)
+	boxes, err := engine.CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		return nil
+	}
+	return boxes
+}
+
+// calculateLayoutWarnings runs the render layout engine at desktop width and returns its notes
+func calculateLayoutWarnings(structure *types.Structure, log io.Writer) ([]render.LayoutWarning, error) {
+	engine := render.NewLayoutEngine(1)
+	engine.SetLog(log)
+	if _, err := engine.CalculateLayout(structure, 1200, 0); err != nil {
+		return nil, err
+	}
+	if warnings := engine.Warnings(); warnings != nil {
+		return warnings, nil
+	}
+	return []render.LayoutWarning{}, nil
+}
+
+// attachGuidance adds the --explain guidance for a validator's issues to its JSON block
+func attachGuidance(result map[string]interface{}, key string, issues interface{}) {
+	block, ok := result[key].(map[string]interface{})
+	if !ok {
+		return
+	}
+	guidance := map[string]string{}
+	for _, category := range validate.IssueCategories(issues) {
+		guidance[category], _ = validate.Guidance(category)
+	}
+	block["guidance"] = guidance
+}
+
+// printGuidance prints, once per issue category, the principle behind it and a
+// remediation tip, under heading unless it is empty
+func printGuidance(issues interface{}, heading string) {
+	categories := validate.IssueCategories(issues)
+	if len(categories) == 0 {
+		return
+	}
+	if heading != "" {
+		fmt.Printf("\n   %s\n", heading)
+	}
+	for _, category := range categories {
+		text, _ := validate.Guidance(category)
+		fmt.Printf("     💡 %s: %s\n", category, text)
+	}
 }
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidate_Explain(t *testing.T) {
+	project := t.TempDir()
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	structure := `{
+		"version": "v1",
+		"phase": "structure",
+		"intent": {"purpose": "Settings"},
+		"layout": {"type": "stack"},
+		"components": [
+			{"id": "panel", "type": "box", "layout": {"padding": 20}, "children": [
+				{"id": "title", "type": "text", "content": "Settings"}
+			]}
+		]
+	}`
+	if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(structure), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, code := runPrism(t, "validate", project, "--spacing", "--explain", "--json")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d: %s", code, stdout)
+	}
+
+	var result struct {
+		Spacing struct {
+			Guidance map[string]string `json:"guidance"`
+		} `json:"spacing"`
+	}
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("Failed to parse output: %v: %s", err, stdout)
+	}
+	if result.Spacing.Guidance["off_grid"] == "" {
+		t.Errorf("Expected guidance for off_grid, got %+v", result.Spacing.Guidance)
+	}
+	if _, ok := result.Spacing.Guidance["suggestion"]; ok {
+		t.Error("Expected no guidance entry for suggestions")
+	}
+
+	// Without --explain the block is unchanged
+	stdout, _ = runPrism(t, "validate", project, "--spacing", "--json")
+	var plain struct {
+		Spacing map[string]interface{} `json:"spacing"`
+	}
+	if err := json.Unmarshal(stdout, &plain); err != nil {
+		t.Fatalf("Failed to parse output: %v: %s", err, stdout)
+	}
+	if _, ok := plain.Spacing["guidance"]; ok {
+		t.Error("Expected no guidance without --explain")
+	}
+}
+
+func TestValidate_PaletteFromConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	writeProject := func(palette string) string {
+		project := t.TempDir()
+		structurePath := filepath.Join(project, "phase1-structure")
+		if err := os.Mkdir(structurePath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		structure := `{
+			"version": "v1",
+			"phase": "structure",
+			"intent": {"purpose": "Settings"},
+			"layout": {"type": "stack"},` + palette + `
+			"components": [
+				{"id": "title", "type": "text", "content": "Settings", "color": "#737373"}
+			]
+		}`
+		if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(structure), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return project
+	}
+	configPath := filepath.Join(t.TempDir(), "prism.json")
+	if err := os.WriteFile(configPath, []byte(`{"palette": ["#FFFFFF", "#000000"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	type paletteBlock struct {
+		Status string `json:"status"`
+		Issues []struct {
+			Color    string `json:"color"`
+			Severity string `json:"severity"`
+		} `json:"issues"`
+	}
+	validatePalette := func(project string, args ...string) paletteBlock {
+		t.Helper()
+		stdout, _ := runPrism(t, append([]string{"validate", project, "--palette", "--json"}, args...)...)
+		var result struct {
+			Palette paletteBlock `json:"palette"`
+		}
+		if err := json.Unmarshal(stdout, &result); err != nil {
+			t.Fatalf("Failed to parse output: %v: %s", err, stdout)
+		}
+		return result.Palette
+	}
+
+	// Without a palette anywhere the check is skipped
+	plain := writeProject("")
+	if got := validatePalette(plain); got.Status != "passed" || len(got.Issues) != 1 || got.Issues[0].Severity != "info" {
+		t.Errorf("Expected a skipped palette check without a palette, got %+v", got)
+	}
+
+	// The config palette reaches the validator
+	got := validatePalette(plain, "--config", configPath)
+	if got.Status != "failed" || len(got.Issues) != 1 || got.Issues[0].Color != "#737373" {
+		t.Errorf("Expected the config palette to flag #737373, got %+v", got)
+	}
+
+	// A palette declared in the structure wins over the config
+	declared := writeProject(`
+			"palette": ["#FFFFFF", "#000000", "#737373"],`)
+	if got := validatePalette(declared, "--config", configPath); got.Status != "passed" || len(got.Issues) != 0 {
+		t.Errorf("Expected the structure palette to take precedence, got %+v", got)
+	}
+
+	// The audit runs the same check with the configured palette
+	stdout, _ := runPrism(t, "audit", plain, "--json", "--config", configPath)
+	var audit struct {
+		Audits map[string]paletteBlock `json:"audits"`
+	}
+	if err := json.Unmarshal(stdout, &audit); err != nil {
+		t.Fatalf("Failed to parse audit output: %v: %s", err, stdout)
+	}
+	if got := audit.Audits["palette"]; got.Status != "failed" {
+		t.Errorf("Expected the audit palette entry to fail with the config palette, got %+v", got)
+	}
+}
+
+func TestValidate_LayoutStatus(t *testing.T) {
+	layoutStatusFor := func(display string) string {
+		t.Helper()
+		project := t.TempDir()
+		structurePath := filepath.Join(project, "phase1-structure")
+		if err := os.Mkdir(structurePath, 0755); err != nil {
+			t.Fatal(err)
+		}
+		structure := `{
+			"version": "v1",
+			"phase": "structure",
+			"intent": {"purpose": "Settings"},
+			"layout": {"type": "stack"},
+			"components": [
+				{"id": "panel", "type": "box", "layout": {` + display + `}, "children": [
+					{"id": "title", "type": "text", "content": "Settings"},
+					{"id": "body", "type": "text", "content": "Manage your account"}
+				]}
+			]
+		}`
+		if err := os.WriteFile(filepath.Join(structurePath, "v1.json"), []byte(structure), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		stdout, _ := runPrism(t, "validate", project, "--layout", "--json")
+		var result struct {
+			Layout struct {
+				Status string `json:"status"`
+			} `json:"layout"`
+		}
+		if err := json.Unmarshal(stdout, &result); err != nil {
+			t.Fatalf("Failed to parse output: %v: %s", err, stdout)
+		}
+		return result.Layout.Status
+	}
+
+	// An undeclared display on a multi-child box is a warning-severity note
+	if got := layoutStatusFor(""); got != "warning" {
+		t.Errorf("Expected status warning for an undeclared display, got %q", got)
+	}
+	if got := layoutStatusFor(`"display": "flex"`); got != "passed" {
+		t.Errorf("Expected status passed with a declared display, got %q", got)
+	}
+}
+
+func TestValidate_VersionRangeJSON(t *testing.T) {
+	project := t.TempDir()
+	structurePath := filepath.Join(project, "phase1-structure")
+	if err := os.Mkdir(structurePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	structure := `{
+		"version": "v1",
+		"phase": "structure",
+		"intent": {"purpose": "Settings"},
+		"layout": {"type": "stack"},
+		"components": [{"id": "title", "type": "text", "content": "Settings"}]
+	}`
+	for name, content := range map[string]string{"v1.json": structure, "v2.json": structure, "v3.json": "{"} {
+		if err := os.WriteFile(filepath.Join(structurePath, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// One document for the whole range, with a report per version
+	stdout, code := runPrism(t, "validate", project, "--version", "range:1-3", "--json")
+	if code == 0 {
+		t.Fatalf("Expected a failing exit code for the broken version: %s", stdout)
+	}
+	var result struct {
+		Status   string                   `json:"status"`
+		Versions []map[string]interface{} `json:"versions"`
+		Failed   int                      `json:"failed"`
+	}
+	if err := json.Unmarshal(stdout, &result); err != nil {
+		t.Fatalf("Failed to parse output as one document: %v: %s", err, stdout)
+	}
+	if result.Status != "failed" || result.Failed != 1 || len(result.Versions) != 3 {
+		t.Fatalf("Expected 3 reports with 1 failure, got %+v", result)
+	}
+	if result.Versions[0]["status"] != "success" || result.Versions[2]["status"] != "failed" {
+		t.Errorf("Expected per-version statuses in range order, got %+v", result.Versions)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/johanbellander/prism/internal/validate"
+	"github.com/spf13/cobra"
+)
+
+var validatorsCmd = &cobra.Command{
+	Use:   "validators",
+	Short: "List the available validators",
+	Long: `List every validator with its flag, phase and a one-line description.
+
+Validators marked [audit] run as part of prism audit and can be named in
+--only and --skip. Use --json to discover the validators from a script
+instead of hard-coding their names.
+
+Examples:
+  prism validators
+  prism validators --json | jq -r '.validators[] | select(.audit) | .flag'`,
+	Args: cobra.NoArgs,
+	RunE: runValidators,
+}
+
+func runValidators(cmd *cobra.Command, args []string) error {
+	outputJSON, _ := cmd.Parent().PersistentFlags().GetBool("json")
+	validators := validate.Validators()
+
+	if outputJSON {
+		result := map[string]interface{}{
+			"status":     "success",
+			"count":      len(validators),
+			"validators": validators,
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+
+	for _, phase := range []struct {
+		number int
+		title  string
+	}{{1, "Phase 1 (Structural)"}, {2, "Phase 2 (Visual Design)"}} {
+		fmt.Printf("%s:\n", phase.title)
+		for _, v := range validators {
+			if v.Phase != phase.number {
+				continue
+			}
+			flag := "(API only)"
+			if v.Flag != "" {
+				flag = "--" + v.Flag
+			}
+			fmt.Printf("  %-20s %s", flag, v.Description)
+			if v.Audit {
+				fmt.Printf(" [audit]")
+			}
+			fmt.Printf("\n")
+		}
+		fmt.Printf("\n")
+	}
+
+	fmt.Printf("Total: %d validator(s), %d in the audit\n", len(validators), len(validate.AuditValidatorNames()))
+	return nil
+}
+
+// completeAuditValidators offers audit validator names for --only and --skip
+func completeAuditValidators(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	completions := []string{}
+	for _, v := range validate.Validators() {
+		if v.Audit {
+			completions = append(completions, v.Flag+"\t"+v.Title)
+		}
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
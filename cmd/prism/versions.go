@@ -0,0 +1,199 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// gzipExt marks a compressed structure file (v2.json.gz)
+const gzipExt = ".gz"
+
+// structureName returns the version name of a structure file, "v2" for v2.json or
+// v2.json.gz; ok is false for files that are not structure JSON
+func structureName(fileName string) (string, bool) {
+	name := strings.TrimSuffix(fileName, gzipExt)
+	if filepath.Ext(name) != ".json" {
+		return "", false
+	}
+	return strings.TrimSuffix(name, ".json"), true
+}
+
+// versionNumber returns N for a "vN.json" or "vN.json.gz" file name
+func versionNumber(name string) (int, bool) {
+	base, ok := structureName(name)
+	if !ok {
+		return 0, false
+	}
+	var v int
+	if _, err := fmt.Sscanf(base, "v%d", &v); err != nil || fmt.Sprintf("v%d", v) != base {
+		return 0, false
+	}
+	return v, true
+}
+
+// sortVersionFiles orders structure file names numerically (v2 before v10), with
+// names that are not vN.json, such as approved.json, after them alphabetically
+func sortVersionFiles(names []string) {
+	sort.SliceStable(names, func(i, j int) bool {
+		vi, iok := versionNumber(names[i])
+		vj, jok := versionNumber(names[j])
+		switch {
+		case iok && jok:
+			return vi < vj
+		case iok != jok:
+			return iok
+		default:
+			return names[i] < names[j]
+		}
+	})
+}
+
+// resolveLatestVersion returns the path of the highest-numbered vN.json in dir
+func resolveLatestVersion(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	latest, latestVersion := "", 0
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if v, ok := versionNumber(entry.Name()); ok && v > latestVersion {
+			latest, latestVersion = entry.Name(), v
+		}
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("no structure files found in %s", dir)
+	}
+	return filepath.Join(dir, latest), nil
+}
+
+// versionRange is an inclusive window of version numbers
+type versionRange struct {
+	min, max int
+}
+
+// allVersions is the range that every version falls in
+var allVersions = versionRange{min: 1, max: math.MaxInt}
+
+// parseVersionRange parses a --version range. A range is one or more comma-separated
+// bounds (">=3", ">2", "<=5", "<6", with or without a "v" prefix) or "range:A-B" for
+// A through B inclusive. ok is false when spec is a plain version name such as "v2".
+func parseVersionRange(spec string) (r versionRange, ok bool, err error) {
+	spec = strings.TrimSpace(spec)
+	if !strings.HasPrefix(spec, ">") && !strings.HasPrefix(spec, "<") && !strings.HasPrefix(spec, "range:") {
+		return versionRange{}, false, nil
+	}
+
+	invalid := fmt.Errorf("invalid version range '%s' (use >=N, >N, <=N, <N or range:A-B)", spec)
+	number := func(s string) (int, error) {
+		n, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(s), "v"))
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		return n, nil
+	}
+
+	r = allVersions
+	for _, bound := range strings.Split(spec, ",") {
+		bound = strings.TrimSpace(bound)
+		var op, value string
+		for _, candidate := range []string{">=", "<=", ">", "<", "range:"} {
+			if strings.HasPrefix(bound, candidate) {
+				op, value = candidate, bound[len(candidate):]
+				break
+			}
+		}
+
+		switch op {
+		case "range:":
+			from, to, found := strings.Cut(value, "-")
+			if !found {
+				return versionRange{}, true, invalid
+			}
+			lo, err := number(from)
+			if err != nil {
+				return versionRange{}, true, err
+			}
+			hi, err := number(to)
+			if err != nil {
+				return versionRange{}, true, err
+			}
+			r = r.intersect(versionRange{min: lo, max: hi})
+		case ">=", ">", "<=", "<":
+			n, err := number(value)
+			if err != nil {
+				return versionRange{}, true, err
+			}
+			switch op {
+			case ">=":
+				r = r.intersect(versionRange{min: n, max: math.MaxInt})
+			case ">":
+				r = r.intersect(versionRange{min: n + 1, max: math.MaxInt})
+			case "<=":
+				r = r.intersect(versionRange{min: 1, max: n})
+			case "<":
+				r = r.intersect(versionRange{min: 1, max: n - 1})
+			}
+		default:
+			return versionRange{}, true, invalid
+		}
+	}
+
+	if r.max < r.min {
+		return versionRange{}, true, fmt.Errorf("version range '%s' matches no version numbers", spec)
+	}
+	return r, true, nil
+}
+
+// intersect narrows r to the versions also in other
+func (r versionRange) intersect(other versionRange) versionRange {
+	return versionRange{min: max(r.min, other.min), max: min(r.max, other.max)}
+}
+
+// contains reports whether version v is inside the range
+func (r versionRange) contains(v int) bool {
+	return v >= r.min && v <= r.max
+}
+
+// String formats the range as "v2-v5" or "v3+"
+func (r versionRange) String() string {
+	if r.max == math.MaxInt {
+		return fmt.Sprintf("v%d+", r.min)
+	}
+	return fmt.Sprintf("v%d-v%d", r.min, r.max)
+}
+
+// resolveVersionRange returns the paths of the vN.json files in dir whose version
+// falls inside the range, in version order
+func resolveVersionRange(dir string, r versionRange) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if v, ok := versionNumber(entry.Name()); ok && !entry.IsDir() && r.contains(v) {
+			names = append(names, entry.Name())
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no versions in %s match %s", dir, r)
+	}
+
+	sortVersionFiles(names)
+	paths := make([]string, len(names))
+	for i, name := range names {
+		paths[i] = filepath.Join(dir, name)
+	}
+	return paths, nil
+}
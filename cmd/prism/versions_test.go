@@ -0,0 +1,109 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestResolveLatestVersion(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"v1.json", "v2.json", "v10.json", "approved.json", "v3.json.bak"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	latest, err := resolveLatestVersion(dir)
+	if err != nil {
+		t.Fatalf("resolveLatestVersion failed: %v", err)
+	}
+	if want := filepath.Join(dir, "v10.json"); latest != want {
+		t.Errorf("latest = %s, expected %s", latest, want)
+	}
+
+	if _, err := resolveLatestVersion(t.TempDir()); err == nil {
+		t.Error("Expected an error for a directory without versions")
+	}
+}
+
+func TestSortVersionFiles(t *testing.T) {
+	names := []string{"v10.json", "approved.json", "v2.json", "v1.json"}
+	sortVersionFiles(names)
+
+	want := []string{"v1.json", "v2.json", "v10.json", "approved.json"}
+	if !slices.Equal(names, want) {
+		t.Errorf("sorted = %v, expected %v", names, want)
+	}
+}
+
+func TestParseVersionRange(t *testing.T) {
+	tests := []struct {
+		spec    string
+		in, out []int
+	}{
+		{">=3", []int{3, 4, 10}, []int{1, 2}},
+		{">3", []int{4, 10}, []int{3}},
+		{"<=5", []int{1, 5}, []int{6}},
+		{"<5", []int{1, 4}, []int{5}},
+		{"range:2-5", []int{2, 3, 5}, []int{1, 6}},
+		{"range:v2-v2", []int{2}, []int{1, 3}},
+		{">=2,<10", []int{2, 9}, []int{1, 10}},
+	}
+
+	for _, tt := range tests {
+		r, ok, err := parseVersionRange(tt.spec)
+		if err != nil || !ok {
+			t.Errorf("parseVersionRange(%q) = ok %v, err %v", tt.spec, ok, err)
+			continue
+		}
+		for _, v := range tt.in {
+			if !r.contains(v) {
+				t.Errorf("%q should contain v%d", tt.spec, v)
+			}
+		}
+		for _, v := range tt.out {
+			if r.contains(v) {
+				t.Errorf("%q should not contain v%d", tt.spec, v)
+			}
+		}
+	}
+
+	// Plain names are not ranges
+	for _, spec := range []string{"v2", "latest", "approved"} {
+		if _, ok, err := parseVersionRange(spec); ok || err != nil {
+			t.Errorf("parseVersionRange(%q) = ok %v, err %v; expected a plain version", spec, ok, err)
+		}
+	}
+
+	for _, spec := range []string{">=x", "range:2", "range:5-2", "<1", ">=3,<3"} {
+		if _, _, err := parseVersionRange(spec); err == nil {
+			t.Errorf("parseVersionRange(%q) should fail", spec)
+		}
+	}
+}
+
+func TestResolveVersionRange(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"v1.json", "v2.json", "v10.json", "approved.json"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r, _, _ := parseVersionRange("range:2-10")
+	files, err := resolveVersionRange(dir, r)
+	if err != nil {
+		t.Fatalf("resolveVersionRange failed: %v", err)
+	}
+	want := []string{filepath.Join(dir, "v2.json"), filepath.Join(dir, "v10.json")}
+	if !slices.Equal(files, want) {
+		t.Errorf("files = %v, expected %v", files, want)
+	}
+
+	r, _, _ = parseVersionRange(">10")
+	if _, err := resolveVersionRange(dir, r); err == nil {
+		t.Error("Expected an error when no version matches")
+	}
+}
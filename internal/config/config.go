@@ -0,0 +1,165 @@
+// Package config loads project-wide defaults for PRISM from a JSON config file
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/johanbellander/prism/internal/validate"
+)
+
+// FileName is the name of the config file looked up in the home directory
+const FileName = ".prism"
+
+// Config holds team standards that override the validators' built-in defaults.
+// Zero values leave the corresponding default untouched.
+type Config struct {
+	Palette         []string             `json:"palette,omitempty"`          // allowed colors for Phase 2 designs
+	SpacingScale    []int                `json:"spacing_scale,omitempty"`    // allowed spacing values in pixels
+	TypographyRatio float64              `json:"typography_ratio,omitempty"` // type scale ratio, e.g. 1.25
+	ChoiceOverload  ChoiceOverloadConfig `json:"choice_overload"`
+	Validators      []string             `json:"validators,omitempty"` // validators run when none are selected by flag
+}
+
+// ChoiceOverloadConfig overrides the choice overload (Hick's Law) limits
+type ChoiceOverloadConfig struct {
+	MaxNavItems    int `json:"max_nav_items,omitempty"`
+	MaxFormFields  int `json:"max_form_fields,omitempty"`
+	MaxButtonGroup int `json:"max_button_group,omitempty"`
+	MaxCardGrid    int `json:"max_card_grid,omitempty"`
+}
+
+// DefaultPath returns ~/.prism, or "" when the home directory is unknown
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, FileName)
+}
+
+// Load reads the config file at path. An empty path loads DefaultPath, where a
+// missing file (or a ~/.prism directory) yields an empty config; an explicit path
+// must exist.
+func Load(path string) (*Config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = DefaultPath()
+		if path == "" {
+			return &Config{}, nil
+		}
+	}
+
+	info, err := os.Stat(path)
+	if !explicit && (errors.Is(err, os.ErrNotExist) || (err == nil && info.IsDir())) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Parse decodes config JSON, rejecting unknown keys so typos are not silently ignored
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(cfg); err != nil {
+		return nil, err
+	}
+
+	for _, value := range cfg.SpacingScale {
+		if value < 0 {
+			return nil, fmt.Errorf("spacing_scale values must not be negative (got %d)", value)
+		}
+	}
+	if cfg.TypographyRatio < 0 || (cfg.TypographyRatio > 0 && cfg.TypographyRatio <= 1) {
+		return nil, fmt.Errorf("typography_ratio must be greater than 1 (got %g)", cfg.TypographyRatio)
+	}
+
+	return cfg, nil
+}
+
+// SpacingRule returns the default spacing rule with the configured scale applied
+func (c *Config) SpacingRule() validate.SpacingRule {
+	rule := validate.DefaultSpacingRule()
+	if c != nil && len(c.SpacingScale) > 0 {
+		rule.AllowedScale = append([]int(nil), c.SpacingScale...)
+		sort.Ints(rule.AllowedScale)
+	}
+	return rule
+}
+
+// TypographyRule returns the default typography rule with the configured ratio applied
+func (c *Config) TypographyRule() validate.TypographyRule {
+	rule := validate.DefaultTypographyRule()
+	if c != nil && c.TypographyRatio > 0 {
+		rule.ScaleRatio = c.TypographyRatio
+	}
+	return rule
+}
+
+// ChoiceRule returns the default choice overload rule with the configured limits applied
+func (c *Config) ChoiceRule() validate.ChoiceRule {
+	rule := validate.DefaultChoiceRule()
+	if c == nil {
+		return rule
+	}
+	if c.ChoiceOverload.MaxNavItems > 0 {
+		rule.MaxNavItems = c.ChoiceOverload.MaxNavItems
+	}
+	if c.ChoiceOverload.MaxFormFields > 0 {
+		rule.MaxFormFields = c.ChoiceOverload.MaxFormFields
+	}
+	if c.ChoiceOverload.MaxButtonGroup > 0 {
+		rule.MaxButtonGroup = c.ChoiceOverload.MaxButtonGroup
+	}
+	if c.ChoiceOverload.MaxCardGrid > 0 {
+		rule.MaxCardGrid = c.ChoiceOverload.MaxCardGrid
+	}
+	return rule
+}
+
+// PaletteRule returns the default palette rule with the configured palette applied
+func (c *Config) PaletteRule() validate.PaletteRule {
+	rule := validate.DefaultPaletteRule()
+	if c != nil && len(c.Palette) > 0 {
+		rule.Colors = append([]string(nil), c.Palette...)
+	}
+	return rule
+}
+
+// AuditRules returns the audit rules with the configured overrides applied
+func (c *Config) AuditRules() validate.AuditRules {
+	rules := validate.DefaultAuditRules()
+	rules.Spacing = c.SpacingRule()
+	rules.Typography = c.TypographyRule()
+	rules.ChoiceOverload = c.ChoiceRule()
+	rules.Palette = c.PaletteRule()
+	return rules
+}
+
+// SelectValidators returns the validators to run: those chosen by flag when any
+// were, otherwise the configured defaults
+func (c *Config) SelectValidators(flagged []string) []string {
+	if len(flagged) > 0 || c == nil {
+		return flagged
+	}
+	return c.Validators
+}
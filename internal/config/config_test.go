@@ -0,0 +1,182 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/internal/validate"
+)
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse([]byte(`{
+		"palette": ["#111111", "#FAFAFA"],
+		"spacing_scale": [16, 0, 8, 24],
+		"typography_ratio": 1.333,
+		"choice_overload": {"max_nav_items": 5},
+		"validators": ["hierarchy", "spacing"]
+	}`))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(cfg.Palette) != 2 || cfg.TypographyRatio != 1.333 || cfg.ChoiceOverload.MaxNavItems != 5 {
+		t.Errorf("Unexpected config: %+v", cfg)
+	}
+	if !reflect.DeepEqual(cfg.Validators, []string{"hierarchy", "spacing"}) {
+		t.Errorf("Validators = %v", cfg.Validators)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"unknown key", `{"spacing": [8]}`, "unknown field"},
+		{"negative spacing", `{"spacing_scale": [8, -4]}`, "must not be negative"},
+		{"ratio too small", `{"typography_ratio": 0.8}`, "greater than 1"},
+		{"malformed", `{`, "unexpected EOF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Parse(%s) error = %v, expected it to mention %q", tt.input, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	// A missing default config is not an error
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("Load with no ~/.prism failed: %v", err)
+	}
+	if !reflect.DeepEqual(cfg, &Config{}) {
+		t.Errorf("Expected an empty config, got %+v", cfg)
+	}
+
+	// A missing explicit config is
+	if _, err := Load(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("Expected an error for a missing explicit config")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, FileName), []byte(`{"typography_ratio": 1.5}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = Load("")
+	if err != nil {
+		t.Fatalf("Load of ~/.prism failed: %v", err)
+	}
+	if cfg.TypographyRatio != 1.5 {
+		t.Errorf("Expected ratio from ~/.prism, got %+v", cfg)
+	}
+}
+
+func TestConfig_RulesOverrideDefaults(t *testing.T) {
+	cfg := &Config{
+		SpacingScale:    []int{16, 0, 8},
+		TypographyRatio: 1.5,
+		ChoiceOverload:  ChoiceOverloadConfig{MaxNavItems: 5, MaxCardGrid: 20},
+	}
+
+	spacing := cfg.SpacingRule()
+	if !reflect.DeepEqual(spacing.AllowedScale, []int{0, 8, 16}) {
+		t.Errorf("AllowedScale = %v, expected the sorted configured scale", spacing.AllowedScale)
+	}
+	if spacing.MaxDistinctValues != validate.DefaultSpacingRule().MaxDistinctValues {
+		t.Error("Expected unconfigured spacing settings to keep their defaults")
+	}
+
+	if got := cfg.TypographyRule().ScaleRatio; got != 1.5 {
+		t.Errorf("ScaleRatio = %v, expected 1.5", got)
+	}
+
+	choice := cfg.ChoiceRule()
+	defaults := validate.DefaultChoiceRule()
+	if choice.MaxNavItems != 5 || choice.MaxCardGrid != 20 {
+		t.Errorf("Expected configured choice limits, got %+v", choice)
+	}
+	if choice.MaxFormFields != defaults.MaxFormFields || choice.MaxButtonGroup != defaults.MaxButtonGroup {
+		t.Errorf("Expected unconfigured choice limits to keep their defaults, got %+v", choice)
+	}
+
+	if got := (&Config{Palette: []string{"#111827"}}).PaletteRule().Colors; !reflect.DeepEqual(got, []string{"#111827"}) {
+		t.Errorf("Palette colors = %v, expected the configured palette", got)
+	}
+
+	rules := cfg.AuditRules()
+	if rules.ChoiceOverload.MaxNavItems != 5 || rules.Typography.ScaleRatio != 1.5 {
+		t.Errorf("Expected audit rules to carry the overrides, got %+v", rules)
+	}
+}
+
+func TestConfig_PaletteReachesValidator(t *testing.T) {
+	cfg, err := Parse([]byte(`{"palette": ["#FFFFFF", "#000000"]}`))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "title", Type: "text", Content: "Settings", Color: "#737373"},
+		},
+	}
+
+	// Built-in default: no palette, so the check is left out of the audit
+	if _, ok := validate.RunAuditWithRules(structure, validate.DefaultAuditRules()).Entry("palette"); ok {
+		t.Error("Expected the default rules to skip palette adherence")
+	}
+
+	// The configured palette overrides the default and flags the gray
+	entry := paletteEntry(t, validate.RunAuditWithRules(structure, cfg.AuditRules()))
+	if entry.Passed || entry.Errors != 1 {
+		t.Errorf("Expected the configured palette to flag #737373, got %+v", entry)
+	}
+
+	// A palette declared in the structure takes precedence over the config
+	structure.Palette = []string{"#FFFFFF", "#000000", "#737373"}
+	if entry := paletteEntry(t, validate.RunAuditWithRules(structure, cfg.AuditRules())); !entry.Passed {
+		t.Errorf("Expected the structure palette to win over the config, got %+v", entry)
+	}
+}
+
+func paletteEntry(t *testing.T, report validate.AuditReport) validate.AuditEntry {
+	t.Helper()
+	entry, ok := report.Entry("palette")
+	if !ok {
+		t.Fatal("Expected a palette entry in the audit report")
+	}
+	return entry
+}
+
+func TestConfig_NilUsesDefaults(t *testing.T) {
+	var cfg *Config
+	if !reflect.DeepEqual(cfg.AuditRules(), validate.DefaultAuditRules()) {
+		t.Error("Expected a nil config to produce the default rules")
+	}
+	if got := cfg.SelectValidators(nil); len(got) != 0 {
+		t.Errorf("Expected no validators from a nil config, got %v", got)
+	}
+}
+
+func TestConfig_SelectValidators(t *testing.T) {
+	cfg := &Config{Validators: []string{"hierarchy", "spacing"}}
+
+	if got := cfg.SelectValidators(nil); !reflect.DeepEqual(got, []string{"hierarchy", "spacing"}) {
+		t.Errorf("Expected configured validators without flags, got %v", got)
+	}
+	// Flags win over the config
+	if got := cfg.SelectValidators([]string{"contrast"}); !reflect.DeepEqual(got, []string{"contrast"}) {
+		t.Errorf("Expected flagged validators to replace the config, got %v", got)
+	}
+}
@@ -0,0 +1,200 @@
+package render
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// namedColors maps the CSS color names the renderer understands to their values
+var namedColors = map[string]color.NRGBA{
+	"transparent": {0, 0, 0, 0},
+	"black":       {0, 0, 0, 255},
+	"white":       {255, 255, 255, 255},
+	"gray":        {128, 128, 128, 255},
+	"grey":        {128, 128, 128, 255},
+	"silver":      {192, 192, 192, 255},
+	"red":         {255, 0, 0, 255},
+	"green":       {0, 128, 0, 255},
+	"blue":        {0, 0, 255, 255},
+	"yellow":      {255, 255, 0, 255},
+	"orange":      {255, 165, 0, 255},
+}
+
+// pageBackground is the background behind top-level components
+var pageBackground = color.NRGBA{255, 255, 255, 255}
+
+// canvasBackground returns the opaque canvas fill: the Background option composited
+// over white, or white when none is set
+func (r *Renderer) canvasBackground() (color.NRGBA, error) {
+	if r.opts.Background == "" {
+		return pageBackground, nil
+	}
+	c, ok := ParseColor(r.opts.Background)
+	if !ok {
+		return color.NRGBA{}, fmt.Errorf("unrecognized background color '%s'", r.opts.Background)
+	}
+	return CompositeOver(c, pageBackground), nil
+}
+
+// effectiveBackground returns the opaque color seen behind a component that declares
+// the given background on top of base. Translucent backgrounds are blended over base;
+// unrecognized values are drawn (and so inherited) as black.
+func effectiveBackground(value string, base color.NRGBA) color.NRGBA {
+	c, ok := ParseColor(value)
+	if !ok {
+		c = color.NRGBA{0, 0, 0, 255}
+	}
+	return CompositeOver(c, base)
+}
+
+// CompositeOver alpha-blends src over an opaque base
+func CompositeOver(src, base color.NRGBA) color.NRGBA {
+	blend := func(s, b uint8) uint8 {
+		return uint8((int(s)*int(src.A) + int(b)*(255-int(src.A)) + 127) / 255)
+	}
+	return color.NRGBA{blend(src.R, base.R), blend(src.G, base.G), blend(src.B, base.B), 255}
+}
+
+// placeholderFill returns the image placeholder color for a base: a 10% black tint on
+// light bases (#E5E5E5 on white) and a 10% white tint on dark ones, so the placeholder
+// stays visible whatever it sits on
+func placeholderFill(base color.NRGBA) color.NRGBA {
+	tint := color.NRGBA{0, 0, 0, 26}
+	if (299*int(base.R)+587*int(base.G)+114*int(base.B))/1000 < 128 {
+		tint = color.NRGBA{255, 255, 255, 26}
+	}
+	return CompositeOver(tint, base)
+}
+
+// parsedColor is a cached parse result, including unrecognized values
+type parsedColor struct {
+	color color.NRGBA
+	ok    bool
+}
+
+// maxCachedColors bounds the color cache. A long-running process that renders many
+// unrelated structures starts the cache over instead of growing it without limit.
+const maxCachedColors = 1024
+
+// colorCache interns parse results by the raw color string. Structures reuse a
+// handful of colors across many components, renders and contrast checks, so each
+// distinct string is parsed once. It is safe for concurrent renders.
+var colorCache = struct {
+	sync.RWMutex
+	values map[string]parsedColor
+}{values: map[string]parsedColor{}}
+
+// parseColorValue does the actual parsing on a cache miss; tests replace it to count parses
+var parseColorValue = parseCSSColor
+
+// ParseColor parses a CSS color string, reporting whether it was recognized.
+// Results are cached, so repeated lookups of the same value are cheap.
+func ParseColor(value string) (color.NRGBA, bool) {
+	colorCache.RLock()
+	parsed, cached := colorCache.values[value]
+	colorCache.RUnlock()
+	if cached {
+		return parsed.color, parsed.ok
+	}
+
+	c, ok := parseColorValue(value)
+	colorCache.Lock()
+	if len(colorCache.values) >= maxCachedColors {
+		colorCache.values = map[string]parsedColor{}
+	}
+	colorCache.values[value] = parsedColor{color: c, ok: ok}
+	colorCache.Unlock()
+	return c, ok
+}
+
+// resetColorCache empties the color cache
+func resetColorCache() {
+	colorCache.Lock()
+	colorCache.values = map[string]parsedColor{}
+	colorCache.Unlock()
+}
+
+// parseColor converts a CSS color string to color.Color.
+// Supports #RGB, #RRGGBB, #RRGGBBAA, rgb(), rgba() and a small set of named colors.
+// Unrecognized values render as black.
+func parseColor(value string) color.Color {
+	if c, ok := ParseColor(value); ok {
+		return c
+	}
+	return color.Black
+}
+
+// parseCSSColor parses a CSS color string, reporting whether it was recognized
+func parseCSSColor(value string) (color.NRGBA, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return color.NRGBA{}, false
+	}
+
+	if strings.HasPrefix(value, "#") {
+		return parseHexColor(value[1:])
+	}
+
+	if strings.HasPrefix(value, "rgb(") || strings.HasPrefix(value, "rgba(") {
+		return parseRGBFunc(value)
+	}
+
+	c, ok := namedColors[value]
+	return c, ok
+}
+
+// parseHexColor parses the digits of a hex color without the leading '#'
+func parseHexColor(hex string) (color.NRGBA, bool) {
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return color.NRGBA{}, false
+	}
+
+	val, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, false
+	}
+	return color.NRGBA{uint8(val >> 24), uint8(val >> 16), uint8(val >> 8), uint8(val)}, true
+}
+
+// parseRGBFunc parses rgb(r, g, b) and rgba(r, g, b, a) with a fractional alpha
+func parseRGBFunc(value string) (color.NRGBA, bool) {
+	open := strings.Index(value, "(")
+	if open < 0 || !strings.HasSuffix(value, ")") {
+		return color.NRGBA{}, false
+	}
+
+	parts := strings.Split(value[open+1:len(value)-1], ",")
+	if len(parts) != 3 && len(parts) != 4 {
+		return color.NRGBA{}, false
+	}
+
+	var channels [3]uint8
+	for i := 0; i < 3; i++ {
+		n, err := strconv.Atoi(strings.TrimSpace(parts[i]))
+		if err != nil || n < 0 || n > 255 {
+			return color.NRGBA{}, false
+		}
+		channels[i] = uint8(n)
+	}
+
+	alpha := uint8(255)
+	if len(parts) == 4 {
+		a, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+		if err != nil || a < 0 || a > 1 {
+			return color.NRGBA{}, false
+		}
+		alpha = uint8(math.Round(a * 255))
+	}
+
+	return color.NRGBA{channels[0], channels[1], channels[2], alpha}, true
+}
@@ -0,0 +1,134 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected color.NRGBA
+	}{
+		{"hex", "#3B82F6", color.NRGBA{59, 130, 246, 255}},
+		{"lowercase hex", "#3b82f6", color.NRGBA{59, 130, 246, 255}},
+		{"shorthand hex", "#FFF", color.NRGBA{255, 255, 255, 255}},
+		{"hex with alpha", "#00000080", color.NRGBA{0, 0, 0, 128}},
+		{"transparent hex", "#FFFFFF00", color.NRGBA{255, 255, 255, 0}},
+		{"rgb", "rgb(255, 0, 0)", color.NRGBA{255, 0, 0, 255}},
+		{"rgba fractional alpha", "rgba(0,0,0,0.5)", color.NRGBA{0, 0, 0, 128}},
+		{"rgba spaced", "rgba( 16, 32, 64, 0.25 )", color.NRGBA{16, 32, 64, 64}},
+		{"white", "white", color.NRGBA{255, 255, 255, 255}},
+		{"black", "Black", color.NRGBA{0, 0, 0, 255}},
+		{"transparent", "transparent", color.NRGBA{0, 0, 0, 0}},
+		{"unknown name", "chartreuse-ish", color.NRGBA{0, 0, 0, 255}},
+		{"invalid hex", "#GGGGGG", color.NRGBA{0, 0, 0, 255}},
+		{"rgb out of range", "rgb(300, 0, 0)", color.NRGBA{0, 0, 0, 255}},
+		{"empty", "", color.NRGBA{0, 0, 0, 255}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := color.NRGBAModel.Convert(parseColor(tt.value)).(color.NRGBA)
+			if got != tt.expected {
+				t.Errorf("parseColor(%q) = %v, expected %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+// largeColorStructure has many components sharing a handful of colors
+func largeColorStructure() *types.Structure {
+	colors := []string{"#000000", "#FFFFFF", "#E5E5E5", "#737373", "#525252"}
+	structure := &types.Structure{Version: "v1"}
+	for i := 0; i < 50; i++ {
+		card := types.Component{
+			ID:     fmt.Sprintf("card-%d", i),
+			Type:   "card",
+			Layout: types.ComponentLayout{Background: colors[i%len(colors)]},
+		}
+		for j := 0; j < 10; j++ {
+			card.Children = append(card.Children, types.Component{
+				ID:      fmt.Sprintf("card-%d-text-%d", i, j),
+				Type:    "text",
+				Content: "Label",
+				Color:   colors[(i+j)%len(colors)],
+			})
+		}
+		structure.Components = append(structure.Components, card)
+	}
+	return structure
+}
+
+// countColorParses counts the parses ParseColor does on cache misses until the test ends
+func countColorParses(tb testing.TB) *int {
+	tb.Helper()
+	resetColorCache()
+	parses := 0
+	parseColorValue = func(value string) (color.NRGBA, bool) {
+		parses++
+		return parseCSSColor(value)
+	}
+	tb.Cleanup(func() { parseColorValue = parseCSSColor })
+	return &parses
+}
+
+func TestParseColor_CachesEachValueOnce(t *testing.T) {
+	parses := countColorParses(t)
+	structure := largeColorStructure()
+
+	cold, err := NewRenderer(RenderOptions{Width: 800}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if *parses != 5 {
+		t.Errorf("Expected 5 parses for 5 distinct colors over 550 components, got %d", *parses)
+	}
+
+	warm, err := NewRenderer(RenderOptions{Width: 800}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if *parses != 5 {
+		t.Errorf("Expected a second render to reuse cached colors, got %d parses", *parses)
+	}
+	if !bytes.Equal(cold.Image.Pix, warm.Image.Pix) {
+		t.Error("Expected identical output from cached and uncached color parsing")
+	}
+}
+
+func TestParseColor_CacheIsBounded(t *testing.T) {
+	resetColorCache()
+	for i := 0; i <= maxCachedColors*2; i++ {
+		ParseColor(fmt.Sprintf("#%06X", i))
+	}
+
+	colorCache.RLock()
+	size := len(colorCache.values)
+	colorCache.RUnlock()
+	if size == 0 || size > maxCachedColors {
+		t.Errorf("Expected at most %d cached colors, got %d", maxCachedColors, size)
+	}
+	if c, ok := ParseColor("#000010"); !ok || c != (color.NRGBA{0, 0, 16, 255}) {
+		t.Errorf("Expected evicted colors to parse again, got %v %v", c, ok)
+	}
+}
+
+func BenchmarkRender_LargeStructure(b *testing.B) {
+	structure := largeColorStructure()
+	renderer := NewRenderer(RenderOptions{Width: 800})
+	parses := countColorParses(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := renderer.Render(structure); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(*parses)/float64(b.N), "parses/op")
+}
@@ -0,0 +1,60 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Comparison geometry in unscaled pixels
+const (
+	compareLabelHeight = 24 // strip above each render for its version label
+	compareDivider     = 20 // band between the two renders, with a rule down the middle
+)
+
+// ComposeComparison places two renders side by side, each labeled above with its
+// version, separated by a divider. The shorter render is padded with white to the
+// height of the taller one.
+func ComposeComparison(from, to *RenderResult, fromLabel, toLabel string, scale int) *RenderResult {
+	if scale <= 0 {
+		scale = 1
+	}
+	labelHeight, divider := compareLabelHeight*scale, compareDivider*scale
+
+	width := from.Width + divider + to.Width
+	height := labelHeight + max(from.Height, to.Height)
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	sides := []struct {
+		result *RenderResult
+		label  string
+		x      int
+	}{
+		{from, fromLabel, 0},
+		{to, toLabel, from.Width + divider},
+	}
+	for _, side := range sides {
+		d := &font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(palette.TextLabel),
+			Face: basicfont.Face7x13,
+			Dot:  fixed.P(side.x+8*scale, 16*scale),
+		}
+		d.DrawString(side.label)
+
+		rect := image.Rect(side.x, labelHeight, side.x+side.result.Width, labelHeight+side.result.Height)
+		draw.Draw(img, rect, side.result.Image, image.Point{}, draw.Src)
+	}
+
+	// Divider rule, full height, centered in the band
+	rule := image.Rect(from.Width+divider/2-scale/2, 0, from.Width+divider/2-scale/2+scale, height)
+	draw.Draw(img, rule, &image.Uniform{palette.Border}, image.Point{}, draw.Src)
+
+	return &RenderResult{Image: img, Width: width, Height: height}
+}
@@ -0,0 +1,55 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestComposeComparison(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{{ID: "title", Type: "text", Content: "Heading"}},
+	}
+	from, err := NewRenderer(RenderOptions{Width: 300, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	to, err := NewRenderer(RenderOptions{Width: 300, Height: 320}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	result := ComposeComparison(from, to, "v1", "v2", 1)
+
+	// Both renders plus the divider, side by side below the label strip
+	if result.Width != from.Width+to.Width+compareDivider {
+		t.Errorf("Expected width %d, got %d", from.Width+to.Width+compareDivider, result.Width)
+	}
+	if result.Height != compareLabelHeight+to.Height || result.Image.Bounds().Dy() != result.Height {
+		t.Errorf("Expected height %d padded to the taller render, got %d", compareLabelHeight+to.Height, result.Height)
+	}
+
+	// The divider rule runs down the middle of the band
+	if got := result.Image.RGBAAt(from.Width+compareDivider/2, result.Height-1); got != (color.RGBA{229, 229, 229, 255}) {
+		t.Errorf("Expected the divider rule, got %v", got)
+	}
+	// The shorter render is padded with white
+	if got := result.Image.RGBAAt(from.Width/2, result.Height-1); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected white padding below the shorter render, got %v", got)
+	}
+	// Each side carries its label
+	labelInk := func(x0, x1 int) bool {
+		for x := x0; x < x1; x++ {
+			for y := 0; y < compareLabelHeight; y++ {
+				if result.Image.RGBAAt(x, y) != (color.RGBA{255, 255, 255, 255}) && x != from.Width+compareDivider/2 {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !labelInk(0, 40) || !labelInk(from.Width+compareDivider, from.Width+compareDivider+40) {
+		t.Error("Expected version labels above both renders")
+	}
+}
@@ -0,0 +1,112 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Contact sheet geometry in pixels
+const (
+	contactSheetMargin      = 16 // around the sheet and between thumbnails
+	contactSheetLabelHeight = 20 // space above each thumbnail for its label
+)
+
+// ContactSheetEntry is a single labeled image placed on a contact sheet
+type ContactSheetEntry struct {
+	Label string
+	Image image.Image
+}
+
+// ContactSheetOptions controls how a contact sheet is laid out
+type ContactSheetOptions struct {
+	Columns    int // thumbnails per row (default 3)
+	ThumbWidth int // thumbnail width in pixels (default 300)
+}
+
+// ComposeContactSheet scales each entry down to a thumbnail and arranges them
+// in a labeled grid, left to right and top to bottom
+func ComposeContactSheet(entries []ContactSheetEntry, opts ContactSheetOptions) *image.RGBA {
+	if opts.Columns <= 0 {
+		opts.Columns = 3
+	}
+	if opts.ThumbWidth <= 0 {
+		opts.ThumbWidth = 300
+	}
+
+	columns := opts.Columns
+	if len(entries) < columns {
+		columns = len(entries)
+	}
+	if columns == 0 {
+		columns = 1
+	}
+
+	// Thumbnails keep their aspect ratio; each row is as tall as its tallest thumbnail
+	thumbHeights := make([]int, len(entries))
+	rowHeights := make([]int, (len(entries)+columns-1)/columns)
+	for i, entry := range entries {
+		bounds := entry.Image.Bounds()
+		if bounds.Dx() > 0 {
+			thumbHeights[i] = bounds.Dy() * opts.ThumbWidth / bounds.Dx()
+		}
+		if row := i / columns; thumbHeights[i] > rowHeights[row] {
+			rowHeights[row] = thumbHeights[i]
+		}
+	}
+
+	width := contactSheetMargin + columns*(opts.ThumbWidth+contactSheetMargin)
+	height := contactSheetMargin
+	for _, rowHeight := range rowHeights {
+		height += contactSheetLabelHeight + rowHeight + contactSheetMargin
+	}
+
+	sheet := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(sheet, sheet.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	borderColor := palette.Border
+	labelColor := palette.TextLabel
+
+	y := contactSheetMargin
+	for row, rowHeight := range rowHeights {
+		for col := 0; col < columns; col++ {
+			i := row*columns + col
+			if i >= len(entries) {
+				break
+			}
+			x := contactSheetMargin + col*(opts.ThumbWidth+contactSheetMargin)
+
+			d := &font.Drawer{
+				Dst:  sheet,
+				Src:  image.NewUniform(labelColor),
+				Face: basicfont.Face7x13,
+				Dot:  fixed.P(x, y+14),
+			}
+			d.DrawString(entries[i].Label)
+
+			thumb := image.Rect(x, y+contactSheetLabelHeight, x+opts.ThumbWidth, y+contactSheetLabelHeight+thumbHeights[i])
+			xdraw.ApproxBiLinear.Scale(sheet, thumb, entries[i].Image, entries[i].Image.Bounds(), draw.Src, nil)
+			drawOutline(sheet, thumb.Inset(-1), borderColor)
+		}
+		y += contactSheetLabelHeight + rowHeight + contactSheetMargin
+	}
+
+	return sheet
+}
+
+// drawOutline draws a 1px rectangle outline just inside r
+func drawOutline(img *image.RGBA, r image.Rectangle, col color.Color) {
+	for x := r.Min.X; x < r.Max.X; x++ {
+		img.Set(x, r.Min.Y, col)
+		img.Set(x, r.Max.Y-1, col)
+	}
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		img.Set(r.Min.X, y, col)
+		img.Set(r.Max.X-1, y, col)
+	}
+}
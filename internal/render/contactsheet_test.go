@@ -0,0 +1,57 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func solidImage(width, height int, c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{c}, image.Point{}, draw.Src)
+	return img
+}
+
+func TestComposeContactSheet(t *testing.T) {
+	black := color.RGBA{0, 0, 0, 255}
+	entries := []ContactSheetEntry{
+		{Label: "v1", Image: solidImage(600, 400, black)},
+		{Label: "v2", Image: solidImage(600, 800, black)},
+		{Label: "v3", Image: solidImage(600, 200, black)},
+	}
+
+	sheet := ComposeContactSheet(entries, ContactSheetOptions{Columns: 2, ThumbWidth: 100})
+
+	// Two columns of 100px thumbnails with 16px margins
+	if got, expected := sheet.Bounds().Dx(), 16+2*(100+16); got != expected {
+		t.Errorf("Sheet width = %d, expected %d", got, expected)
+	}
+	// Row 1 is as tall as v2 (133px), row 2 as tall as v3 (33px)
+	if got, expected := sheet.Bounds().Dy(), 16+(20+133+16)+(20+33+16); got != expected {
+		t.Errorf("Sheet height = %d, expected %d", got, expected)
+	}
+
+	// Inside each thumbnail the scaled image is drawn
+	thumbs := []image.Point{{66, 16 + 20 + 33}, {182, 16 + 20 + 100}, {66, 16 + 169 + 20 + 15}}
+	for i, p := range thumbs {
+		if got := sheet.RGBAAt(p.X, p.Y); got != black {
+			t.Errorf("Expected thumbnail %d pixel at %v to be black, got %v", i, p, got)
+		}
+	}
+
+	// Below v1's 66px thumbnail the row is padded with background
+	if got := sheet.RGBAAt(66, 16+20+100); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected background below short thumbnail, got %v", got)
+	}
+}
+
+func TestComposeContactSheet_Defaults(t *testing.T) {
+	entries := []ContactSheetEntry{{Label: "v1", Image: solidImage(300, 300, color.Black)}}
+	sheet := ComposeContactSheet(entries, ContactSheetOptions{})
+
+	// A single entry produces a single 300px column
+	if got, expected := sheet.Bounds().Dx(), 16+300+16; got != expected {
+		t.Errorf("Sheet width = %d, expected %d", got, expected)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"image/color"
 	"image/draw"
 	"image/png"
+	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -17,12 +20,21 @@ import (
 
 // RenderOptions configures the rendering process
 type RenderOptions struct {
-	Width       int
-	Height      int
-	Scale       int
-	Viewport    string // "mobile", "tablet", "desktop"
-	Annotations bool
-	Grid        bool
+	Width        int
+	Height       int
+	Scale        int
+	Viewport     string    // "mobile", "tablet", "desktop"
+	Annotations  bool
+	Grid         bool
+	ShowFocus    bool      // draw a focus-ring preview around interactive components
+	TabOrder     bool      // number interactive components in keyboard focus order, with arrows between them
+	Guides       bool      // draw alignment guides through top-level component edges (blue shared, faint single, red misaligned)
+	ImageLabels  bool      // label image placeholders with their component ID instead of "IMAGE"
+	Component    string    // render only the subtree rooted at this component ID ("" for the whole page)
+	Background   string    // canvas fill as a CSS color, e.g. "#111827" ("" for white)
+	Transparent  bool      // leave the canvas unfilled (alpha zero) so the PNG overlays other backgrounds
+	ContentWidth int       // content column width, centered on the canvas (0 for the structure's layout.max_width)
+	Log          io.Writer // debug log of layout and render decisions (nil for silent)
 }
 
 // RenderResult contains the result of a rendering operation
@@ -31,6 +43,9 @@ type RenderResult struct {
 	Width      int
 	Height     int
 	OutputPath string
+	Warnings   []LayoutWarning      // layout notes such as ragged grid rows
+	Overflow   int                  // content height hidden below a fixed Height, in canvas pixels (0 when it fits)
+	Boxes      map[string]LayoutBox // computed component boxes by ID, in canvas pixels
 }
 
 // Renderer handles rendering Phase 1 structures to images
@@ -56,6 +71,10 @@ func NewRenderer(opts RenderOptions) *Renderer {
 
 // Render renders a structure to an image
 func (r *Renderer) Render(structure *types.Structure) (*RenderResult, error) {
+	if r.opts.Component != "" {
+		return r.renderSubtree(structure)
+	}
+
 	// Calculate canvas dimensions
 	width := r.opts.Width * r.opts.Scale
 	height := r.opts.Height * r.opts.Scale
@@ -63,16 +82,34 @@ func (r *Renderer) Render(structure *types.Structure) (*RenderResult, error) {
 	// If height is 0 (auto), calculate based on content
 	if height == 0 {
 		height = r.calculateHeight(structure) * r.opts.Scale
+		r.logf("auto height estimated as %dpx", height)
+	}
+
+	// Annotated renders reserve a strip below the mockup for the legend
+	canvasHeight := height
+	if r.opts.Annotations {
+		canvasHeight += r.legendHeight(width)
+	}
+
+	background, err := r.canvasBackground()
+	if err != nil {
+		return nil, err
 	}
 
 	// Create the image
-	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	img := image.NewRGBA(image.Rect(0, 0, width, canvasHeight))
 	
-	// Fill with white background
-	draw.Draw(img, img.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+	// Fill with the canvas background; transparent renders leave it at alpha zero
+	if !r.opts.Transparent {
+		draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+	}
+
+	r.logf("canvas %dx%d at %dx scale (%s viewport)", width, canvasHeight, r.opts.Scale, r.opts.Viewport)
 
 	// Create layout engine
 	layoutEngine := NewLayoutEngine(r.opts.Scale)
+	layoutEngine.SetLog(r.opts.Log)
+	layoutEngine.SetContentWidth(r.opts.ContentWidth)
 	
 	// Calculate layout for all components
 	boxes, err := layoutEngine.CalculateLayout(structure, width, height)
@@ -82,22 +119,54 @@ func (r *Renderer) Render(structure *types.Structure) (*RenderResult, error) {
 
 	// Create render context
 	ctx := &renderContext{
-		img:    img,
-		scale:  r.opts.Scale,
-		boxes:  boxes,
+		img:         img,
+		scale:       r.opts.Scale,
+		boxes:       boxes,
+		backgrounds: types.LayerBackgrounds(structure, background, effectiveBackground),
+		shadows:     structure.Phase == "design",
 	}
 
 	// Render components using calculated layout
-	for _, comp := range structure.Components {
-		if err := r.renderComponent(ctx, &comp); err != nil {
+	for _, comp := range paintOrder(structure.Components) {
+		if err := r.renderComponent(ctx, comp); err != nil {
 			return nil, fmt.Errorf("failed to render component %s: %w", comp.ID, err)
 		}
 	}
 
+	// Overlay focus rings on top of the rendered components
+	if r.opts.ShowFocus {
+		r.renderFocusRings(ctx, structure.Components)
+	}
+
+	if r.opts.TabOrder {
+		r.renderTabOrder(ctx, structure.Components)
+	}
+
+	if r.opts.Guides {
+		r.renderGuides(ctx, structure.Components, height)
+	}
+
+	// A fixed height shorter than the content is shown like a scrollable viewport
+	overflow := 0
+	if r.opts.Height > 0 {
+		if bottom := contentBottom(boxes); bottom > height {
+			overflow = bottom - height
+			r.logf("content is %dpx taller than the fixed %dpx height, drawing overflow indicator", overflow, height)
+			r.renderOverflowIndicator(img, height, overflow, background)
+		}
+	}
+
+	if r.opts.Annotations {
+		r.drawLegend(img, structure, height)
+	}
+
 	return &RenderResult{
-		Image:  img,
-		Width:  width,
-		Height: height,
+		Image:    img,
+		Width:    width,
+		Height:   canvasHeight,
+		Warnings: layoutEngine.Warnings(),
+		Boxes:    boxes,
+		Overflow: overflow,
 	}, nil
 }
 
@@ -118,9 +187,11 @@ func (r *RenderResult) SavePNG(path string) error {
 
 // renderContext holds the current rendering state
 type renderContext struct {
-	img   *image.RGBA
-	scale int
-	boxes map[string]LayoutBox // calculated layout boxes for all components
+	img         *image.RGBA
+	scale       int
+	boxes       map[string]LayoutBox   // calculated layout boxes for all components
+	backgrounds map[string]color.NRGBA // effective (opaque) background each component is drawn on, by ID
+	shadows     bool                   // draw drop shadows (Phase 2 designs only; Phase 1 has no styling)
 }
 
 // calculateHeight estimates the height needed for the content
@@ -149,12 +220,15 @@ func (r *Renderer) estimateComponentHeight(comp *types.Component) int {
 	baseHeight := comp.Layout.Padding * 2
 
 	// Estimate based on component type
-	switch comp.Type {
+	switch comp.BaseType() {
 	case "text":
 		return baseHeight + r.getTextHeight(comp.Size)
 	case "button":
 		return baseHeight + 44 // minimum touch target
 	case "input":
+		if comp.InputKind() == "textarea" {
+			return baseHeight + 96
+		}
 		return baseHeight + 40
 	case "image":
 		return baseHeight + 200 // placeholder size
@@ -199,8 +273,19 @@ func (r *Renderer) renderComponent(ctx *renderContext, comp *types.Component) er
 		return fmt.Errorf("no layout box found for component %s", comp.ID)
 	}
 
+	// Elevation sits beneath the component, so it is drawn first
+	if ctx.shadows && comp.Layout.Shadow != "" {
+		r.renderShadow(ctx, comp, box)
+	}
+
+	// Loading components with a skeleton render placeholders instead of content
+	if comp.State == "loading" && comp.Skeleton != nil && len(comp.Skeleton.Elements) > 0 {
+		r.logf("'%s': loading state, drawing %d skeleton elements", comp.ID, len(comp.Skeleton.Elements))
+		return r.renderSkeleton(ctx, comp, box)
+	}
+
 	// Render based on component type
-	switch comp.Type {
+	switch comp.BaseType() {
 	case "box":
 		return r.renderBox(ctx, comp, box)
 	case "text":
@@ -212,7 +297,28 @@ func (r *Renderer) renderComponent(ctx *renderContext, comp *types.Component) er
 	case "image":
 		return r.renderImage(ctx, comp, box)
 	default:
-		return fmt.Errorf("unsupported component type: %s", comp.Type)
+		r.logf("'%s': unknown type '%s', drawing labeled fallback box", comp.ID, comp.Type)
+		return r.renderUnknown(ctx, comp, box)
+	}
+}
+
+// paintOrder returns sibling components in drawing order: ascending z_index, with
+// document order kept among equal values, so overlays can be declared anywhere
+func paintOrder(components []types.Component) []*types.Component {
+	ordered := make([]*types.Component, len(components))
+	for i := range components {
+		ordered[i] = &components[i]
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].ZIndex < ordered[j].ZIndex
+	})
+	return ordered
+}
+
+// logf writes a debug line when logging is enabled
+func (r *Renderer) logf(format string, args ...interface{}) {
+	if r.opts.Log != nil {
+		fmt.Fprintf(r.opts.Log, "render: "+format+"\n", args...)
 	}
 }
 
@@ -222,11 +328,12 @@ func (r *Renderer) renderBox(ctx *renderContext, comp *types.Component, box Layo
 	if comp.Layout.Background != "" {
 		bgColor := parseColor(comp.Layout.Background)
 		rect := image.Rect(box.X, box.Y, box.X+box.Width, box.Y+box.Height)
-		draw.Draw(ctx.img, rect, &image.Uniform{bgColor}, image.Point{}, draw.Src)
+		// Composite over what is already drawn so translucent overlays show through
+		draw.Draw(ctx.img, rect, &image.Uniform{bgColor}, image.Point{}, draw.Over)
 	}
 
 	// Draw borders if specified
-	borderColor := color.RGBA{229, 229, 229, 255} // #E5E5E5
+	borderColor := palette.Border
 	if comp.Layout.Border != "" {
 		r.drawRect(ctx.img, box.X, box.Y, box.Width, box.Height, borderColor)
 	}
@@ -238,8 +345,8 @@ func (r *Renderer) renderBox(ctx *renderContext, comp *types.Component, box Layo
 	}
 
 	// Render children using their pre-calculated layouts
-	for _, child := range comp.Children {
-		if err := r.renderComponent(ctx, &child); err != nil {
+	for _, child := range paintOrder(comp.Children) {
+		if err := r.renderComponent(ctx, child); err != nil {
 			return err
 		}
 	}
@@ -249,7 +356,8 @@ func (r *Renderer) renderBox(ctx *renderContext, comp *types.Component, box Layo
 
 // renderText renders a text component
 func (r *Renderer) renderText(ctx *renderContext, comp *types.Component, box LayoutBox) error {
-	if comp.Content == "" {
+	lines := comp.TextLines()
+	if len(lines) == 0 {
 		return nil
 	}
 
@@ -258,9 +366,8 @@ func (r *Renderer) renderText(ctx *renderContext, comp *types.Component, box Lay
 		textColor = color.Black
 	}
 
-	// Split content by newlines for multi-line text
-	lines := strings.Split(comp.Content, "\n")
-	lineHeight := 16 // pixels between lines
+	lineHeight := 16 * ctx.scale // pixels between lines
+	baseline := 14 * ctx.scale   // offset from the box top to the first baseline
 	
 	d := &font.Drawer{
 		Dst:  ctx.img,
@@ -276,12 +383,29 @@ func (r *Renderer) renderText(ctx *renderContext, comp *types.Component, box Lay
 			continue
 		}
 		
+		// Center and right alignment offset each line by the room left in the box
+		x := fixed.I(box.X)
+		if comp.TextAlign == "center" || comp.TextAlign == "right" {
+			room := fixed.I(box.Width) - d.MeasureString(line)
+			if comp.TextAlign == "center" {
+				room /= 2
+			}
+			if room > 0 {
+				x += room
+			}
+		}
+
 		point := fixed.Point26_6{
-			X: fixed.Int26_6(box.X * 64),
-			Y: fixed.Int26_6((box.Y + 14 + (currentLine * lineHeight)) * 64),
+			X: x,
+			Y: fixed.Int26_6((box.Y + baseline + (currentLine * lineHeight)) * 64),
 		}
 		d.Dot = point
 		d.DrawString(line)
+		if comp.IsBold() {
+			// The bitmap face has no bold variant, so strike the line again 1px to the right
+			d.Dot = point.Add(fixed.P(1, 0))
+			d.DrawString(line)
+		}
 		currentLine++
 	}
 
@@ -297,7 +421,7 @@ func (r *Renderer) renderButton(ctx *renderContext, comp *types.Component, box L
 	}
 
 	rect := image.Rect(box.X, box.Y, box.X+box.Width, box.Y+box.Height)
-	draw.Draw(ctx.img, rect, &image.Uniform{bgColor}, image.Point{}, draw.Src)
+	draw.Draw(ctx.img, rect, &image.Uniform{bgColor}, image.Point{}, draw.Over)
 
 	// Draw button text (centered)
 	if comp.Content != "" {
@@ -326,13 +450,29 @@ func (r *Renderer) renderButton(ctx *renderContext, comp *types.Component, box L
 
 // renderInput renders an input component
 func (r *Renderer) renderInput(ctx *renderContext, comp *types.Component, box LayoutBox) error {
+	kind := comp.InputKind()
+	if kind == "checkbox" || kind == "radio" {
+		r.renderChoiceInput(ctx, comp, box, kind)
+		return nil
+	}
+
 	// Draw input border
-	borderColor := color.RGBA{229, 229, 229, 255} // #E5E5E5
-	r.drawRect(ctx.img, box.X, box.Y, box.Width, box.Height, borderColor)
+	r.drawRect(ctx.img, box.X, box.Y, box.Width, box.Height, palette.Border)
 
-	// Draw placeholder text if present
-	if comp.Content != "" {
-		textColor := color.RGBA{115, 115, 115, 255} // #737373 (gray)
+	switch kind {
+	case "select":
+		r.drawSelectChevron(ctx, box)
+	case "textarea":
+		r.drawResizeGrip(ctx, box)
+	}
+
+	// A filled-in value is drawn like body text; the content is only a
+	// placeholder and is shown muted while the input is empty
+	text, textColor := comp.Value, color.Color(color.Black)
+	if text == "" {
+		text, textColor = comp.Content, palette.TextSecondary
+	}
+	if text != "" {
 		point := fixed.Point26_6{
 			X: fixed.Int26_6((box.X + 8) * 64),
 			Y: fixed.Int26_6((box.Y + 22) * 64),
@@ -345,7 +485,7 @@ func (r *Renderer) renderInput(ctx *renderContext, comp *types.Component, box La
 			Dot:  point,
 		}
 
-		d.DrawString(comp.Content)
+		d.DrawString(text)
 	}
 
 	return nil
@@ -353,16 +493,26 @@ func (r *Renderer) renderInput(ctx *renderContext, comp *types.Component, box La
 
 // renderImage renders an image placeholder
 func (r *Renderer) renderImage(ctx *renderContext, comp *types.Component, box LayoutBox) error {
-	// Draw gray rectangle as placeholder
-	bgColor := color.RGBA{229, 229, 229, 255} // #E5E5E5
+	// Draw a tinted rectangle as placeholder (#E5E5E5 on the default white page)
+	bgColor := placeholderFill(ctx.backgrounds[comp.ID])
 	rect := image.Rect(box.X, box.Y, box.X+box.Width, box.Y+box.Height)
 	draw.Draw(ctx.img, rect, &image.Uniform{bgColor}, image.Point{}, draw.Src)
 
-	// Draw "IMAGE" text in center
-	textColor := color.RGBA{115, 115, 115, 255} // #737373
+	// Draw "IMAGE" (or the ID, so gallery images can be told apart) in the center,
+	// skipping slots too small to hold it (7x13 glyphs)
+	label := "IMAGE"
+	if r.opts.ImageLabels && len(comp.ID)*7 <= box.Width {
+		label = comp.ID
+	}
+	labelWidth := len(label) * 7
+	if box.Width < labelWidth || box.Height < 13 {
+		return nil
+	}
+
+	textColor := palette.TextSecondary
 	point := fixed.Point26_6{
-		X: fixed.Int26_6((box.X + box.Width/2 - 20) * 64),
-		Y: fixed.Int26_6((box.Y + box.Height/2) * 64),
+		X: fixed.Int26_6((box.X + (box.Width-labelWidth)/2) * 64),
+		Y: fixed.Int26_6((box.Y + box.Height/2 + 5) * 64),
 	}
 
 	d := &font.Drawer{
@@ -372,11 +522,140 @@ func (r *Renderer) renderImage(ctx *renderContext, comp *types.Component, box La
 		Dot:  point,
 	}
 
-	d.DrawString("IMAGE")
+	d.DrawString(label)
+
+	return nil
+}
+
+// renderUnknown renders a component of an unrecognized type as a bordered box labeled with its type
+func (r *Renderer) renderUnknown(ctx *renderContext, comp *types.Component, box LayoutBox) error {
+	borderColor := palette.TextSecondary
+	r.drawRect(ctx.img, box.X, box.Y, box.Width, box.Height, borderColor)
+
+	// Annotate the type so the fallback is visible in the mockup
+	point := fixed.Point26_6{
+		X: fixed.Int26_6((box.X + 4) * 64),
+		Y: fixed.Int26_6((box.Y + 14) * 64),
+	}
+
+	d := &font.Drawer{
+		Dst:  ctx.img,
+		Src:  image.NewUniform(borderColor),
+		Face: basicfont.Face7x13,
+		Dot:  point,
+	}
+
+	d.DrawString("<" + comp.Type + ">")
+
+	// Render children using their pre-calculated layouts
+	for _, child := range paintOrder(comp.Children) {
+		if err := r.renderComponent(ctx, child); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+// renderSkeleton renders skeleton placeholder shapes stacked inside the component box
+func (r *Renderer) renderSkeleton(ctx *renderContext, comp *types.Component, box LayoutBox) error {
+	placeholderColor := palette.Placeholder
+
+	if comp.Layout.Border != "" {
+		r.drawRect(ctx.img, box.X, box.Y, box.Width, box.Height, placeholderColor)
+	}
+
+	padding := comp.Layout.Padding * ctx.scale
+	gap := 8 * ctx.scale
+	contentX := box.X + padding
+	contentWidth := box.Width - padding*2
+	currentY := box.Y + padding
+	bottom := box.Y + box.Height - padding
+
+	for _, elem := range comp.Skeleton.Elements {
+		if currentY >= bottom {
+			break
+		}
+
+		switch elem.Type {
+		case "circle":
+			size := elem.Size * ctx.scale
+			if size == 0 {
+				size = 40 * ctx.scale
+			}
+			r.fillCircle(ctx.img, contentX+size/2, currentY+size/2, size/2, placeholderColor)
+			currentY += size + gap
+		case "text", "rect":
+			defaultHeight := 12
+			if elem.Type == "rect" {
+				defaultHeight = 40
+			}
+			width := parseSkeletonLength(elem.Width, contentWidth, ctx.scale, contentWidth)
+			height := parseSkeletonLength(elem.Height, box.Height, ctx.scale, defaultHeight*ctx.scale)
+			if currentY+height > bottom {
+				height = bottom - currentY
+			}
+			rect := image.Rect(contentX, currentY, contentX+width, currentY+height)
+			draw.Draw(ctx.img, rect, &image.Uniform{placeholderColor}, image.Point{}, draw.Src)
+			currentY += height + gap
+		}
+	}
+
+	return nil
+}
+
+// parseSkeletonLength converts a skeleton dimension ("60%", "120px", "120") to pixels
+func parseSkeletonLength(value string, available, scale, fallback int) int {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return fallback
+	}
+
+	if strings.HasSuffix(value, "%") {
+		if pct, err := strconv.Atoi(strings.TrimSuffix(value, "%")); err == nil {
+			return available * pct / 100
+		}
+		return fallback
+	}
+
+	if px, err := strconv.Atoi(strings.TrimSuffix(value, "px")); err == nil {
+		return px * scale
+	}
+
+	return fallback
+}
+
+// fillCircle draws a filled circle
+func (r *Renderer) fillCircle(img *image.RGBA, cx, cy, radius int, col color.Color) {
+	for y := -radius; y <= radius; y++ {
+		for x := -radius; x <= radius; x++ {
+			if x*x+y*y <= radius*radius {
+				img.Set(cx+x, cy+y, col)
+			}
+		}
+	}
+}
+
+// renderFocusRings draws a simulated focus indicator around every interactive component
+func (r *Renderer) renderFocusRings(ctx *renderContext, components []types.Component) {
+	ringColor := color.RGBA{0, 0, 0, 255} // #000000
+	ringWidth := 2 * ctx.scale
+	offset := 2 * ctx.scale
+
+	for _, comp := range components {
+		if comp.IsInteractive() {
+			if box, ok := ctx.boxes[comp.ID]; ok {
+				for i := 0; i < ringWidth; i++ {
+					inset := offset + i
+					r.drawRect(ctx.img, box.X-inset, box.Y-inset, box.Width+inset*2, box.Height+inset*2, ringColor)
+				}
+			}
+		}
+
+		r.renderFocusRings(ctx, comp.Children)
+	}
+}
+
 // drawRect draws a rectangle outline
 func (r *Renderer) drawRect(img *image.RGBA, x, y, width, height int, col color.Color) {
 	// Top
@@ -410,14 +689,3 @@ func (r *Renderer) drawVerticalLine(img *image.RGBA, x, y, height int, col color
 		img.Set(x, y+i, col)
 	}
 }
-
-// parseColor converts a hex color string to color.Color
-func parseColor(hex string) color.Color {
-	if hex == "" || hex[0] != '#' || len(hex) != 7 {
-		return color.Black
-	}
-
-	var r, g, b uint8
-	fmt.Sscanf(hex, "#%02x%02x%02x", &r, &g, &b)
-	return color.RGBA{r, g, b, 255}
-}
@@ -0,0 +1,576 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+func TestRender_ShowFocus(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "submit-btn",
+				Type:   "button",
+				Layout: types.ComponentLayout{Width: 120, Height: 44},
+			},
+		},
+	}
+
+	plain, err := NewRenderer(RenderOptions{Width: 400, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	focused, err := NewRenderer(RenderOptions{Width: 400, Height: 200, ShowFocus: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render with focus failed: %v", err)
+	}
+
+	// The ring sits 2px outside the right edge of the 120px-wide button
+	black := color.RGBA{0, 0, 0, 255}
+	if got := focused.Image.RGBAAt(122, 20); got != black {
+		t.Errorf("Expected focus ring pixel at (122,20), got %v", got)
+	}
+	if got := plain.Image.RGBAAt(122, 20); got == black {
+		t.Errorf("Expected no focus ring without ShowFocus")
+	}
+}
+
+func TestRender_LoadingSkeleton(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:    "card",
+				Type:  "box",
+				State: "loading",
+				Layout: types.ComponentLayout{
+					Padding: 16,
+					Width:   300,
+					Height:  200,
+				},
+				Skeleton: &types.SkeletonConfig{
+					Elements: []types.SkeletonElement{
+						{Type: "circle", Size: 40},
+						{Type: "text", Width: "50%"},
+						{Type: "rect", Width: "200px", Height: "60px"},
+					},
+				},
+				Children: []types.Component{
+					{ID: "card-avatar", Type: "image"},
+					{ID: "card-title", Type: "text", Content: "Title"},
+				},
+			},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 400, Height: 300}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	gray := color.RGBA{229, 229, 229, 255}
+	white := color.RGBA{255, 255, 255, 255}
+
+	// Circle centered at (16+20, 16+20)
+	if got := result.Image.RGBAAt(36, 36); got != gray {
+		t.Errorf("Expected skeleton circle at (36,36), got %v", got)
+	}
+	// Text bar starts at y=16+40+8=64, 50% of 268px content width = 134px
+	if got := result.Image.RGBAAt(100, 68); got != gray {
+		t.Errorf("Expected skeleton text bar at (100,68), got %v", got)
+	}
+	if got := result.Image.RGBAAt(160, 68); got != white {
+		t.Errorf("Expected text bar to end at 50%% width, got %v at (160,68)", got)
+	}
+	// Rect starts at y=64+12+8=84
+	if got := result.Image.RGBAAt(200, 120); got != gray {
+		t.Errorf("Expected skeleton rect at (200,120), got %v", got)
+	}
+}
+
+func TestRender_TranslucentBackgroundComposites(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "backdrop",
+				Type:   "box",
+				Layout: types.ComponentLayout{Width: 100, Height: 100, Background: "#00000080"},
+			},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// 50% black over the white canvas should be mid-gray, not solid black
+	got := result.Image.RGBAAt(50, 50)
+	if got.A != 255 || got.R < 120 || got.R > 135 {
+		t.Errorf("Expected a mid-gray composite, got %v", got)
+	}
+
+	// Outside the backdrop the canvas stays white
+	if outside := result.Image.RGBAAt(150, 150); outside != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected white outside the backdrop, got %v", outside)
+	}
+}
+
+func TestRender_ImagePlaceholderInheritsBackground(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "hero", Type: "image", Layout: types.ComponentLayout{Width: 100, Height: 40}},
+			{
+				ID:     "dark-panel",
+				Type:   "box",
+				Layout: types.ComponentLayout{Width: 200, Height: 100, Background: "#000000"},
+				Children: []types.Component{
+					// No background of its own: inherits the panel's black
+					{
+						ID:       "inner",
+						Type:     "box",
+						Children: []types.Component{{ID: "avatar", Type: "image", Layout: types.ComponentLayout{Width: 100, Height: 40}}},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// On the white page the placeholder keeps its #E5E5E5 fill
+	if got := result.Image.RGBAAt(2, 2); got != (color.RGBA{229, 229, 229, 255}) {
+		t.Errorf("Expected #E5E5E5 placeholder on white, got %v", got)
+	}
+
+	// Inside the black panel it is lightened instead, so it stays visible
+	avatar := result.Image.RGBAAt(2, 40+2)
+	if avatar != (color.RGBA{26, 26, 26, 255}) {
+		t.Errorf("Expected a light tint over the inherited black background, got %v", avatar)
+	}
+}
+
+func TestRender_Background(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "hero", Type: "image", Layout: types.ComponentLayout{Width: 100, Height: 40}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 200, Background: "#111827"}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	dark := color.RGBA{0x11, 0x18, 0x27, 255}
+	if got := result.Image.RGBAAt(150, 150); got != dark {
+		t.Errorf("Expected the canvas filled with #111827, got %v", got)
+	}
+	// Placeholders on the dark canvas are lightened rather than darkened
+	if got := result.Image.RGBAAt(2, 2); got.R <= dark.R {
+		t.Errorf("Expected a light tint over the dark canvas, got %v", got)
+	}
+
+	subtree, err := NewRenderer(RenderOptions{Width: 200, Background: "#111827", Component: "hero"}).Render(structure)
+	if err != nil {
+		t.Fatalf("Subtree render failed: %v", err)
+	}
+	if got := subtree.Image.RGBAAt(2, 2); got != result.Image.RGBAAt(2, 2) {
+		t.Errorf("Expected the subtree placeholder to match the page render, got %v", got)
+	}
+
+	if _, err := NewRenderer(RenderOptions{Width: 200, Background: "not-a-color"}).Render(structure); err == nil {
+		t.Error("Expected an error for an unrecognized background color")
+	}
+}
+
+func TestRender_Transparent(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "panel",
+				Type:   "box",
+				Layout: types.ComponentLayout{Width: 100, Height: 100, Background: "#E5E5E5"},
+			},
+		},
+	}
+
+	opaque, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	transparent, err := NewRenderer(RenderOptions{Width: 200, Height: 200, Transparent: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Transparent render failed: %v", err)
+	}
+
+	white := color.RGBA{255, 255, 255, 255}
+	for _, corner := range []image.Point{{199, 0}, {0, 199}, {199, 199}} {
+		if got := opaque.Image.RGBAAt(corner.X, corner.Y); got != white {
+			t.Errorf("Expected an opaque white corner at %v, got %v", corner, got)
+		}
+		if got := transparent.Image.RGBAAt(corner.X, corner.Y); got.A != 0 {
+			t.Errorf("Expected a transparent corner at %v, got %v", corner, got)
+		}
+	}
+
+	// Component backgrounds still paint over the transparent canvas
+	if got := transparent.Image.RGBAAt(50, 50); got != (color.RGBA{0xE5, 0xE5, 0xE5, 255}) {
+		t.Errorf("Expected the panel background to paint normally, got %v", got)
+	}
+}
+
+func TestRender_CardType(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "product-card",
+				Type:   "card",
+				Layout: types.ComponentLayout{Width: 200, Height: 120, Border: "1px solid #E5E5E5"},
+				Children: []types.Component{
+					{ID: "product-title", Type: "text", Content: "Product"},
+				},
+			},
+		},
+	}
+
+	if _, err := NewRenderer(RenderOptions{Width: 400, Height: 200}).Render(structure); err != nil {
+		t.Fatalf("Expected card to render, got: %v", err)
+	}
+}
+
+func TestRender_UnknownTypeFallsBack(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "hero-carousel",
+				Type:   "carousel",
+				Layout: types.ComponentLayout{Width: 200, Height: 100},
+			},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 400, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Expected unknown type to render as a fallback box, got: %v", err)
+	}
+
+	// The fallback draws a #737373 outline around the component
+	if got := result.Image.RGBAAt(100, 99); got != (color.RGBA{115, 115, 115, 255}) {
+		t.Errorf("Expected fallback border at bottom edge, got %v", got)
+	}
+}
+
+func TestRender_TextBaselineScales(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "label", Type: "text", Content: "Hello\nWorld"},
+		},
+	}
+
+	// inkRows returns the first and last rows containing dark text pixels
+	inkRows := func(scale int) (int, int) {
+		result, err := NewRenderer(RenderOptions{Width: 200, Height: 100, Scale: scale}).Render(structure)
+		if err != nil {
+			t.Fatalf("Render at %dx failed: %v", scale, err)
+		}
+		first, last := -1, -1
+		bounds := result.Image.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				if result.Image.RGBAAt(x, y).R < 128 {
+					if first < 0 {
+						first = y
+					}
+					last = y
+					break
+				}
+			}
+		}
+		return first, last
+	}
+
+	first1, last1 := inkRows(1)
+	first2, last2 := inkRows(2)
+	if first1 < 0 || first2 < 0 {
+		t.Fatalf("Expected text to be drawn at both scales")
+	}
+
+	// The glyphs are not scaled, so the baseline moves by 14px and the second line by a further 16px
+	if got := first2 - first1; got != 14 {
+		t.Errorf("Expected first line to shift 14px at 2x, got %d", got)
+	}
+	if got := last2 - last1; got != 14+16 {
+		t.Errorf("Expected second line to shift 30px at 2x, got %d", got)
+	}
+}
+
+func TestRender_WideImagePlaceholder(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "banner", Type: "image", Layout: types.ComponentLayout{Width: 600, Height: 8}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 800, Height: 100}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	placeholder := color.RGBA{229, 229, 229, 255}
+	white := color.RGBA{255, 255, 255, 255}
+
+	// The placeholder fills exactly 600x8 at the origin
+	if got := result.Image.RGBAAt(599, 7); got != placeholder {
+		t.Errorf("Expected placeholder at bottom-right corner, got %v", got)
+	}
+	if got := result.Image.RGBAAt(600, 7); got != white {
+		t.Errorf("Expected background right of the placeholder, got %v", got)
+	}
+	if got := result.Image.RGBAAt(0, 8); got != white {
+		t.Errorf("Expected background below the placeholder, got %v", got)
+	}
+
+	// The slot is too short for the label, so nothing spills outside it
+	for x := 0; x < 800; x++ {
+		for y := 8; y < 100; y++ {
+			if got := result.Image.RGBAAt(x, y); got != white {
+				t.Fatalf("Expected no label drawn outside the slot, found %v at (%d,%d)", got, x, y)
+			}
+		}
+	}
+}
+
+func TestRender_ImageLabels(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "gallery-photo-3", Type: "image", Layout: types.ComponentLayout{Width: 300, Height: 200}},
+		},
+	}
+
+	// labelImage draws label the way renderImage centers it in the 300x200 slot
+	labelImage := func(label string) *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+		draw.Draw(img, img.Bounds(), &image.Uniform{color.RGBA{229, 229, 229, 255}}, image.Point{}, draw.Src)
+		d := &font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(palette.TextSecondary),
+			Face: basicfont.Face7x13,
+			Dot:  fixed.P((300-len(label)*7)/2, 100+5),
+		}
+		d.DrawString(label)
+		return img
+	}
+	matches := func(result *RenderResult, want *image.RGBA) bool {
+		for y := 0; y < 200; y++ {
+			for x := 0; x < 300; x++ {
+				if result.Image.RGBAAt(x, y) != want.RGBAAt(x, y) {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	plain, err := NewRenderer(RenderOptions{Width: 400, Height: 300}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !matches(plain, labelImage("IMAGE")) {
+		t.Error("Expected the generic IMAGE label by default")
+	}
+
+	labeled, err := NewRenderer(RenderOptions{Width: 400, Height: 300, ImageLabels: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if !matches(labeled, labelImage("gallery-photo-3")) {
+		t.Error("Expected the component ID centered in the placeholder with ImageLabels")
+	}
+}
+
+func TestRender_InputValueAndPlaceholder(t *testing.T) {
+	// inkColors returns the distinct non-white colors drawn inside the input's text area
+	inkColors := func(comp types.Component) map[color.RGBA]bool {
+		structure := &types.Structure{Components: []types.Component{comp}}
+		result, err := NewRenderer(RenderOptions{Width: 300, Height: 60}).Render(structure)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		inks := map[color.RGBA]bool{}
+		white := color.RGBA{255, 255, 255, 255}
+		for y := 10; y < 26; y++ {
+			for x := 8; x < 200; x++ {
+				if c := result.Image.RGBAAt(x, y); c != white {
+					inks[c] = true
+				}
+			}
+		}
+		return inks
+	}
+
+	black := color.RGBA{0, 0, 0, 255}
+	placeholder := inkColors(types.Component{ID: "email", Type: "input", Content: "you@example.com"})
+	if !placeholder[palette.TextSecondary] || placeholder[black] {
+		t.Errorf("Expected placeholder in the secondary text color only, got %v", placeholder)
+	}
+
+	filled := inkColors(types.Component{ID: "email", Type: "input", Content: "you@example.com", Value: "jane@acme.io"})
+	if !filled[black] || filled[palette.TextSecondary] {
+		t.Errorf("Expected value in the primary text color with the placeholder hidden, got %v", filled)
+	}
+}
+
+func TestRender_BoldTextIsDoubleStruck(t *testing.T) {
+	// inkPixels counts the dark pixels drawn for a single text component
+	inkPixels := func(weight string) int {
+		structure := &types.Structure{
+			Components: []types.Component{
+				{ID: "title", Type: "text", Content: "Dashboard", Weight: weight},
+			},
+		}
+		result, err := NewRenderer(RenderOptions{Width: 200, Height: 40}).Render(structure)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		count := 0
+		bounds := result.Image.Bounds()
+		for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				if result.Image.RGBAAt(x, y).R < 128 {
+					count++
+				}
+			}
+		}
+		return count
+	}
+
+	normal, bold := inkPixels("normal"), inkPixels("bold")
+	if normal == 0 {
+		t.Fatal("Expected normal text to be drawn")
+	}
+	if bold <= normal {
+		t.Errorf("Expected bold text to cover more pixels than normal (%d), got %d", normal, bold)
+	}
+	if medium := inkPixels("medium"); medium != normal {
+		t.Errorf("Expected medium text to render like normal (%d), got %d", normal, medium)
+	}
+}
+
+func TestRender_TextAlign(t *testing.T) {
+	// inkColumns returns the box and the first and last columns containing dark text pixels
+	inkColumns := func(align string) (LayoutBox, int, int) {
+		structure := &types.Structure{
+			Components: []types.Component{
+				{ID: "title", Type: "text", Content: "Overview", TextAlign: align, Layout: types.ComponentLayout{Width: 300}},
+			},
+		}
+		result, err := NewRenderer(RenderOptions{Width: 400, Height: 40}).Render(structure)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		first, last := -1, -1
+		bounds := result.Image.Bounds()
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+				if result.Image.RGBAAt(x, y).R < 128 {
+					if first < 0 {
+						first = x
+					}
+					last = x
+					break
+				}
+			}
+		}
+		return result.Boxes["title"], first, last
+	}
+
+	box, leftFirst, _ := inkColumns("")
+	if box.Width != 300 {
+		t.Fatalf("Expected a 300px text box, got %d", box.Width)
+	}
+	if leftFirst < box.X || leftFirst > box.X+7 {
+		t.Errorf("Expected left-aligned text at the box edge (%d), first ink at %d", box.X, leftFirst)
+	}
+
+	// "Overview" is 8 glyphs of 7px, leaving equal room on both sides of the box
+	_, first, last := inkColumns("center")
+	textWidth := 8 * 7
+	left := box.X + (box.Width-textWidth)/2
+	if first < left || last >= left+textWidth {
+		t.Errorf("Expected centered ink within [%d, %d), got [%d, %d]", left, left+textWidth, first, last)
+	}
+	if gap := (first - box.X) - (box.X + box.Width - 1 - last); gap < -7 || gap > 7 {
+		t.Errorf("Expected centered text to leave equal room on both sides, got ink at [%d, %d] in %+v", first, last, box)
+	}
+
+	_, first, last = inkColumns("right")
+	if first < box.X+box.Width-textWidth || last >= box.X+box.Width {
+		t.Errorf("Expected right-aligned ink ending at the box edge (%d), got [%d, %d]", box.X+box.Width, first, last)
+	}
+}
+
+func TestRender_ZIndexPaintsModalOnTop(t *testing.T) {
+	// The negative gap pulls the content up under the modal declared before it
+	structure := func(modalZ int) *types.Structure {
+		return &types.Structure{
+			Components: []types.Component{
+				{
+					ID:     "screen",
+					Type:   "box",
+					Layout: types.ComponentLayout{Gap: -40},
+					Children: []types.Component{
+						{ID: "modal", Type: "box", ZIndex: modalZ, Layout: types.ComponentLayout{Height: 80, Background: "#000000"}},
+						{ID: "content", Type: "box", Layout: types.ComponentLayout{Height: 80, Background: "#E5E5E5"}},
+					},
+				},
+			},
+		}
+	}
+
+	overlap := func(modalZ int) color.RGBA {
+		result, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure(modalZ))
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		return result.Image.RGBAAt(100, 60)
+	}
+
+	if got := overlap(0); got != (color.RGBA{229, 229, 229, 255}) {
+		t.Errorf("Expected later content to cover the modal at equal z-index, got %v", got)
+	}
+	if got := overlap(10); got != (color.RGBA{0, 0, 0, 255}) {
+		t.Errorf("Expected the higher z-index modal to cover the content, got %v", got)
+	}
+}
+
+func TestRender_ContentWidthOnWideCanvas(t *testing.T) {
+	structure := &types.Structure{
+		Layout:     types.Layout{Type: "stack"},
+		Components: []types.Component{{ID: "hero", Type: "image", Layout: types.ComponentLayout{Height: 200}}},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 1920, ContentWidth: 375}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if result.Width != 1920 {
+		t.Errorf("Expected a 1920px canvas, got %d", result.Width)
+	}
+	if got := result.Boxes["hero"]; got.X != 772 || got.Width != 375 {
+		t.Errorf("hero at x=%d width=%d, expected a centered 375px column", got.X, got.Width)
+	}
+	// The canvas outside the column stays empty
+	if got := result.Image.RGBAAt(100, 100); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected white canvas beside the column, got %v", got)
+	}
+}
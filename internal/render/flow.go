@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/johanbellander/prism/internal/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Flow diagram geometry in unscaled pixels
+const (
+	flowMargin      = 32 // around the diagram
+	flowLabelHeight = 24 // space above each screen for its name
+	flowArrowGap    = 80 // horizontal space between screens, holding the arrow
+)
+
+// FlowStep is one screen of a flow: its label and parsed structure
+type FlowStep struct {
+	Label     string
+	Structure *types.Structure
+}
+
+// FlowResult is a composed flow diagram and the render of each screen
+type FlowResult struct {
+	RenderResult
+	Screens []*RenderResult
+}
+
+// RenderFlow renders each step with the single-structure renderer and composes the
+// screens left to right at full size, labeled, with arrows between consecutive screens
+func RenderFlow(steps []FlowStep, opts RenderOptions) (*FlowResult, error) {
+	r := NewRenderer(opts)
+	scale := r.opts.Scale
+
+	screens := make([]*RenderResult, len(steps))
+	for i, step := range steps {
+		result, err := r.Render(step.Structure)
+		if err != nil {
+			return nil, fmt.Errorf("screen '%s': %w", step.Label, err)
+		}
+		screens[i] = result
+	}
+
+	margin, labelHeight, gap := flowMargin*scale, flowLabelHeight*scale, flowArrowGap*scale
+	width, tallest := margin*2, 0
+	for i, screen := range screens {
+		width += screen.Width
+		if i > 0 {
+			width += gap
+		}
+		if screen.Height > tallest {
+			tallest = screen.Height
+		}
+	}
+	height := margin*2 + labelHeight + tallest
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{color.White}, image.Point{}, draw.Src)
+
+	borderColor := palette.Border
+	labelColor := palette.TextLabel
+	arrowColor := palette.TextSecondary
+
+	x := margin
+	top := margin + labelHeight
+	for i, screen := range screens {
+		d := &font.Drawer{
+			Dst:  img,
+			Src:  image.NewUniform(labelColor),
+			Face: basicfont.Face7x13,
+			Dot:  fixed.P(x, margin+14),
+		}
+		d.DrawString(fmt.Sprintf("%d. %s", i+1, steps[i].Label))
+
+		rect := image.Rect(x, top, x+screen.Width, top+screen.Height)
+		draw.Draw(img, rect, screen.Image, image.Point{}, draw.Src)
+		drawOutline(img, rect.Inset(-1), borderColor)
+
+		// The arrow sits halfway down the shorter of the two screens it joins
+		if i > 0 {
+			arrowY := top + min(screens[i-1].Height, screen.Height)/2
+			r.drawArrow(img, image.Pt(x-gap+8*scale, arrowY), image.Pt(x-8*scale, arrowY), 0, scale*2, arrowColor)
+		}
+
+		x += screen.Width + gap
+	}
+
+	return &FlowResult{
+		RenderResult: RenderResult{Image: img, Width: width, Height: height},
+		Screens:      screens,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package render
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestRenderFlow_ComposesScreensWithArrows(t *testing.T) {
+	screen := func(version string) *types.Structure {
+		return &types.Structure{
+			Version:    version,
+			Components: []types.Component{{ID: "title", Type: "text", Content: version}},
+		}
+	}
+	steps := []FlowStep{
+		{Label: "Landing", Structure: screen("v1")},
+		{Label: "Signup", Structure: screen("v2")},
+	}
+
+	result, err := RenderFlow(steps, RenderOptions{Width: 200, Height: 100})
+	if err != nil {
+		t.Fatalf("RenderFlow failed: %v", err)
+	}
+
+	if len(result.Screens) != 2 {
+		t.Fatalf("Expected 2 screen renders, got %d", len(result.Screens))
+	}
+	// Margins, two 200px screens and the arrow gap; label line above the tallest screen
+	if result.Width != 32*2+200*2+80 || result.Height != 32*2+24+100 {
+		t.Errorf("Unexpected flow size %dx%d", result.Width, result.Height)
+	}
+
+	// The arrow crosses the gap halfway down the screens
+	if got := result.Image.RGBAAt(32+200+40, 32+24+50); got != (color.RGBA{115, 115, 115, 255}) {
+		t.Errorf("Expected an arrow between the screens, got %v", got)
+	}
+}
+
+func TestRenderFlow_ReportsFailingScreen(t *testing.T) {
+	steps := []FlowStep{{Label: "Broken", Structure: &types.Structure{}}}
+	_, err := RenderFlow(steps, RenderOptions{Component: "missing"})
+	if err == nil || !strings.Contains(err.Error(), "screen 'Broken'") {
+		t.Errorf("Expected the failing screen to be named, got %v", err)
+	}
+}
@@ -0,0 +1,108 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"sort"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// guideDrift is the largest gap, in unscaled pixels, between two edges that reads as
+// an accidental misalignment rather than a deliberate offset (one 8pt grid step)
+const guideDrift = 8
+
+// Alignment guide colors: edges shared by several components are solid blue, edges
+// used by one component faint blue, and edges that nearly meet another are red
+var (
+	guideShared = color.NRGBA{0x3B, 0x82, 0xF6, 0x99}
+	guideSingle = color.NRGBA{0x3B, 0x82, 0xF6, 0x33}
+	guideDrifts = color.NRGBA{0xEF, 0x44, 0x44, 0xCC}
+)
+
+// guide is an alignment line through the edges of one or more components
+type guide struct {
+	Pos   int  // x of a vertical guide or y of a horizontal one, in canvas pixels
+	Count int  // number of component edges on the line
+	Drift bool // another guide lies within guideDrift of this one
+}
+
+// alignmentGuides collects the distinct edge positions in edges and marks those that
+// lie within tolerance of another without meeting it
+func alignmentGuides(edges []int, tolerance int) []guide {
+	counts := map[int]int{}
+	for _, edge := range edges {
+		counts[edge]++
+	}
+
+	guides := make([]guide, 0, len(counts))
+	for pos, count := range counts {
+		guides = append(guides, guide{Pos: pos, Count: count})
+	}
+	sort.Slice(guides, func(i, j int) bool { return guides[i].Pos < guides[j].Pos })
+
+	// Sorted, so only neighbours can be closer than the tolerance
+	for i := 1; i < len(guides); i++ {
+		if guides[i].Pos-guides[i-1].Pos < tolerance {
+			guides[i].Drift = true
+			guides[i-1].Drift = true
+		}
+	}
+	return guides
+}
+
+// guideComponents returns the components whose edges the guides follow: the top-level
+// components, or the children of a lone wrapper (a single page or main box) in its place
+func guideComponents(components []types.Component) []types.Component {
+	for len(components) == 1 && len(components[0].Children) > 0 {
+		components = components[0].Children
+	}
+	return components
+}
+
+// renderGuides draws alignment guides through the left, right and top edges of the
+// top-level components: vertical lines through every distinct left or right x and
+// horizontal lines through every distinct top y. Guides that nearly meet another one
+// are drawn in red to surface alignment drift.
+func (r *Renderer) renderGuides(ctx *renderContext, components []types.Component, height int) {
+	var xs, ys []int
+	for _, comp := range guideComponents(components) {
+		box, ok := ctx.boxes[comp.ID]
+		if !ok || box.Width <= 0 || box.Height <= 0 {
+			continue
+		}
+		xs = append(xs, box.X, box.X+box.Width)
+		ys = append(ys, box.Y)
+	}
+
+	bounds := ctx.img.Bounds()
+	vertical := alignmentGuides(xs, guideDrift*ctx.scale)
+	horizontal := alignmentGuides(ys, guideDrift*ctx.scale)
+	drifting := 0
+	for _, g := range vertical {
+		rect := image.Rect(g.Pos, 0, g.Pos+ctx.scale, height)
+		drifting += drawGuide(ctx.img, rect.Intersect(bounds), g)
+	}
+	for _, g := range horizontal {
+		rect := image.Rect(0, g.Pos, bounds.Dx(), g.Pos+ctx.scale)
+		drifting += drawGuide(ctx.img, rect.Intersect(bounds), g)
+	}
+	r.logf("guides: %d vertical and %d horizontal, %d misaligned", len(vertical), len(horizontal), drifting)
+}
+
+// drawGuide blends a guide line over the image in its color and returns 1 when it drifts
+func drawGuide(img *image.RGBA, rect image.Rectangle, g guide) int {
+	col := guideSingle
+	switch {
+	case g.Drift:
+		col = guideDrifts
+	case g.Count > 1:
+		col = guideShared
+	}
+	draw.Draw(img, rect, &image.Uniform{col}, image.Point{}, draw.Over)
+	if g.Drift {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestAlignmentGuides(t *testing.T) {
+	guides := alignmentGuides([]int{0, 400, 0, 403, 800, 200}, 8)
+
+	expected := []guide{
+		{Pos: 0, Count: 2},
+		{Pos: 200, Count: 1},
+		{Pos: 400, Count: 1, Drift: true},
+		{Pos: 403, Count: 1, Drift: true},
+		{Pos: 800, Count: 1},
+	}
+	if len(guides) != len(expected) {
+		t.Fatalf("Expected %d guides, got %+v", len(expected), guides)
+	}
+	for i, want := range expected {
+		if guides[i] != want {
+			t.Errorf("Guide %d = %+v, expected %+v", i, guides[i], want)
+		}
+	}
+
+	// Edges a full grid step apart are a deliberate offset
+	for _, g := range alignmentGuides([]int{0, 8, 16}, 8) {
+		if g.Drift {
+			t.Errorf("Expected no drift for edges 8px apart, got %+v", g)
+		}
+	}
+}
+
+func TestGuideComponents_SkipsLoneWrapper(t *testing.T) {
+	components := []types.Component{
+		{ID: "page", Type: "box", Children: []types.Component{
+			{ID: "header", Type: "box"},
+			{ID: "main", Type: "box"},
+		}},
+	}
+
+	got := guideComponents(components)
+	if len(got) != 2 || got[0].ID != "header" || got[1].ID != "main" {
+		t.Errorf("Expected the wrapper's children, got %+v", got)
+	}
+}
+
+func TestRender_Guides(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "card", Type: "box", Layout: types.ComponentLayout{Position: "absolute", Left: offset(40), Top: offset(20), Width: 200, Height: 60}},
+			{ID: "drifted", Type: "box", Layout: types.ComponentLayout{Position: "absolute", Left: offset(44), Top: offset(120), Width: 200, Height: 60}},
+			{ID: "aligned", Type: "box", Layout: types.ComponentLayout{Position: "absolute", Left: offset(300), Top: offset(20), Width: 100, Height: 60}},
+		},
+	}
+
+	plain, err := NewRenderer(RenderOptions{Width: 500, Height: 300}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	result, err := NewRenderer(RenderOptions{Width: 500, Height: 300, Guides: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// Left edges 4px apart drift: red guides below the components
+	if got := result.Image.RGBAAt(40, 250); got.R <= got.B {
+		t.Errorf("Expected a red guide at x=40, got %v", got)
+	}
+	if got := result.Image.RGBAAt(44, 250); got.R <= got.B {
+		t.Errorf("Expected a red guide at x=44, got %v", got)
+	}
+	// The shared top edge at y=20 is blue, and so is its line across empty canvas
+	if got := result.Image.RGBAAt(470, 20); got.B <= got.R {
+		t.Errorf("Expected a blue guide at y=20, got %v", got)
+	}
+	// Away from every edge the canvas is untouched
+	if got, want := result.Image.RGBAAt(150, 250), plain.Image.RGBAAt(150, 250); got != want {
+		t.Errorf("Expected no guide at (150, 250), got %v, expected %v", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package render
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/johanbellander/prism/internal/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// choiceControlSize is the side of a checkbox and the diameter of a radio button
+const choiceControlSize = 16
+
+// renderChoiceInput draws a checkbox (square) or radio button (circle) at the left of
+// the box with its content as the label. A value other than "" or "false" checks it.
+func (r *Renderer) renderChoiceInput(ctx *renderContext, comp *types.Component, box LayoutBox, kind string) {
+	scale := ctx.scale
+	size := choiceControlSize * scale
+	top := box.Y + (box.Height-size)/2
+	checked := comp.Value != "" && comp.Value != "false"
+
+	if kind == "checkbox" {
+		r.drawRect(ctx.img, box.X, top, size, size, color.Black)
+		if checked {
+			inset := 4 * scale
+			mark := image.Rect(box.X+inset, top+inset, box.X+size-inset, top+size-inset)
+			for y := mark.Min.Y; y < mark.Max.Y; y++ {
+				for x := mark.Min.X; x < mark.Max.X; x++ {
+					ctx.img.Set(x, y, color.Black)
+				}
+			}
+		}
+	} else {
+		radius := size / 2
+		cx, cy := box.X+radius, top+radius
+		r.fillCircle(ctx.img, cx, cy, radius, color.Black)
+		r.fillCircle(ctx.img, cx, cy, radius-scale, ctx.backgrounds[comp.ID])
+		if checked {
+			r.fillCircle(ctx.img, cx, cy, radius/2, color.Black)
+		}
+	}
+
+	if comp.Content == "" {
+		return
+	}
+	d := &font.Drawer{
+		Dst:  ctx.img,
+		Src:  image.NewUniform(color.Black),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(box.X+size+8*scale, top+size/2+4),
+	}
+	d.DrawString(comp.Content)
+}
+
+// drawSelectChevron draws a down chevron at the right edge of a select
+func (r *Renderer) drawSelectChevron(ctx *renderContext, box LayoutBox) {
+	scale := ctx.scale
+	half := float64(5 * scale)
+	cx := float64(box.X + box.Width - 16*scale)
+	cy := float64(box.Y + box.Height/2)
+	r.drawLine(ctx.img, cx-half, cy-half/2, cx, cy+half/2, scale, color.Black)
+	r.drawLine(ctx.img, cx, cy+half/2, cx+half, cy-half/2, scale, color.Black)
+}
+
+// drawResizeGrip draws the two diagonal strokes in a textarea's bottom-right corner
+func (r *Renderer) drawResizeGrip(ctx *renderContext, box LayoutBox) {
+	scale := ctx.scale
+	right := float64(box.X + box.Width - 4*scale)
+	bottom := float64(box.Y + box.Height - 4*scale)
+	for _, length := range []float64{float64(8 * scale), float64(4 * scale)} {
+		r.drawLine(ctx.img, right-length, bottom, right, bottom-length, scale, palette.TextSecondary)
+	}
+}
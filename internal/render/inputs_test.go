@@ -0,0 +1,116 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// renderInputs renders the inputs stacked at 400px wide with no gap between them
+func renderInputs(t *testing.T, inputs ...types.Component) *RenderResult {
+	t.Helper()
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "form", Type: "box", Layout: types.ComponentLayout{Display: "block"}, Children: inputs},
+		},
+	}
+	result, err := NewRenderer(RenderOptions{Width: 400, Height: 400}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	return result
+}
+
+var (
+	black = color.RGBA{0, 0, 0, 255}
+	white = color.RGBA{255, 255, 255, 255}
+)
+
+func TestRender_CheckboxInput(t *testing.T) {
+	result := renderInputs(t,
+		types.Component{ID: "terms", Type: "input", InputType: "checkbox", Content: "I agree"},
+		types.Component{ID: "news", Type: "checkbox", Content: "Newsletter", Value: "true"},
+	)
+
+	// A 16px square centered in each 40px row: outline at y=12, inside at y=20
+	terms, news := result.Boxes["terms"], result.Boxes["news"]
+	if got := result.Image.RGBAAt(terms.X+8, terms.Y+12); got != black {
+		t.Errorf("Expected the checkbox outline, got %v", got)
+	}
+	if got := result.Image.RGBAAt(terms.X+8, terms.Y+20); got != white {
+		t.Errorf("Expected an unchecked checkbox to be empty, got %v", got)
+	}
+	if got := result.Image.RGBAAt(news.X+8, news.Y+20); got != black {
+		t.Errorf("Expected a checked checkbox to be filled, got %v", got)
+	}
+	// No text-field border across the full width
+	if got := result.Image.RGBAAt(terms.X+200, terms.Y); got != white {
+		t.Errorf("Expected no text field border on a checkbox, got %v", got)
+	}
+}
+
+func TestRender_RadioInput(t *testing.T) {
+	result := renderInputs(t,
+		types.Component{ID: "monthly", Type: "radio", Content: "Monthly"},
+		types.Component{ID: "yearly", Type: "input", InputType: "radio", Content: "Yearly", Value: "yearly"},
+	)
+
+	// An 8px-radius ring centered 8px in from the left, 20px down each row
+	monthly, yearly := result.Boxes["monthly"], result.Boxes["yearly"]
+	if got := result.Image.RGBAAt(monthly.X+8, monthly.Y+12); got != black {
+		t.Errorf("Expected the radio ring, got %v", got)
+	}
+	if got := result.Image.RGBAAt(monthly.X+8, monthly.Y+20); got != white {
+		t.Errorf("Expected an unselected radio to be empty, got %v", got)
+	}
+	if got := result.Image.RGBAAt(yearly.X+8, yearly.Y+20); got != black {
+		t.Errorf("Expected a selected radio to have a dot, got %v", got)
+	}
+	// Round, not square: the corner of the control's bounds stays empty
+	if got := result.Image.RGBAAt(monthly.X, monthly.Y+12); got != white {
+		t.Errorf("Expected the radio's corner to be empty, got %v", got)
+	}
+}
+
+func TestRender_SelectInput(t *testing.T) {
+	result := renderInputs(t,
+		types.Component{ID: "country", Type: "select", Content: "Country"},
+		types.Component{ID: "city", Type: "input", Content: "City"},
+	)
+
+	hasChevron := func(box LayoutBox) bool {
+		for y := box.Y + 10; y < box.Y+box.Height-10; y++ {
+			for x := box.X + box.Width - 24; x < box.X+box.Width-8; x++ {
+				if result.Image.RGBAAt(x, y) == black {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if !hasChevron(result.Boxes["country"]) {
+		t.Error("Expected a chevron at the right of the select")
+	}
+	if hasChevron(result.Boxes["city"]) {
+		t.Error("Expected no chevron on a text input")
+	}
+}
+
+func TestRender_TextareaInput(t *testing.T) {
+	result := renderInputs(t,
+		types.Component{ID: "notes", Type: "input", InputType: "textarea", Content: "Notes"},
+		types.Component{ID: "name", Type: "input", Content: "Name"},
+	)
+
+	notes, name := result.Boxes["notes"], result.Boxes["name"]
+	if notes.Height != 96 || name.Height != 40 {
+		t.Fatalf("Expected a 96px textarea and a 40px text input, got %d and %d", notes.Height, name.Height)
+	}
+	if got := result.Image.RGBAAt(notes.X, notes.Y+notes.Height-1); got != palette.Border {
+		t.Errorf("Expected the textarea border at its bottom edge, got %v", got)
+	}
+	if got := result.Image.RGBAAt(notes.X+notes.Width-8, notes.Y+notes.Height-8); got != palette.TextSecondary {
+		t.Errorf("Expected a resize grip in the bottom-right corner, got %v", got)
+	}
+}
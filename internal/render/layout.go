@@ -1,6 +1,8 @@
 package render
 
 import (
+	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -15,9 +17,19 @@ type LayoutBox struct {
 	Height int
 }
 
+// LayoutWarning is an informational note about a layout that may not be intended
+type LayoutWarning struct {
+	ComponentID string `json:"component_id"`
+	Message     string `json:"message"`
+	Severity    string `json:"severity"` // "info" or "warning"
+}
+
 // LayoutEngine calculates layout positions for all components
 type LayoutEngine struct {
-	scale int
+	scale        int
+	warnings     []LayoutWarning
+	log          io.Writer // debug log destination, nil when silent
+	contentWidth int       // content column width in unscaled pixels, 0 for the structure's layout.max_width
 }
 
 // NewLayoutEngine creates a new layout engine with given scale
@@ -25,17 +37,62 @@ func NewLayoutEngine(scale int) *LayoutEngine {
 	return &LayoutEngine{scale: scale}
 }
 
+// SetLog directs debug output about layout decisions to w (nil disables it)
+func (e *LayoutEngine) SetLog(w io.Writer) {
+	e.log = w
+}
+
+// SetContentWidth caps the content column at w unscaled pixels, centered on the
+// canvas, in place of the structure's layout.max_width (0 restores it)
+func (e *LayoutEngine) SetContentWidth(w int) {
+	e.contentWidth = w
+}
+
+// logf writes a debug line when logging is enabled
+func (e *LayoutEngine) logf(format string, args ...interface{}) {
+	if e.log != nil {
+		fmt.Fprintf(e.log, "layout: "+format+"\n", args...)
+	}
+}
+
+// logBoxes writes the final box of every component in tree order
+func (e *LayoutEngine) logBoxes(components []types.Component, boxes map[string]LayoutBox, depth int) {
+	for i := range components {
+		comp := &components[i]
+		box := boxes[comp.ID]
+		e.logf("%s'%s' (%s) at (%d, %d) size %dx%d", strings.Repeat("  ", depth), comp.ID, comp.Type, box.X, box.Y, box.Width, box.Height)
+		e.logBoxes(comp.Children, boxes, depth+1)
+	}
+}
+
 // CalculateLayout calculates positions and sizes for all components
 func (e *LayoutEngine) CalculateLayout(structure *types.Structure, width, height int) (map[string]LayoutBox, error) {
 	boxes := make(map[string]LayoutBox)
+	e.warnings = nil
+
+	// Top-level components flow in a content column centered on the canvas
+	columnX, columnWidth := e.contentColumn(structure, width)
 
-	// Calculate layout for top-level components
-	currentY := 0
-	for _, comp := range structure.Components {
-		box, err := e.calculateComponentLayout(&comp, 0, currentY, width, height)
+	// Size top-level components first so the root alignment can place them
+	flow, absolute := splitPositioned(structure.Components)
+	rootBoxes := make([]LayoutBox, len(flow))
+	contentHeight := 0
+	for i := range flow {
+		box, err := e.calculateComponentLayout(&flow[i], 0, 0, columnWidth, height)
 		if err != nil {
 			return nil, err
 		}
+		rootBoxes[i] = box
+		contentHeight += box.Height
+	}
+
+	spacing := structure.Layout.Spacing * e.scale
+	currentY, spacing := e.rootJustify(structure.Layout.JustifyContent, height, contentHeight, spacing, len(rootBoxes))
+
+	for i, comp := range flow {
+		box := rootBoxes[i]
+		box.X = columnX + e.rootAlign(structure.Layout.AlignItems, columnWidth, box.Width)
+		box.Y = currentY
 
 		boxes[comp.ID] = box
 
@@ -44,12 +101,91 @@ func (e *LayoutEngine) CalculateLayout(structure *types.Structure, width, height
 			return nil, err
 		}
 
-		currentY += box.Height + (structure.Layout.Spacing * e.scale)
+		currentY += box.Height + spacing
+	}
+
+	// Absolutely positioned top-level components are placed against the canvas
+	if len(absolute) > 0 {
+		canvas := LayoutBox{Width: width, Height: height}
+		if height <= 0 {
+			canvas.Height = contentBottom(boxes)
+		}
+		if err := e.layoutAbsoluteChildren(absolute, canvas, boxes); err != nil {
+			return nil, err
+		}
+	}
+
+	if e.log != nil {
+		e.logf("computed boxes for %d components in %dx%d", len(boxes), width, height)
+		e.logBoxes(structure.Components, boxes, 1)
 	}
 
 	return boxes, nil
 }
 
+// contentColumn returns the X and width of the column top-level components flow in.
+// The canvas width and the content max-width are independent: content no wider than
+// the engine's content width, else the structure's layout.max_width, is centered on
+// a wider canvas rather than stretched across it.
+func (e *LayoutEngine) contentColumn(structure *types.Structure, width int) (int, int) {
+	maxWidth := structure.Layout.MaxWidth
+	if e.contentWidth > 0 {
+		maxWidth = e.contentWidth
+	}
+	maxWidth *= e.scale
+	if maxWidth <= 0 || maxWidth >= width {
+		return 0, width
+	}
+	e.logf("content column %dpx centered on a %dpx canvas", maxWidth, width)
+	return (width - maxWidth) / 2, maxWidth
+}
+
+// rootJustify returns the Y of the first top-level component and the spacing between
+// them for the root justify_content. Vertical justification needs a fixed canvas
+// height and content that fits in it; otherwise components stack from the top.
+func (e *LayoutEngine) rootJustify(justify string, height, contentHeight, spacing, count int) (int, int) {
+	if height <= 0 || count == 0 {
+		return 0, spacing
+	}
+	free := height - contentHeight - spacing*(count-1)
+	if free <= 0 {
+		return 0, spacing
+	}
+
+	switch justify {
+	case "center":
+		return free / 2, spacing
+	case "flex-end", "end":
+		return free, spacing
+	case "space-between":
+		if count > 1 {
+			return 0, spacing + free/(count-1)
+		}
+	}
+	return 0, spacing
+}
+
+// rootAlign returns the X of a top-level component of the given width for the root align_items
+func (e *LayoutEngine) rootAlign(align string, width, boxWidth int) int {
+	free := width - boxWidth
+	if free <= 0 {
+		return 0
+	}
+
+	switch align {
+	case "center":
+		return free / 2
+	case "flex-end", "end":
+		return free
+	}
+	return 0
+}
+
+// Warnings returns the layout notes collected by the last CalculateLayout call
+func (e *LayoutEngine) Warnings() []LayoutWarning {
+	return e.warnings
+}
+
 // calculateComponentLayout calculates layout for a single component
 func (e *LayoutEngine) calculateComponentLayout(comp *types.Component, x, y, availWidth, availHeight int) (LayoutBox, error) {
 	box := LayoutBox{X: x, Y: y}
@@ -62,7 +198,10 @@ func (e *LayoutEngine) calculateComponentLayout(comp *types.Component, x, y, ava
 		box.Width = availWidth
 	} else {
 		// Fallback to type-based sizing
-		switch comp.Type {
+		if comp.BaseType() != comp.Type {
+			e.logf("'%s': sizing type '%s' as '%s'", comp.ID, comp.Type, comp.BaseType())
+		}
+		switch comp.BaseType() {
 		case "text":
 			box.Width = availWidth
 		case "button":
@@ -80,13 +219,13 @@ func (e *LayoutEngine) calculateComponentLayout(comp *types.Component, x, y, ava
 		box.Height = comp.Layout.Height * e.scale
 	} else {
 		// Calculate height based on component type
-		switch comp.Type {
+		switch comp.BaseType() {
 		case "text":
 			box.Height = e.estimateTextHeight(comp)
 		case "button":
 			box.Height = 44 * e.scale
 		case "input":
-			box.Height = 40 * e.scale
+			box.Height = e.inputHeight(comp)
 		case "image":
 			box.Height = 150 * e.scale
 		case "box":
@@ -109,6 +248,14 @@ func (e *LayoutEngine) calculateChildrenLayout(comp *types.Component, parentBox
 		return nil
 	}
 
+	// Lay out the flow children, then place absolutely positioned ones against this box
+	flow, absolute := splitPositioned(comp.Children)
+	if len(absolute) > 0 {
+		inFlow := *comp
+		inFlow.Children = flow
+		comp = &inFlow
+	}
+
 	// Calculate content area (inside padding)
 	padding := comp.Layout.Padding * e.scale
 	contentX := parentBox.X + padding
@@ -120,17 +267,39 @@ func (e *LayoutEngine) calculateChildrenLayout(comp *types.Component, parentBox
 	display := comp.Layout.Display
 	if display == "" {
 		display = "flex" // default
+
+		// Multi-child boxes should say how they lay out rather than rely on the default
+		if comp.BaseType() == "box" && len(comp.Children) > 1 {
+			e.warnings = append(e.warnings, LayoutWarning{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("Display: '%s' has %d children but no display - declare flex, grid or block instead of relying on the default (flex)", comp.ID, len(comp.Children)),
+				Severity:    "warning",
+			})
+		}
 	}
 
+	switch display {
+	case "flex", "grid":
+		e.logf("'%s': %s layout for %d children in %dx%d content area", comp.ID, display, len(comp.Children), contentWidth, contentHeight)
+	default:
+		e.logf("'%s': unknown display '%s', falling back to stack", comp.ID, display)
+	}
+
+	var err error
 	switch display {
 	case "flex":
-		return e.layoutFlexChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
+		err = e.layoutFlexChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
 	case "grid":
-		return e.layoutGridChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
+		err = e.layoutGridChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
 	default:
 		// Default to stack (vertical)
-		return e.layoutStackChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
+		err = e.layoutStackChildren(comp, contentX, contentY, contentWidth, contentHeight, boxes)
+	}
+	if err != nil {
+		return err
 	}
+
+	return e.layoutAbsoluteChildren(absolute, parentBox, boxes)
 }
 
 // layoutFlexChildren positions children using flexbox rules
@@ -156,7 +325,7 @@ func (e *LayoutEngine) layoutFlexChildren(comp *types.Component, x, y, width, he
 		for i, child := range comp.Children {
 			// For text components, use intrinsic width instead of available width
 			childWidth := width
-			if child.Type == "text" {
+			if child.BaseType() == "text" {
 				childWidth = e.estimateTextWidth(&child)
 			}
 			
@@ -217,14 +386,16 @@ func (e *LayoutEngine) layoutFlexChildren(comp *types.Component, x, y, width, he
 			availableForFlex = 0
 		}
 		
+		flexWidths := e.flexChildWidths(comp.Children, availableForFlex)
+
 		// Second pass: layout children with calculated widths
 		currentX = x
-		for _, child := range comp.Children {
+		for i, child := range comp.Children {
 			childWidth := width
 			if child.Layout.Width > 0 {
 				childWidth = child.Layout.Width * e.scale
 			} else if child.Layout.Flex > 0 && totalFlex > 0 {
-				childWidth = (availableForFlex * child.Layout.Flex) / totalFlex
+				childWidth = flexWidths[i]
 			}
 			
 			childBox, err := e.calculateComponentLayout(&child, currentX, currentY, childWidth, height)
@@ -266,58 +437,250 @@ func (e *LayoutEngine) layoutFlexChildren(comp *types.Component, x, y, width, he
 	return nil
 }
 
+// flexChildWidths shares the available width between flex children by their grow
+// factors, clamping each to its min_width/max_width. Like CSS flexbox, clamped
+// children are frozen and the space they gave up or took is shared again among
+// the rest, until no child violates its bounds.
+func (e *LayoutEngine) flexChildWidths(children []types.Component, available int) []int {
+	widths := make([]int, len(children))
+	frozen := make([]bool, len(children))
+
+	for {
+		remaining := available
+		totalFlex := 0
+		for i, child := range children {
+			if child.Layout.Width > 0 || child.Layout.Flex <= 0 {
+				frozen[i] = true
+				continue
+			}
+			if frozen[i] {
+				remaining -= widths[i]
+			} else {
+				totalFlex += child.Layout.Flex
+			}
+		}
+		if totalFlex == 0 {
+			return widths
+		}
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		// Share the remaining space and measure how far the bounds pull it
+		violation := 0
+		clamped := make([]int, len(children))
+		for i, child := range children {
+			if frozen[i] {
+				continue
+			}
+			widths[i] = remaining * child.Layout.Flex / totalFlex
+			clamped[i] = e.clampFlexWidth(&child, widths[i])
+			violation += clamped[i] - widths[i]
+		}
+
+		// Freeze the min violators when the bounds grew the total, the max
+		// violators when they shrank it, and everyone when they cancel out
+		changed := false
+		for i := range children {
+			if frozen[i] || clamped[i] == widths[i] {
+				continue
+			}
+			if violation == 0 || (violation > 0) == (clamped[i] > widths[i]) {
+				widths[i] = clamped[i]
+				frozen[i] = true
+				changed = true
+			}
+		}
+		if !changed || violation == 0 {
+			return widths
+		}
+	}
+}
+
+// clampFlexWidth bounds a flex child's width by its max_width, then its
+// min_width, so min_width wins when the two conflict
+func (e *LayoutEngine) clampFlexWidth(comp *types.Component, width int) int {
+	if comp.Layout.MaxWidth > 0 && width > comp.Layout.MaxWidth*e.scale {
+		width = comp.Layout.MaxWidth * e.scale
+	}
+	if comp.Layout.MinWidth > 0 && width < comp.Layout.MinWidth*e.scale {
+		width = comp.Layout.MinWidth * e.scale
+	}
+	return width
+}
+
+// gridPlacement records where a child was auto-placed in the grid
+type gridPlacement struct {
+	row, col         int
+	rowSpan, colSpan int
+	box              LayoutBox
+}
+
 // layoutGridChildren layouts children using grid rules
 func (e *LayoutEngine) layoutGridChildren(comp *types.Component, x, y, width, height int, boxes map[string]LayoutBox) error {
-	gap := comp.Layout.Gap * e.scale
+	rowGap, columnGap := e.gridGaps(comp)
 	
 	// Parse grid_template_columns to get column widths
-	columnWidths := e.parseGridColumnWidths(comp.Layout.GridTemplateColumns, width, gap)
+	columnWidths := e.parseGridColumnWidths(comp.Layout.GridTemplateColumns, width, columnGap)
 	if len(columnWidths) == 0 {
 		// Fallback to 2-column grid with equal widths
-		cellWidth := (width - gap) / 2
+		e.logf("'%s': no usable grid_template_columns %q, falling back to 2 equal columns", comp.ID, comp.Layout.GridTemplateColumns)
+		cellWidth := (width - columnGap) / 2
 		columnWidths = []int{cellWidth, cellWidth}
 	}
 
 	columns := len(columnWidths)
-	currentX := x
-	currentY := y
-	col := 0
-	maxRowHeight := 0
 
-	for _, child := range comp.Children {
-		cellWidth := columnWidths[col]
-		
-		childBox, err := e.calculateComponentLayout(&child, currentX, currentY, cellWidth, 0)
+	// First pass: auto-place children row by row, skipping cells taken by earlier row spans
+	placements := make([]gridPlacement, len(comp.Children))
+	occupied := map[[2]int]bool{}
+	row, col := 0, 0
+
+	for i, child := range comp.Children {
+		colSpan := child.Layout.GridColumnSpan
+		if colSpan < 1 {
+			colSpan = 1
+		}
+		if colSpan > columns {
+			colSpan = columns
+		}
+		rowSpan := child.Layout.GridRowSpan
+		if rowSpan < 1 {
+			rowSpan = 1
+		}
+
+		// Advance the cursor until the spanned cells fit and are free
+		for !gridCellsFree(occupied, row, col, rowSpan, colSpan, columns) {
+			col++
+			if col >= columns {
+				col = 0
+				row++
+			}
+		}
+		for r := row; r < row+rowSpan; r++ {
+			for c := col; c < col+colSpan; c++ {
+				occupied[[2]int{r, c}] = true
+			}
+		}
+
+		// Size the cell across spanned columns, including the gaps between them
+		cellWidth := columnGap * (colSpan - 1)
+		for c := col; c < col+colSpan; c++ {
+			cellWidth += columnWidths[c]
+		}
+
+		childBox, err := e.calculateComponentLayout(&child, 0, 0, cellWidth, 0)
 		if err != nil {
 			return err
 		}
 
+		placements[i] = gridPlacement{row: row, col: col, rowSpan: rowSpan, colSpan: colSpan, box: childBox}
+
+		col += colSpan
+		if col >= columns {
+			col = 0
+			row++
+		}
+	}
+
+	// Note grids whose last row is only partially filled
+	cells := 0
+	for _, p := range placements {
+		cells += p.rowSpan * p.colSpan
+	}
+	if columns > 1 && cells%columns != 0 {
+		e.warnings = append(e.warnings, LayoutWarning{
+			ComponentID: comp.ID,
+			Message:     fmt.Sprintf("Grid: '%s' fills %d cells in %d columns, leaving a ragged last row (%d of %d cells filled)", comp.ID, cells, columns, cells%columns, columns),
+			Severity:    "info",
+		})
+	}
+
+	// Second pass: row heights come from the tallest single-row child in each row
+	rowHeights := map[int]int{}
+	for _, p := range placements {
+		if p.rowSpan == 1 && p.box.Height > rowHeights[p.row] {
+			rowHeights[p.row] = p.box.Height
+		}
+	}
+
+	// Grow the last spanned row when a row-spanning child is taller than its rows
+	for _, p := range placements {
+		if p.rowSpan > 1 {
+			spanned := e.gridSpanHeight(rowHeights, p.row, p.rowSpan, rowGap)
+			if p.box.Height > spanned {
+				rowHeights[p.row+p.rowSpan-1] += p.box.Height - spanned
+			}
+		}
+	}
+
+	// Final pass: position children and recurse
+	for i, child := range comp.Children {
+		p := placements[i]
+		childBox := p.box
+		childBox.X = x
+		for c := 0; c < p.col; c++ {
+			childBox.X += columnWidths[c] + columnGap
+		}
+		childBox.Y = y + e.gridSpanHeight(rowHeights, 0, p.row, rowGap)
+		if p.row > 0 {
+			childBox.Y += rowGap
+		}
+
+		// Row-spanning children stretch across the rows they occupy
+		if p.rowSpan > 1 && child.Layout.Height == 0 {
+			childBox.Height = e.gridSpanHeight(rowHeights, p.row, p.rowSpan, rowGap)
+		}
+
 		boxes[child.ID] = childBox
 
 		// Recurse for grandchildren
 		if err := e.calculateChildrenLayout(&child, childBox, boxes); err != nil {
 			return err
 		}
+	}
 
-		// Track max height in this row
-		if childBox.Height > maxRowHeight {
-			maxRowHeight = childBox.Height
-		}
+	return nil
+}
 
-		col++
-		if col >= columns {
-			// Move to next row
-			col = 0
-			currentX = x
-			currentY += maxRowHeight + gap
-			maxRowHeight = 0
-		} else {
-			// Move to next column
-			currentX += cellWidth + gap
+// gridGaps returns the scaled row and column gaps of a grid, falling back to gap
+// when an axis is unset. Negative gaps are invalid in CSS and are treated as 0.
+func (e *LayoutEngine) gridGaps(comp *types.Component) (rowGap, columnGap int) {
+	rowGap, columnGap = comp.Layout.Gap, comp.Layout.Gap
+	if comp.Layout.RowGap != 0 {
+		rowGap = comp.Layout.RowGap
+	}
+	if comp.Layout.ColumnGap != 0 {
+		columnGap = comp.Layout.ColumnGap
+	}
+	return max(rowGap, 0) * e.scale, max(columnGap, 0) * e.scale
+}
+
+// gridCellsFree reports whether a span starting at (row, col) fits within the columns and is unoccupied
+func gridCellsFree(occupied map[[2]int]bool, row, col, rowSpan, colSpan, columns int) bool {
+	if col+colSpan > columns {
+		return false
+	}
+	for r := row; r < row+rowSpan; r++ {
+		for c := col; c < col+colSpan; c++ {
+			if occupied[[2]int{r, c}] {
+				return false
+			}
 		}
 	}
+	return true
+}
 
-	return nil
+// gridSpanHeight returns the height of count rows starting at row, including the gaps between them
+func (e *LayoutEngine) gridSpanHeight(rowHeights map[int]int, row, count, gap int) int {
+	if count <= 0 {
+		return 0
+	}
+	total := gap * (count - 1)
+	for r := row; r < row+count; r++ {
+		total += rowHeights[r]
+	}
+	return total
 }
 
 // layoutStackChildren layouts children in a vertical stack (default)
@@ -349,13 +712,13 @@ func (e *LayoutEngine) estimateContentHeight(comp *types.Component) int {
 	padding := comp.Layout.Padding * e.scale
 	baseHeight := padding * 2
 
-	switch comp.Type {
+	switch comp.BaseType() {
 	case "text":
 		return baseHeight + e.estimateTextHeight(comp)
 	case "button":
 		return baseHeight + 44*e.scale // minimum touch target
 	case "input":
-		return baseHeight + 40*e.scale
+		return baseHeight + e.inputHeight(comp)
 	case "image":
 		return baseHeight + 150*e.scale
 	case "box":
@@ -365,63 +728,40 @@ func (e *LayoutEngine) estimateContentHeight(comp *types.Component) int {
 	}
 }
 
+// inputHeight returns the default height of an input; textareas get room for several lines
+func (e *LayoutEngine) inputHeight(comp *types.Component) int {
+	if comp.InputKind() == "textarea" {
+		return 96 * e.scale
+	}
+	return 40 * e.scale
+}
+
 // estimateTextHeight returns height needed for text
 func (e *LayoutEngine) estimateTextHeight(comp *types.Component) int {
 	// Use consistent 16px line height to match rendering
 	lineHeight := 16
 	
-	// Count lines in content (split by newline)
-	// This includes empty lines for spacing
-	lines := 1
-	if comp.Content != "" {
-		lines = len(strings.Split(comp.Content, "\n"))
+	// Count lines in content, keeping blank lines between paragraphs for spacing;
+	// blank content takes no space so it does not push the layout
+	lines := len(comp.TextLines())
+	if lines == 0 {
+		return 0
 	}
-	
+
 	// Add 14px for first line baseline + (lines * lineHeight) + 8px bottom padding
 	return (14 + (lines * lineHeight) + 8) * e.scale
 }
 
 // estimateTextWidth returns approximate width needed for text
 func (e *LayoutEngine) estimateTextWidth(comp *types.Component) int {
-	if comp.Content == "" {
-		return 0
-	}
-	
-	// Find longest line
-	lines := strings.Split(comp.Content, "\n")
-	maxLen := 0
-	for _, line := range lines {
-		if len(line) > maxLen {
-			maxLen = len(line)
-		}
-	}
-	
-	// Rough approximation: 7 pixels per character (monospace-ish)
-	// Adjust based on font size
-	baseWidth := 7
-	switch comp.Size {
-	case "xs":
-		baseWidth = 5
-	case "sm":
-		baseWidth = 6
-	case "base":
-		baseWidth = 7
-	case "lg":
-		baseWidth = 9
-	case "xl":
-		baseWidth = 11
-	case "2xl":
-		baseWidth = 14
-	case "3xl":
-		baseWidth = 18
-	}
-	
-	return (maxLen * baseWidth) * e.scale
+	return comp.EstimatedTextWidth() * e.scale
 }
 
 // calculateContainerHeight calculates height for a container with children
 func (e *LayoutEngine) calculateContainerHeight(comp *types.Component, width int) int {
-	if len(comp.Children) == 0 {
+	// Absolutely positioned children do not take up room in the container
+	children, _ := splitPositioned(comp.Children)
+	if len(children) == 0 {
 		return 0
 	}
 
@@ -441,16 +781,16 @@ func (e *LayoutEngine) calculateContainerHeight(comp *types.Component, width int
 
 	if direction == "vertical" {
 		// Stack children vertically
-		for _, child := range comp.Children {
+		for _, child := range children {
 			totalHeight += e.estimateContentHeight(&child)
 		}
-		if len(comp.Children) > 1 {
-			totalHeight += gap * (len(comp.Children) - 1)
+		if len(children) > 1 {
+			totalHeight += gap * (len(children) - 1)
 		}
 	} else {
 		// Horizontal layout - use max child height
 		maxHeight := 0
-		for _, child := range comp.Children {
+		for _, child := range children {
 			h := e.estimateContentHeight(&child)
 			if h > maxHeight {
 				maxHeight = h
@@ -1,7 +1,12 @@
 package render
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
 )
 
 func TestParseGridColumns(t *testing.T) {
@@ -80,3 +85,500 @@ func TestParseGridColumns_EdgeCases(t *testing.T) {
 		t.Errorf("parseGridColumns 8 columns failed: got %d, expected 8", result)
 	}
 }
+
+func TestLayoutGridChildren_ColumnSpan(t *testing.T) {
+	engine := NewLayoutEngine(1)
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "grid",
+				Type: "box",
+				Layout: types.ComponentLayout{
+					Display:             "grid",
+					GridTemplateColumns: "repeat(3, 1fr)",
+					Gap:                 16,
+				},
+				Children: []types.Component{
+					{ID: "featured", Type: "box", Layout: types.ComponentLayout{GridColumnSpan: 2, Height: 100}},
+					{ID: "side", Type: "box", Layout: types.ComponentLayout{Height: 100}},
+					{ID: "next", Type: "box", Layout: types.ComponentLayout{Height: 100}},
+				},
+			},
+		},
+	}
+
+	boxes, err := engine.CalculateLayout(structure, 332, 800)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	// 332px wide with two 16px gaps gives 100px cells
+	if got := boxes["featured"].Width; got != 216 {
+		t.Errorf("featured width = %d, expected 216 (two cells plus the gap)", got)
+	}
+	if got := boxes["side"].X; got != 232 {
+		t.Errorf("side X = %d, expected 232 (third column)", got)
+	}
+	if got := boxes["next"]; got.X != 0 || got.Y != 116 {
+		t.Errorf("next at (%d, %d), expected (0, 116) on the second row", got.X, got.Y)
+	}
+}
+
+func TestLayoutGridChildren_RowAndColumnGap(t *testing.T) {
+	newGrid := func(layout types.ComponentLayout) *types.Structure {
+		layout.Display = "grid"
+		layout.GridTemplateColumns = "100px 100px"
+		return &types.Structure{
+			Components: []types.Component{
+				{
+					ID:     "grid",
+					Type:   "box",
+					Layout: layout,
+					Children: []types.Component{
+						{ID: "a", Type: "box", Layout: types.ComponentLayout{Height: 50}},
+						{ID: "b", Type: "box", Layout: types.ComponentLayout{Height: 50}},
+						{ID: "c", Type: "box", Layout: types.ComponentLayout{Height: 50}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		layout    types.ComponentLayout
+		expectedX int // X of "b": 100px column plus the column gap
+		expectedY int // Y of "c": 50px row plus the row gap
+	}{
+		{"distinct axes", types.ComponentLayout{RowGap: 32, ColumnGap: 8}, 108, 82},
+		{"row gap falls back to gap", types.ComponentLayout{Gap: 16, ColumnGap: 4}, 104, 66},
+		{"column gap falls back to gap", types.ComponentLayout{Gap: 16, RowGap: 24}, 116, 74},
+		{"negative gaps are ignored", types.ComponentLayout{RowGap: -8, ColumnGap: -8}, 100, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boxes, err := NewLayoutEngine(1).CalculateLayout(newGrid(tt.layout), 400, 800)
+			if err != nil {
+				t.Fatalf("CalculateLayout failed: %v", err)
+			}
+			if got := boxes["b"]; got.X != tt.expectedX || got.Y != 0 {
+				t.Errorf("b at (%d, %d), expected (%d, 0)", got.X, got.Y, tt.expectedX)
+			}
+			if got := boxes["c"]; got.X != 0 || got.Y != tt.expectedY {
+				t.Errorf("c at (%d, %d), expected (0, %d)", got.X, got.Y, tt.expectedY)
+			}
+		})
+	}
+}
+
+func TestLayoutGridChildren_RowSpan(t *testing.T) {
+	engine := NewLayoutEngine(1)
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "grid",
+				Type: "box",
+				Layout: types.ComponentLayout{
+					Display:             "grid",
+					GridTemplateColumns: "100px 100px",
+					Gap:                 10,
+				},
+				Children: []types.Component{
+					{ID: "tall", Type: "box", Layout: types.ComponentLayout{GridRowSpan: 2}},
+					{ID: "a", Type: "box", Layout: types.ComponentLayout{Height: 50}},
+					{ID: "b", Type: "box", Layout: types.ComponentLayout{Height: 50}},
+				},
+			},
+		},
+	}
+
+	boxes, err := engine.CalculateLayout(structure, 210, 800)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	// "b" skips the cell occupied by "tall" and lands in the second column of row two
+	if got := boxes["b"]; got.X != 110 || got.Y != 60 {
+		t.Errorf("b at (%d, %d), expected (110, 60)", got.X, got.Y)
+	}
+	if got := boxes["tall"].Height; got < 110 {
+		t.Errorf("tall height = %d, expected at least two rows plus the gap (110)", got)
+	}
+}
+
+func TestCalculateLayout_RaggedGridWarning(t *testing.T) {
+	newGrid := func(count int) *types.Structure {
+		children := make([]types.Component, count)
+		for i := range children {
+			children[i] = types.Component{ID: fmt.Sprintf("card-%d", i), Type: "box"}
+		}
+		return &types.Structure{
+			Components: []types.Component{
+				{
+					ID:       "cards",
+					Type:     "box",
+					Layout:   types.ComponentLayout{Display: "grid", GridTemplateColumns: "repeat(3, 1fr)"},
+					Children: children,
+				},
+			},
+		}
+	}
+
+	engine := NewLayoutEngine(1)
+	if _, err := engine.CalculateLayout(newGrid(10), 1200, 800); err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	warnings := engine.Warnings()
+	if len(warnings) != 1 || warnings[0].ComponentID != "cards" || warnings[0].Severity != "info" {
+		t.Fatalf("Expected one info warning for 'cards', got %+v", warnings)
+	}
+
+	if _, err := engine.CalculateLayout(newGrid(9), 1200, 800); err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if warnings := engine.Warnings(); len(warnings) != 0 {
+		t.Errorf("Expected no warnings for a full grid, got %+v", warnings)
+	}
+}
+
+func TestCalculateLayout_UndeclaredDisplay(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "form",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "email", Type: "input"},
+					{ID: "submit", Type: "button", Content: "Sign in"},
+				},
+			},
+			{
+				ID:       "footer",
+				Type:     "box",
+				Layout:   types.ComponentLayout{Display: "flex"},
+				Children: []types.Component{{ID: "terms", Type: "text"}, {ID: "privacy", Type: "text"}},
+			},
+			{
+				ID:       "wrapper",
+				Type:     "box",
+				Children: []types.Component{{ID: "only", Type: "text"}},
+			},
+		},
+	}
+
+	engine := NewLayoutEngine(1)
+	if _, err := engine.CalculateLayout(structure, 1200, 0); err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	warnings := engine.Warnings()
+	if len(warnings) != 1 || warnings[0].ComponentID != "form" || warnings[0].Severity != "warning" {
+		t.Fatalf("Expected one warning for 'form', got %+v", warnings)
+	}
+	if !strings.Contains(warnings[0].Message, "no display") {
+		t.Errorf("Expected the message to mention the missing display, got %q", warnings[0].Message)
+	}
+}
+
+func TestCalculateLayout_Log(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "cards",
+				Type:   "card",
+				Layout: types.ComponentLayout{Display: "grid"},
+				Children: []types.Component{
+					{ID: "card-1", Type: "box", Layout: types.ComponentLayout{Height: 40}},
+				},
+			},
+		},
+	}
+
+	var log bytes.Buffer
+	engine := NewLayoutEngine(1)
+	engine.SetLog(&log)
+	if _, err := engine.CalculateLayout(structure, 400, 0); err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	for _, expected := range []string{
+		"'cards': sizing type 'card' as 'box'",
+		"'cards': grid layout for 1 children",
+		"falling back to 2 equal columns",
+		"'card-1' (box) at (0, 0) size 200x40",
+	} {
+		if !strings.Contains(log.String(), expected) {
+			t.Errorf("Expected log to contain %q, got:\n%s", expected, log.String())
+		}
+	}
+
+	// Logging is off by default
+	engine.SetLog(nil)
+	log.Reset()
+	if _, err := engine.CalculateLayout(structure, 400, 0); err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if log.Len() != 0 {
+		t.Errorf("Expected no log output after SetLog(nil), got %q", log.String())
+	}
+}
+
+func TestCalculateLayout_RootAlignment(t *testing.T) {
+	newStructure := func(justify, align string) *types.Structure {
+		return &types.Structure{
+			Layout: types.Layout{Spacing: 20, JustifyContent: justify, AlignItems: align},
+			Components: []types.Component{
+				{ID: "form", Type: "box", Layout: types.ComponentLayout{Width: 400, Height: 200}},
+				{ID: "submit", Type: "button", Layout: types.ComponentLayout{Height: 40}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		justify        string
+		align          string
+		expectedForm   [2]int // X, Y of the 400x200 form
+		expectedSubmit [2]int // X, Y of the 120x40 button
+	}{
+		{"left-aligned by default", "", "", [2]int{0, 0}, [2]int{0, 220}},
+		{"centered", "center", "center", [2]int{200, 170}, [2]int{340, 390}},
+		{"end", "flex-end", "flex-end", [2]int{400, 340}, [2]int{680, 560}},
+		{"space-between", "space-between", "", [2]int{0, 0}, [2]int{0, 560}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 800x600 canvas holding 260px of content leaves 340px free
+			boxes, err := NewLayoutEngine(1).CalculateLayout(newStructure(tt.justify, tt.align), 800, 600)
+			if err != nil {
+				t.Fatalf("CalculateLayout failed: %v", err)
+			}
+			if got := boxes["form"]; got.X != tt.expectedForm[0] || got.Y != tt.expectedForm[1] {
+				t.Errorf("form at (%d, %d), expected %v", got.X, got.Y, tt.expectedForm)
+			}
+			if got := boxes["submit"]; got.X != tt.expectedSubmit[0] || got.Y != tt.expectedSubmit[1] {
+				t.Errorf("submit at (%d, %d), expected %v", got.X, got.Y, tt.expectedSubmit)
+			}
+		})
+	}
+}
+
+func TestCalculateLayout_RootJustifyNeedsHeight(t *testing.T) {
+	structure := &types.Structure{
+		Layout: types.Layout{JustifyContent: "center", AlignItems: "center"},
+		Components: []types.Component{
+			{
+				ID:       "card",
+				Type:     "box",
+				Layout:   types.ComponentLayout{Width: 200, Height: 100},
+				Children: []types.Component{{ID: "title", Type: "text", Content: "Hi"}},
+			},
+		},
+	}
+
+	// Auto height leaves nothing to distribute vertically, but centering still applies horizontally
+	boxes, err := NewLayoutEngine(1).CalculateLayout(structure, 600, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := boxes["card"]; got.X != 200 || got.Y != 0 {
+		t.Errorf("card at (%d, %d), expected (200, 0)", got.X, got.Y)
+	}
+	// Children follow their moved parent
+	if got := boxes["title"]; got.X != 200 {
+		t.Errorf("title X = %d, expected 200 inside the centered card", got.X)
+	}
+}
+
+func TestCalculateLayout_NarrowContentOnWideCanvas(t *testing.T) {
+	structure := &types.Structure{
+		Layout: types.Layout{Type: "stack", MaxWidth: 375},
+		Components: []types.Component{
+			{
+				ID:       "header",
+				Type:     "box",
+				Layout:   types.ComponentLayout{Display: "block", Padding: 16},
+				Children: []types.Component{{ID: "title", Type: "text", Content: "Inbox"}},
+			},
+			{ID: "compose", Type: "button", Content: "Compose"},
+		},
+	}
+
+	// The canvas stays 1920px wide; the content column is 375px and centered
+	boxes, err := NewLayoutEngine(1).CalculateLayout(structure, 1920, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := boxes["header"]; got.X != 772 || got.Width != 375 {
+		t.Errorf("header at x=%d width=%d, expected x=772 width=375", got.X, got.Width)
+	}
+	if got := boxes["title"]; got.X != 788 || got.Width != 343 {
+		t.Errorf("title at x=%d width=%d, expected x=788 width=343 inside the header padding", got.X, got.Width)
+	}
+	if got := boxes["compose"]; got.X < 772 || got.X+got.Width > 772+375 {
+		t.Errorf("compose at x=%d width=%d, expected it inside the 375px column", got.X, got.Width)
+	}
+
+	// Scaling scales the column with the canvas
+	scaled, err := NewLayoutEngine(2).CalculateLayout(structure, 3840, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := scaled["header"]; got.X != 1545 || got.Width != 750 {
+		t.Errorf("scaled header at x=%d width=%d, expected x=1545 width=750", got.X, got.Width)
+	}
+
+	// A canvas narrower than the max-width is used in full
+	narrow, err := NewLayoutEngine(1).CalculateLayout(structure, 320, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := narrow["header"]; got.X != 0 || got.Width != 320 {
+		t.Errorf("header on a narrow canvas at x=%d width=%d, expected x=0 width=320", got.X, got.Width)
+	}
+}
+
+func TestCalculateLayout_ContentWidthOverridesMaxWidth(t *testing.T) {
+	structure := &types.Structure{
+		Layout:     types.Layout{Type: "stack", MaxWidth: 1200},
+		Components: []types.Component{{ID: "hero", Type: "box", Layout: types.ComponentLayout{Height: 100}}},
+	}
+
+	engine := NewLayoutEngine(1)
+	engine.SetContentWidth(375)
+	boxes, err := engine.CalculateLayout(structure, 1920, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := boxes["hero"]; got.X != 772 || got.Width != 375 {
+		t.Errorf("hero at x=%d width=%d, expected x=772 width=375", got.X, got.Width)
+	}
+
+	engine.SetContentWidth(0)
+	boxes, err = engine.CalculateLayout(structure, 1920, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := boxes["hero"]; got.X != 360 || got.Width != 1200 {
+		t.Errorf("hero at x=%d width=%d, expected the structure's 1200px column at x=360", got.X, got.Width)
+	}
+}
+
+func TestLayoutFlexChildren_MaxWidthRedistributes(t *testing.T) {
+	engine := NewLayoutEngine(1)
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "row",
+				Type:   "box",
+				Layout: types.ComponentLayout{Direction: "horizontal", Gap: 20},
+				Children: []types.Component{
+					{ID: "content", Type: "box", Layout: types.ComponentLayout{Flex: 2, MaxWidth: 400, Height: 100}},
+					{ID: "aside", Type: "box", Layout: types.ComponentLayout{Flex: 1, Height: 100}},
+				},
+			},
+		},
+	}
+
+	boxes, err := engine.CalculateLayout(structure, 1220, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	// 1200px to share: content would get 800px but is capped at 400px, so aside takes the rest
+	if got := boxes["content"].Width; got != 400 {
+		t.Errorf("content width = %d, expected 400 (max_width)", got)
+	}
+	if got := boxes["aside"]; got.Width != 800 || got.X != 420 {
+		t.Errorf("aside at x=%d width=%d, expected x=420 width=800", got.X, got.Width)
+	}
+}
+
+func TestLayoutFlexChildren_MinWidthRedistributes(t *testing.T) {
+	engine := NewLayoutEngine(1)
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "row",
+				Type:   "box",
+				Layout: types.ComponentLayout{Direction: "horizontal"},
+				Children: []types.Component{
+					{ID: "nav", Type: "box", Layout: types.ComponentLayout{Flex: 1, MinWidth: 300, Height: 100}},
+					{ID: "main", Type: "box", Layout: types.ComponentLayout{Flex: 3, Height: 100}},
+				},
+			},
+		},
+	}
+
+	boxes, err := engine.CalculateLayout(structure, 800, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	// nav would get 200px but needs 300px, which comes out of main's share
+	if got := boxes["nav"].Width; got != 300 {
+		t.Errorf("nav width = %d, expected 300 (min_width)", got)
+	}
+	if got := boxes["main"].Width; got != 500 {
+		t.Errorf("main width = %d, expected 500", got)
+	}
+}
+
+func TestLayoutFlexChildren_MinWidthWinsOverMaxWidth(t *testing.T) {
+	engine := NewLayoutEngine(2)
+	child := types.Component{ID: "c", Layout: types.ComponentLayout{Flex: 1, MinWidth: 300, MaxWidth: 200}}
+
+	widths := engine.flexChildWidths([]types.Component{child}, 1000)
+	if widths[0] != 600 {
+		t.Errorf("width = %d, expected 600 (min_width at 2x scale)", widths[0])
+	}
+}
+
+func TestEstimateTextHeight_BlankContent(t *testing.T) {
+	engine := NewLayoutEngine(1)
+	oneLine := engine.estimateTextHeight(&types.Component{Type: "text", Content: "Hello"})
+
+	tests := []struct {
+		name     string
+		content  string
+		expected int
+	}{
+		{"empty", "", 0},
+		{"whitespace only", " \n\n\t", 0},
+		{"trailing newlines", "Hello\n\n\n", oneLine},
+		{"surrounding blank lines", "\n\nHello\n", oneLine},
+		{"paragraphs", "Hello\n\nWorld", oneLine + 2*16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := engine.estimateTextHeight(&types.Component{Type: "text", Content: tt.content}); got != tt.expected {
+				t.Errorf("estimateTextHeight(%q) = %d, expected %d", tt.content, got, tt.expected)
+			}
+		})
+	}
+
+	// An empty text component no longer pushes its siblings down
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "stack",
+				Type:   "box",
+				Layout: types.ComponentLayout{Display: "flex", Direction: "vertical", Gap: 8},
+				Children: []types.Component{
+					{ID: "spacer", Type: "text", Content: "\n\n"},
+					{ID: "body", Type: "text", Content: "Hello"},
+				},
+			},
+		},
+	}
+	boxes, err := engine.CalculateLayout(structure, 400, 800)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if got := boxes["body"].Y - boxes["spacer"].Y; got != 8 {
+		t.Errorf("Expected only the gap between a blank text and its sibling, got %dpx", got)
+	}
+}
@@ -0,0 +1,157 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+
+	"github.com/johanbellander/prism/internal/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Legend geometry in unscaled pixels
+const (
+	legendPadding    = 12 // around the legend strip
+	legendLineHeight = 20 // height of the title line and each swatch row
+	legendSwatchSize = 12 // side of a swatch square
+	legendItemGap    = 20 // horizontal space between swatch items
+)
+
+// legendItem is a single swatch and its explanation
+type legendItem struct {
+	label string
+	draw  func(r *Renderer, img *image.RGBA, x, y, size int)
+}
+
+// legendItems returns the symbols drawn in the mockup that the legend explains
+func (r *Renderer) legendItems() []legendItem {
+	border := palette.Border
+	muted := palette.TextSecondary
+
+	fill := func(col color.Color) func(r *Renderer, img *image.RGBA, x, y, size int) {
+		return func(r *Renderer, img *image.RGBA, x, y, size int) {
+			draw.Draw(img, image.Rect(x, y, x+size, y+size), &image.Uniform{col}, image.Point{}, draw.Src)
+		}
+	}
+	outline := func(col color.Color) func(r *Renderer, img *image.RGBA, x, y, size int) {
+		return func(r *Renderer, img *image.RGBA, x, y, size int) {
+			r.drawRect(img, x, y, size, size, col)
+		}
+	}
+
+	items := []legendItem{
+		{"Button", fill(color.Black)},
+		{"Input / border", outline(border)},
+		{"Image placeholder", fill(palette.Placeholder)},
+		{"Unknown type", outline(muted)},
+	}
+	if r.opts.ShowFocus {
+		items = append(items, legendItem{"Focus ring", func(r *Renderer, img *image.RGBA, x, y, size int) {
+			r.drawRect(img, x, y, size, size, color.Black)
+			r.drawRect(img, x+1, y+1, size-2, size-2, color.Black)
+		}})
+	}
+	if r.opts.TabOrder {
+		items = append(items, legendItem{"Tab order", func(r *Renderer, img *image.RGBA, x, y, size int) {
+			r.drawBadge(img, image.Pt(x+size/2, y+size/2), size/2, "")
+		}})
+	}
+	if r.opts.Guides {
+		guideLine := func(col color.Color) func(r *Renderer, img *image.RGBA, x, y, size int) {
+			return func(r *Renderer, img *image.RGBA, x, y, size int) {
+				mid := x + size/2
+				draw.Draw(img, image.Rect(mid, y, mid+r.opts.Scale, y+size), &image.Uniform{col}, image.Point{}, draw.Over)
+			}
+		}
+		items = append(items,
+			legendItem{"Shared edge", guideLine(guideShared)},
+			legendItem{"Single edge", guideLine(guideSingle)},
+			legendItem{"Misaligned edge", guideLine(guideDrifts)},
+		)
+	}
+	return items
+}
+
+// legendItemWidth is the width of a swatch item: square, gap and 7px glyphs
+func legendItemWidth(item legendItem, scale int) int {
+	return (legendSwatchSize+6)*scale + len(item.label)*7
+}
+
+// legendRows wraps the legend items into rows that fit the canvas width
+func legendRows(items []legendItem, width, scale int) [][]legendItem {
+	available := width - legendPadding*2*scale
+	rows := [][]legendItem{}
+	row := []legendItem{}
+	rowWidth := 0
+	for _, item := range items {
+		itemWidth := legendItemWidth(item, scale)
+		if len(row) > 0 && rowWidth+legendItemGap*scale+itemWidth > available {
+			rows = append(rows, row)
+			row, rowWidth = []legendItem{}, 0
+		}
+		if len(row) > 0 {
+			rowWidth += legendItemGap * scale
+		}
+		row = append(row, item)
+		rowWidth += itemWidth
+	}
+	if len(row) > 0 {
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// legendHeight returns the canvas height reserved for the legend strip
+func (r *Renderer) legendHeight(width int) int {
+	rows := legendRows(r.legendItems(), width, r.opts.Scale)
+	return (legendPadding*2 + legendLineHeight*(1+len(rows))) * r.opts.Scale
+}
+
+// drawLegend draws the legend strip below a mockup of the given height, listing the
+// structure version, render dimensions and the meaning of each drawn symbol
+func (r *Renderer) drawLegend(img *image.RGBA, structure *types.Structure, mockupHeight int) {
+	scale := r.opts.Scale
+	width := img.Bounds().Dx()
+	muted := palette.TextSecondary
+
+	top := mockupHeight
+	background := image.Rect(0, top, width, img.Bounds().Dy())
+	draw.Draw(img, background, &image.Uniform{palette.Surface}, image.Point{}, draw.Src)
+	r.drawHorizontalLine(img, 0, top, width, palette.Border)
+
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.Black),
+		Face: basicfont.Face7x13,
+	}
+
+	x := legendPadding * scale
+	y := top + legendPadding*scale
+
+	version := structure.Version
+	if version == "" {
+		version = "unversioned"
+	}
+	if r.opts.Component != "" {
+		version += " #" + r.opts.Component
+	}
+	d.Dot = fixed.P(x, y+14*scale)
+	d.DrawString(fmt.Sprintf("%s | %dx%d @%dx (%dx%d px) | %s viewport",
+		version, width/scale, mockupHeight/scale, scale, width, mockupHeight, r.opts.Viewport))
+
+	d.Src = image.NewUniform(muted)
+	swatch := legendSwatchSize * scale
+	for _, row := range legendRows(r.legendItems(), width, scale) {
+		y += legendLineHeight * scale
+		itemX := x
+		for _, item := range row {
+			item.draw(r, img, itemX, y+4*scale, swatch)
+			d.Dot = fixed.P(itemX+swatch+6*scale, y+14*scale)
+			d.DrawString(item.label)
+			itemX += legendItemWidth(item, scale) + legendItemGap*scale
+		}
+	}
+}
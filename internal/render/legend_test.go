@@ -0,0 +1,63 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestRender_AnnotationLegend(t *testing.T) {
+	structure := &types.Structure{
+		Version: "v2",
+		Components: []types.Component{
+			{ID: "title", Type: "text", Content: "Dashboard"},
+		},
+	}
+
+	plain, err := NewRenderer(RenderOptions{Width: 1200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if plain.Height != 200 {
+		t.Errorf("Expected no legend without annotations, got height %d", plain.Height)
+	}
+
+	annotated, err := NewRenderer(RenderOptions{Width: 1200, Height: 200, Annotations: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render with annotations failed: %v", err)
+	}
+
+	// Padding, the title line and a single row of swatches
+	if expected := 200 + 12*2 + 20*2; annotated.Height != expected || annotated.Image.Bounds().Dy() != expected {
+		t.Errorf("Annotated height = %d, expected %d", annotated.Height, expected)
+	}
+
+	// The divider sits at the bottom of the mockup and the button swatch is black
+	if got := annotated.Image.RGBAAt(600, 200); got != (color.RGBA{229, 229, 229, 255}) {
+		t.Errorf("Expected legend divider at y=200, got %v", got)
+	}
+	if got := annotated.Image.RGBAAt(14, 200+12+20+6); got != (color.RGBA{0, 0, 0, 255}) {
+		t.Errorf("Expected button swatch in the legend, got %v", got)
+	}
+}
+
+func TestLegendRows_WrapsToWidth(t *testing.T) {
+	r := NewRenderer(RenderOptions{Width: 400, ShowFocus: true})
+	items := r.legendItems()
+
+	if got := len(legendRows(items, 1200, 1)); got != 1 {
+		t.Errorf("Expected one row at 1200px, got %d", got)
+	}
+	rows := legendRows(items, 400, 1)
+	if len(rows) < 2 {
+		t.Fatalf("Expected items to wrap at 400px, got %d row(s)", len(rows))
+	}
+	count := 0
+	for _, row := range rows {
+		count += len(row)
+	}
+	if count != len(items) {
+		t.Errorf("Expected all %d items across rows, got %d", len(items), count)
+	}
+}
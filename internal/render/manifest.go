@@ -0,0 +1,67 @@
+package render
+
+import (
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// Manifest maps the regions of a rendered image back to the components drawn there,
+// for design-to-code tooling. Coordinates are canvas pixels after the scale factor.
+type Manifest struct {
+	Version    string          `json:"version"`
+	Width      int             `json:"width"`  // canvas width in pixels
+	Height     int             `json:"height"` // canvas height in pixels, including any legend
+	Scale      int             `json:"scale"`
+	Components []ManifestEntry `json:"components"`
+}
+
+// ManifestEntry describes one rendered component and its computed box
+type ManifestEntry struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Role        string `json:"role,omitempty"`
+	Parent      string `json:"parent,omitempty"` // ID of the enclosing component ("" at the top level)
+	X           int    `json:"x"`
+	Y           int    `json:"y"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Interactive bool   `json:"interactive"`
+}
+
+// BuildManifest lists every component that has a box in result, in document order.
+// Components outside a --component subtree have no box and are left out.
+func BuildManifest(structure *types.Structure, result *RenderResult, scale int) Manifest {
+	if scale <= 0 {
+		scale = 1
+	}
+	manifest := Manifest{
+		Version:    structure.Version,
+		Width:      result.Width,
+		Height:     result.Height,
+		Scale:      scale,
+		Components: []ManifestEntry{},
+	}
+
+	var walk func(components []types.Component, parent string)
+	walk = func(components []types.Component, parent string) {
+		for i := range components {
+			comp := &components[i]
+			if box, ok := result.Boxes[comp.ID]; ok {
+				manifest.Components = append(manifest.Components, ManifestEntry{
+					ID:          comp.ID,
+					Type:        comp.Type,
+					Role:        comp.Role,
+					Parent:      parent,
+					X:           box.X,
+					Y:           box.Y,
+					Width:       box.Width,
+					Height:      box.Height,
+					Interactive: comp.IsInteractive(),
+				})
+			}
+			walk(comp.Children, comp.ID)
+		}
+	}
+	walk(structure.Components, "")
+
+	return manifest
+}
@@ -0,0 +1,78 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestBuildManifest(t *testing.T) {
+	structure := &types.Structure{
+		Version: "v3",
+		Components: []types.Component{
+			{
+				ID:   "login",
+				Type: "box",
+				Role: "form",
+				Children: []types.Component{
+					{ID: "email-input", Type: "input"},
+					{ID: "submit", Type: "button", Content: "Sign in", Layout: types.ComponentLayout{Width: 120, Height: 44}},
+				},
+			},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 400, Height: 300, Scale: 2}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	manifest := BuildManifest(structure, result, 2)
+
+	if manifest.Version != "v3" || manifest.Scale != 2 || manifest.Width != 800 {
+		t.Errorf("Unexpected manifest header: %+v", manifest)
+	}
+	if len(manifest.Components) != 3 {
+		t.Fatalf("Expected 3 components, got %d", len(manifest.Components))
+	}
+
+	ids := []string{"login", "email-input", "submit"}
+	for i, entry := range manifest.Components {
+		if entry.ID != ids[i] {
+			t.Errorf("Entry %d: expected %s in document order, got %s", i, ids[i], entry.ID)
+		}
+		if box := result.Boxes[entry.ID]; entry.X != box.X || entry.Y != box.Y || entry.Width != box.Width || entry.Height != box.Height {
+			t.Errorf("Entry %s does not match its layout box %+v: %+v", entry.ID, box, entry)
+		}
+	}
+
+	login, submit := manifest.Components[0], manifest.Components[2]
+	if login.Role != "form" || login.Parent != "" || login.Interactive {
+		t.Errorf("Unexpected container entry: %+v", login)
+	}
+	if submit.Parent != "login" || !submit.Interactive {
+		t.Errorf("Unexpected button entry: %+v", submit)
+	}
+	// Coordinates are post-scale: the 120x44 button is 240x88 on the 2x canvas
+	if submit.Width != 240 || submit.Height != 88 {
+		t.Errorf("Expected post-scale button size 240x88, got %dx%d", submit.Width, submit.Height)
+	}
+}
+
+func TestBuildManifest_Subtree(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "header", Type: "box"},
+			{ID: "metrics", Type: "box", Children: []types.Component{{ID: "metric-1", Type: "text", Content: "42"}}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 400, Component: "metrics"}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	manifest := BuildManifest(structure, result, 1)
+
+	if len(manifest.Components) != 2 || manifest.Components[0].ID != "metrics" {
+		t.Errorf("Expected only the rendered subtree, got %+v", manifest.Components)
+	}
+}
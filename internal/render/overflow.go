@@ -0,0 +1,70 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// overflowFadeHeight is the height of the fade drawn over the bottom edge of a
+// fixed-height canvas whose content continues below it
+const overflowFadeHeight = 48
+
+// contentBottom returns the lowest edge of any laid-out component
+func contentBottom(boxes map[string]LayoutBox) int {
+	bottom := 0
+	for _, box := range boxes {
+		if box.Y+box.Height > bottom {
+			bottom = box.Y + box.Height
+		}
+	}
+	return bottom
+}
+
+// renderOverflowIndicator fades the bottom of a mockup of the given height into the
+// canvas background and labels how much content is hidden, like a scrollable viewport
+func (r *Renderer) renderOverflowIndicator(img *image.RGBA, height, hidden int, background color.NRGBA) {
+	scale := r.opts.Scale
+	width := img.Bounds().Dx()
+
+	fade := overflowFadeHeight * scale
+	if fade > height/2 {
+		fade = height / 2
+	}
+
+	// Ramp from transparent to nearly opaque canvas background at the bottom edge
+	for i := 0; i < fade; i++ {
+		y := height - fade + i
+		alpha := uint8(230 * (i + 1) / fade)
+		for x := 0; x < width; x++ {
+			under := img.RGBAAt(x, y)
+			over := CompositeOver(color.NRGBA{background.R, background.G, background.B, alpha},
+				color.NRGBA{under.R, under.G, under.B, 255})
+			img.SetRGBA(x, y, color.RGBA{over.R, over.G, over.B, 255})
+		}
+	}
+
+	muted := palette.TextSecondary
+	label := fmt.Sprintf("content continues (%dpx more)", hidden/scale)
+	labelWidth := len(label) * 7
+	chevron := 5 * scale
+	left := (width - labelWidth - chevron*2 - 8*scale) / 2
+	baseline := height - 8*scale
+
+	// Down chevron ahead of the label
+	cx, cy := float64(left+chevron), float64(baseline-4*scale)
+	r.drawLine(img, cx-float64(chevron), cy-float64(chevron)/2, cx, cy+float64(chevron)/2, scale, muted)
+	r.drawLine(img, cx, cy+float64(chevron)/2, cx+float64(chevron), cy-float64(chevron)/2, scale, muted)
+
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(muted),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(left+chevron*2+8*scale, baseline),
+	}
+	d.DrawString(label)
+}
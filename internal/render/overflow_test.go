@@ -0,0 +1,55 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestRender_OverflowIndicator(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "panel", Type: "box", Layout: types.ComponentLayout{Background: "#000000", Height: 400}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 100}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if result.Overflow != 300 {
+		t.Errorf("Overflow = %d, expected 300", result.Overflow)
+	}
+
+	black := color.RGBA{0, 0, 0, 255}
+	if got := result.Image.RGBAAt(2, 10); got != black {
+		t.Errorf("Expected the panel above the fade, got %v", got)
+	}
+	// The bottom edge fades nearly to the white page background
+	if got := result.Image.RGBAAt(2, 99); got.R < 200 {
+		t.Errorf("Expected the bottom edge to fade out, got %v", got)
+	}
+}
+
+func TestRender_NoOverflowIndicator(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "panel", Type: "box", Layout: types.ComponentLayout{Background: "#000000", Height: 400}},
+		},
+	}
+
+	// Content that fits, and auto height, draw no indicator
+	for _, height := range []int{500, 0} {
+		result, err := NewRenderer(RenderOptions{Width: 200, Height: height}).Render(structure)
+		if err != nil {
+			t.Fatalf("Render failed: %v", err)
+		}
+		if result.Overflow != 0 {
+			t.Errorf("height %d: Overflow = %d, expected 0", height, result.Overflow)
+		}
+		if got := result.Image.RGBAAt(2, 399); got != (color.RGBA{0, 0, 0, 255}) {
+			t.Errorf("height %d: expected the panel's bottom edge untouched, got %v", height, got)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package render
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// Palette holds the named Phase 1 grays the renderers draw with, so mockups,
+// legends, flows and contact sheets share one look
+type Palette struct {
+	Border        color.RGBA // box and input borders, frames and dividers
+	Placeholder   color.RGBA // image and skeleton placeholders
+	TextSecondary color.RGBA // placeholder text, unknown types, arrows and legend labels
+	TextLabel     color.RGBA // thumbnail and screen captions
+	Surface       color.RGBA // legend strip background
+}
+
+// DefaultPalette returns the Phase 1 palette, built from its canonical hex values
+func DefaultPalette() Palette {
+	return Palette{
+		Border:        paletteColor("#E5E5E5"),
+		Placeholder:   paletteColor("#E5E5E5"),
+		TextSecondary: paletteColor("#737373"),
+		TextLabel:     paletteColor("#525252"),
+		Surface:       paletteColor("#FAFAFA"),
+	}
+}
+
+// palette is the palette every renderer draws with
+var palette = DefaultPalette()
+
+// paletteColor parses an opaque palette hex color, panicking on a malformed constant
+func paletteColor(hex string) color.RGBA {
+	c, ok := parseCSSColor(hex)
+	if !ok || c.A != 255 {
+		panic(fmt.Sprintf("render: invalid palette color %q", hex))
+	}
+	return color.RGBA{c.R, c.G, c.B, 255}
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden images in testdata/")
+
+// paletteStructure uses every gray the renderers draw with: borders, inputs,
+// image placeholders, unknown types, skeletons and secondary text
+func paletteStructure() *types.Structure {
+	return &types.Structure{
+		Version: "v1",
+		Components: []types.Component{
+			{ID: "header", Type: "box", Layout: types.ComponentLayout{Border: "1px solid #E5E5E5", Padding: 8, BorderBottom: "1px solid #E5E5E5"}, Children: []types.Component{
+				{ID: "title", Type: "text", Content: "Palette"},
+			}},
+			{ID: "email", Type: "input", Content: "you@example.com"},
+			{ID: "save", Type: "button", Content: "Save"},
+			{ID: "hero", Type: "image", Layout: types.ComponentLayout{Height: 60}},
+			{ID: "chart", Type: "chart", Layout: types.ComponentLayout{Height: 40}},
+			{ID: "feed", Type: "box", State: "loading", Layout: types.ComponentLayout{Border: "1px", Height: 60}, Skeleton: &types.SkeletonConfig{
+				Elements: []types.SkeletonElement{{Type: "circle", Size: 24}, {Type: "text", Width: "60%"}},
+			}},
+		},
+	}
+}
+
+// checkGolden compares an image with testdata/name, rewriting it with -update
+func checkGolden(t *testing.T, name string, img image.Image) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("missing golden image (run go test -update): %v", err)
+	}
+	defer f.Close()
+	want, err := png.Decode(f)
+	if err != nil {
+		t.Fatalf("decode %s: %v", path, err)
+	}
+
+	if want.Bounds() != img.Bounds() {
+		t.Fatalf("%s: size %v, golden %v", name, img.Bounds(), want.Bounds())
+	}
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			r1, g1, b1, a1 := img.At(x, y).RGBA()
+			r2, g2, b2, a2 := want.At(x, y).RGBA()
+			if r1 != r2 || g1 != g2 || b1 != b2 || a1 != a2 {
+				t.Fatalf("%s: pixel (%d, %d) is %v, golden %v", name, x, y, img.At(x, y), want.At(x, y))
+			}
+		}
+	}
+}
+
+func TestPalette_GoldenRender(t *testing.T) {
+	result, err := NewRenderer(RenderOptions{Width: 320, Height: 240, Annotations: true, TabOrder: true}).Render(paletteStructure())
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	checkGolden(t, "palette_render.png", result.Image)
+}
+
+func TestPalette_GoldenFlowAndContactSheet(t *testing.T) {
+	steps := []FlowStep{{Label: "Start", Structure: paletteStructure()}, {Label: "Next", Structure: paletteStructure()}}
+	flow, err := RenderFlow(steps, RenderOptions{Width: 160, Height: 200})
+	if err != nil {
+		t.Fatalf("RenderFlow failed: %v", err)
+	}
+	checkGolden(t, "palette_flow.png", flow.Image)
+
+	sheet := ComposeContactSheet([]ContactSheetEntry{
+		{Label: "v1", Image: flow.Screens[0].Image},
+		{Label: "v2", Image: flow.Screens[1].Image},
+	}, ContactSheetOptions{Columns: 2, ThumbWidth: 80})
+	checkGolden(t, "palette_contact_sheet.png", sheet)
+}
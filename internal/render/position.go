@@ -0,0 +1,58 @@
+package render
+
+import (
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// splitPositioned separates components that take part in their parent's flow from
+// absolutely positioned ones, keeping each group in document order
+func splitPositioned(components []types.Component) (flow, absolute []types.Component) {
+	for _, comp := range components {
+		if comp.IsAbsolute() {
+			absolute = append(absolute, comp)
+		} else {
+			flow = append(flow, comp)
+		}
+	}
+	return flow, absolute
+}
+
+// layoutAbsoluteChildren places absolutely positioned components against the parent
+// box. Left wins over right and top over bottom; with neither set the component
+// sits at the parent's corner on that axis.
+func (e *LayoutEngine) layoutAbsoluteChildren(children []types.Component, parentBox LayoutBox, boxes map[string]LayoutBox) error {
+	for _, child := range children {
+		// Like CSS, positioned text shrinks to its content instead of filling the parent
+		availWidth := parentBox.Width
+		if child.BaseType() == "text" {
+			availWidth = e.estimateTextWidth(&child)
+		}
+
+		childBox, err := e.calculateComponentLayout(&child, 0, 0, availWidth, parentBox.Height)
+		if err != nil {
+			return err
+		}
+
+		childBox.X = parentBox.X
+		if child.Layout.Left != nil {
+			childBox.X += *child.Layout.Left * e.scale
+		} else if child.Layout.Right != nil {
+			childBox.X += parentBox.Width - childBox.Width - *child.Layout.Right*e.scale
+		}
+
+		childBox.Y = parentBox.Y
+		if child.Layout.Top != nil {
+			childBox.Y += *child.Layout.Top * e.scale
+		} else if child.Layout.Bottom != nil {
+			childBox.Y += parentBox.Height - childBox.Height - *child.Layout.Bottom*e.scale
+		}
+
+		e.logf("'%s': absolute at (%d, %d) against parent box at (%d, %d)", child.ID, childBox.X, childBox.Y, parentBox.X, parentBox.Y)
+		boxes[child.ID] = childBox
+
+		if err := e.calculateChildrenLayout(&child, childBox, boxes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func offset(v int) *int {
+	return &v
+}
+
+func TestCalculateLayout_AbsoluteBadge(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "toolbar",
+				Type:   "box",
+				Layout: types.ComponentLayout{Display: "flex", Direction: "horizontal", Padding: 16},
+				Children: []types.Component{
+					{
+						ID:     "inbox",
+						Type:   "box",
+						Layout: types.ComponentLayout{Display: "flex", Width: 120, Height: 40},
+						Children: []types.Component{
+							{ID: "inbox-label", Type: "text", Content: "Inbox"},
+							{
+								ID:     "unread",
+								Type:   "box",
+								Layout: types.ComponentLayout{Position: "absolute", Top: offset(-8), Right: offset(-8), Width: 20, Height: 20},
+							},
+						},
+					},
+				},
+			},
+			{
+				ID:     "new-pill",
+				Type:   "box",
+				Layout: types.ComponentLayout{Position: "absolute", Bottom: offset(0), Left: offset(24), Width: 48, Height: 20},
+			},
+		},
+	}
+
+	boxes, err := NewLayoutEngine(1).CalculateLayout(structure, 800, 600)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	inbox := boxes["inbox"]
+	if inbox.X != 16 || inbox.Y != 16 {
+		t.Fatalf("Expected 'inbox' at (16, 16), got (%d, %d)", inbox.X, inbox.Y)
+	}
+
+	// Pinned over the top-right corner of its parent, overhanging by 8px
+	unread := boxes["unread"]
+	if unread.X != inbox.X+inbox.Width-20+8 || unread.Y != inbox.Y-8 {
+		t.Errorf("Expected 'unread' at (%d, %d), got (%d, %d)", inbox.X+inbox.Width-12, inbox.Y-8, unread.X, unread.Y)
+	}
+	if unread.Width != 20 || unread.Height != 20 {
+		t.Errorf("Expected a 20x20 badge, got %dx%d", unread.Width, unread.Height)
+	}
+
+	// The badge is out of flow: the label still starts at the parent's corner
+	if label := boxes["inbox-label"]; label.X != inbox.X || label.Y != inbox.Y {
+		t.Errorf("Expected 'inbox-label' at the parent's corner, got (%d, %d)", label.X, label.Y)
+	}
+
+	// Top-level absolute components are placed against the canvas
+	if pill := boxes["new-pill"]; pill.X != 24 || pill.Y != 600-20 {
+		t.Errorf("Expected 'new-pill' at (24, 580), got (%d, %d)", pill.X, pill.Y)
+	}
+	if toolbar := boxes["toolbar"]; toolbar.Y != 0 {
+		t.Errorf("Expected the absolute pill not to push 'toolbar' down, got Y %d", toolbar.Y)
+	}
+}
+
+func TestCalculateLayout_AbsoluteChildTakesNoRoom(t *testing.T) {
+	card := func(withBadge bool) *types.Structure {
+		children := []types.Component{
+			{ID: "title", Type: "text", Content: "Plan"},
+			{ID: "price", Type: "text", Content: "$9"},
+		}
+		if withBadge {
+			children = append(children, types.Component{
+				ID:      "popular",
+				Type:    "text",
+				Content: "Popular",
+				Layout:  types.ComponentLayout{Position: "absolute", Top: offset(8), Right: offset(8)},
+			})
+		}
+		return &types.Structure{
+			Components: []types.Component{
+				{ID: "card", Type: "card", Layout: types.ComponentLayout{Display: "flex", Padding: 16}, Children: children},
+			},
+		}
+	}
+
+	plain, err := NewLayoutEngine(1).CalculateLayout(card(false), 400, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	badged, err := NewLayoutEngine(1).CalculateLayout(card(true), 400, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	if plain["card"].Height != badged["card"].Height {
+		t.Errorf("Expected the badge not to change the card height, got %d vs %d", badged["card"].Height, plain["card"].Height)
+	}
+	cardBox, popular := badged["card"], badged["popular"]
+	if popular.Width >= cardBox.Width {
+		t.Errorf("Expected the badge text to shrink to its content, got width %d in a %dpx card", popular.Width, cardBox.Width)
+	}
+	if popular.X+popular.Width != cardBox.X+cardBox.Width-8 || popular.Y != cardBox.Y+8 {
+		t.Errorf("Expected the badge 8px inside the card's top-right corner, got (%d, %d) size %dx%d", popular.X, popular.Y, popular.Width, popular.Height)
+	}
+}
@@ -0,0 +1,163 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// defaultShadowColor is used when a shadow does not name a color
+var defaultShadowColor = color.NRGBA{0, 0, 0, 64}
+
+// Shadow is a parsed CSS box-shadow, in unscaled pixels
+type Shadow struct {
+	OffsetX int
+	OffsetY int
+	Blur    int
+	Spread  int
+	Color   color.NRGBA
+	Inset   bool
+}
+
+// ParseShadow parses the first shadow of a CSS box-shadow value such as
+// "0 4px 8px 0 rgba(0,0,0,0.12)", reporting false for "none" or unparsable values
+func ParseShadow(value string) (Shadow, bool) {
+	tokens := shadowTokens(firstShadow(strings.ToLower(strings.TrimSpace(value))))
+	if len(tokens) == 0 {
+		return Shadow{}, false
+	}
+
+	shadow := Shadow{Color: defaultShadowColor}
+	lengths := []int{}
+	for _, token := range tokens {
+		if token == "inset" {
+			shadow.Inset = true
+			continue
+		}
+		if n, err := strconv.ParseFloat(strings.TrimSuffix(token, "px"), 64); err == nil {
+			lengths = append(lengths, int(math.Round(n)))
+			continue
+		}
+		c, ok := ParseColor(token)
+		if !ok {
+			return Shadow{}, false
+		}
+		shadow.Color = c
+	}
+
+	// offset-x offset-y [blur [spread]]
+	if len(lengths) < 2 || len(lengths) > 4 {
+		return Shadow{}, false
+	}
+	shadow.OffsetX, shadow.OffsetY = lengths[0], lengths[1]
+	if len(lengths) > 2 {
+		shadow.Blur = max(lengths[2], 0)
+	}
+	if len(lengths) > 3 {
+		shadow.Spread = lengths[3]
+	}
+	return shadow, true
+}
+
+// firstShadow returns the first of a comma-separated list of shadows, ignoring
+// commas inside color functions
+func firstShadow(value string) string {
+	depth := 0
+	for i, r := range value {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				return value[:i]
+			}
+		}
+	}
+	return value
+}
+
+// shadowTokens splits a shadow on whitespace outside parentheses
+func shadowTokens(value string) []string {
+	tokens := []string{}
+	depth, start := 0, -1
+	for i, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case (r == ' ' || r == '\t') && depth == 0:
+			if start >= 0 {
+				tokens = append(tokens, value[start:i])
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, value[start:])
+	}
+	return tokens
+}
+
+// renderShadow draws a component's drop shadow beneath where its box will be drawn.
+// The blur is approximated with a linear falloff centered on the shadow's edge, and
+// the component's own box is left untouched, as CSS clips a shadow under its box.
+func (r *Renderer) renderShadow(ctx *renderContext, comp *types.Component, box LayoutBox) {
+	shadow, ok := ParseShadow(comp.Layout.Shadow)
+	if !ok || shadow.Inset || shadow.Color.A == 0 {
+		return
+	}
+
+	scale := ctx.scale
+	blur := float64(shadow.Blur * scale)
+	spread := shadow.Spread * scale
+	bounds := image.Rect(box.X, box.Y, box.X+box.Width, box.Y+box.Height)
+	shape := bounds.Add(image.Pt(shadow.OffsetX*scale, shadow.OffsetY*scale)).Inset(-spread)
+	if shape.Empty() {
+		return
+	}
+
+	// Full strength inside the shape inset by half the blur, fading out over the blur width
+	inner := [4]float64{
+		float64(shape.Min.X) + blur/2, float64(shape.Min.Y) + blur/2,
+		float64(shape.Max.X) - blur/2, float64(shape.Max.Y) - blur/2,
+	}
+	area := shape.Inset(-int(math.Ceil(blur / 2))).Intersect(ctx.img.Bounds())
+
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			if image.Pt(x, y).In(bounds) {
+				continue
+			}
+
+			px, py := float64(x)+0.5, float64(y)+0.5
+			dx := math.Max(math.Max(inner[0]-px, px-inner[2]), 0)
+			dy := math.Max(math.Max(inner[1]-py, py-inner[3]), 0)
+			strength := 1.0
+			if blur > 0 {
+				strength = math.Max(1-math.Hypot(dx, dy)/blur, 0)
+			} else if dx > 0 || dy > 0 {
+				strength = 0
+			}
+			if strength == 0 {
+				continue
+			}
+
+			src := shadow.Color
+			src.A = uint8(float64(src.A) * strength)
+			under := ctx.img.RGBAAt(x, y)
+			over := CompositeOver(src, color.NRGBA{under.R, under.G, under.B, 255})
+			ctx.img.SetRGBA(x, y, color.RGBA{over.R, over.G, over.B, 255})
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestParseShadow(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Shadow
+		ok    bool
+	}{
+		{"0 4px 8px 0 rgba(0,0,0,0.12)", Shadow{OffsetY: 4, Blur: 8, Color: color.NRGBA{0, 0, 0, 31}}, true},
+		{"2px 2px #FF0000", Shadow{OffsetX: 2, OffsetY: 2, Color: color.NRGBA{255, 0, 0, 255}}, true},
+		{"0 1px 2px rgba(0, 0, 0, 0.5), 0 8px 16px black", Shadow{OffsetY: 1, Blur: 2, Color: color.NRGBA{0, 0, 0, 128}}, true},
+		{"inset 0 2px 4px 1px", Shadow{OffsetY: 2, Blur: 4, Spread: 1, Color: defaultShadowColor, Inset: true}, true},
+		{"none", Shadow{}, false},
+		{"", Shadow{}, false},
+		{"0 4px glow", Shadow{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseShadow(tt.value)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("ParseShadow(%q) = %+v, %v; expected %+v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRender_ShadowOnlyInPhase2(t *testing.T) {
+	structure := &types.Structure{
+		Phase: "design",
+		Components: []types.Component{
+			{ID: "card", Type: "box", Layout: types.ComponentLayout{
+				Width: 100, Height: 50, Background: "#FFFFFF", Shadow: "0 8px 0 0 rgba(0,0,0,0.5)",
+			}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if got := result.Image.RGBAAt(50, 54); got.R > 140 || got.R < 120 {
+		t.Errorf("Expected a half-black shadow below the card, got %v", got)
+	}
+	if got := result.Image.RGBAAt(50, 25); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected the card itself to cover its shadow, got %v", got)
+	}
+	if got := result.Image.RGBAAt(50, 60); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected no shadow past the offset without blur, got %v", got)
+	}
+
+	structure.Phase = "structure"
+	result, err = NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if got := result.Image.RGBAAt(50, 54); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected no shadow in Phase 1, got %v", got)
+	}
+}
+
+func TestRender_ShadowBlurFadesOut(t *testing.T) {
+	structure := &types.Structure{
+		Phase: "design",
+		Components: []types.Component{
+			{ID: "card", Type: "box", Layout: types.ComponentLayout{
+				Width: 100, Height: 50, Shadow: "0 0 16px 0 #000000",
+			}},
+		},
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 200, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	near := result.Image.RGBAAt(50, 51)
+	far := result.Image.RGBAAt(50, 56)
+	if !(near.R < far.R && far.R < 255) {
+		t.Errorf("Expected the shadow to lighten away from the edge, got %v near and %v further out", near, far)
+	}
+	if got := result.Image.RGBAAt(50, 60); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected the shadow to end half a blur past the edge, got %v", got)
+	}
+}
@@ -0,0 +1,117 @@
+package render
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// renderSubtree renders only the component named by RenderOptions.Component and its
+// descendants. The whole page is laid out as for a full render, so the component
+// keeps the box it has on the page (including any content width), and the canvas
+// is cropped to that box.
+func (r *Renderer) renderSubtree(structure *types.Structure) (*RenderResult, error) {
+	comp := types.FindComponent(structure.Components, r.opts.Component)
+	if comp == nil {
+		return nil, fmt.Errorf("component '%s' not found", r.opts.Component)
+	}
+
+	width := r.opts.Width * r.opts.Scale
+	height := r.opts.Height * r.opts.Scale
+	if height == 0 {
+		height = r.calculateHeight(structure) * r.opts.Scale
+	}
+
+	layoutEngine := NewLayoutEngine(r.opts.Scale)
+	layoutEngine.SetLog(r.opts.Log)
+	layoutEngine.SetContentWidth(r.opts.ContentWidth)
+
+	pageBoxes, err := layoutEngine.CalculateLayout(structure, width, height)
+	if err != nil {
+		return nil, fmt.Errorf("layout calculation failed: %w", err)
+	}
+
+	box := pageBoxes[comp.ID]
+	if box.Width <= 0 || box.Height <= 0 {
+		return nil, fmt.Errorf("component '%s' has an empty layout box (%dx%d)", comp.ID, box.Width, box.Height)
+	}
+
+	// Shift the subtree's boxes so the component sits at the canvas origin; boxes
+	// outside the subtree are dropped, so the manifest lists only what was drawn
+	boxes := make(map[string]LayoutBox)
+	var offset func(c *types.Component)
+	offset = func(c *types.Component) {
+		if b, ok := pageBoxes[c.ID]; ok {
+			b.X -= box.X
+			b.Y -= box.Y
+			boxes[c.ID] = b
+		}
+		for i := range c.Children {
+			offset(&c.Children[i])
+		}
+	}
+	offset(comp)
+
+	canvasHeight := box.Height
+	if r.opts.Annotations {
+		canvasHeight += r.legendHeight(box.Width)
+	}
+
+	canvas, err := r.canvasBackground()
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill with the background the subtree sits on in the full page; transparent
+	// renders only fill when an ancestor painted one
+	backgrounds := types.LayerBackgrounds(structure, canvas, effectiveBackground)
+	background := canvas
+	if parent := types.FindParent(structure.Components, comp.ID); parent != nil {
+		background = backgrounds[parent.ID]
+	}
+	img := image.NewRGBA(image.Rect(0, 0, box.Width, canvasHeight))
+	if !r.opts.Transparent || background != canvas {
+		draw.Draw(img, img.Bounds(), &image.Uniform{background}, image.Point{}, draw.Src)
+	}
+
+	r.logf("subtree '%s' cropped to %dx%d at (%d,%d), %dx scale", comp.ID, box.Width, box.Height, box.X, box.Y, r.opts.Scale)
+
+	ctx := &renderContext{
+		img:         img,
+		scale:       r.opts.Scale,
+		boxes:       boxes,
+		backgrounds: backgrounds,
+		shadows:     structure.Phase == "design",
+	}
+
+	subtree := []types.Component{*comp}
+	if err := r.renderComponent(ctx, comp); err != nil {
+		return nil, fmt.Errorf("failed to render component %s: %w", comp.ID, err)
+	}
+
+	if r.opts.ShowFocus {
+		r.renderFocusRings(ctx, subtree)
+	}
+
+	if r.opts.TabOrder {
+		r.renderTabOrder(ctx, subtree)
+	}
+
+	if r.opts.Guides {
+		r.renderGuides(ctx, subtree, box.Height)
+	}
+
+	if r.opts.Annotations {
+		r.drawLegend(img, structure, box.Height)
+	}
+
+	return &RenderResult{
+		Image:    img,
+		Width:    box.Width,
+		Height:   canvasHeight,
+		Warnings: layoutEngine.Warnings(),
+		Boxes:    boxes,
+	}, nil
+}
@@ -0,0 +1,105 @@
+package render
+
+import (
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func subtreeStructure() *types.Structure {
+	return &types.Structure{
+		Version: "v1",
+		Layout:  types.Layout{Spacing: 24},
+		Components: []types.Component{
+			{ID: "header", Type: "text", Content: "Dashboard"},
+			{
+				ID:   "main",
+				Type: "box",
+				Children: []types.Component{
+					{
+						ID:     "metrics",
+						Type:   "card",
+						Layout: types.ComponentLayout{Width: 300, Height: 120, Background: "#000000"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRender_ComponentSubtree(t *testing.T) {
+	result, err := NewRenderer(RenderOptions{Width: 1200, Scale: 2, Component: "metrics"}).Render(subtreeStructure())
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// The canvas is cropped to the nested card's box
+	if result.Width != 600 || result.Height != 240 {
+		t.Errorf("Expected a 600x240 canvas, got %dx%d", result.Width, result.Height)
+	}
+	if bounds := result.Image.Bounds(); bounds.Dx() != 600 || bounds.Dy() != 240 {
+		t.Errorf("Expected 600x240 image bounds, got %v", bounds)
+	}
+	if got := result.Image.RGBAAt(0, 0); got != (color.RGBA{0, 0, 0, 255}) {
+		t.Errorf("Expected the card background at the origin, got %v", got)
+	}
+}
+
+func TestRender_ComponentSubtreeNotFound(t *testing.T) {
+	_, err := NewRenderer(RenderOptions{Component: "missing"}).Render(subtreeStructure())
+	if err == nil || !strings.Contains(err.Error(), "component 'missing' not found") {
+		t.Errorf("Expected a not-found error, got %v", err)
+	}
+}
+
+func TestRender_ComponentSubtreeInheritsBackground(t *testing.T) {
+	structure := subtreeStructure()
+	structure.Components[1].Layout.Background = "#525252"
+	structure.Components[1].Children[0].Layout.Background = ""
+
+	result, err := NewRenderer(RenderOptions{Width: 1200, Component: "metrics"}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	// The cropped card shows the ancestor's background, as it does on the page
+	if got := result.Image.RGBAAt(10, 10); got != (color.RGBA{82, 82, 82, 255}) {
+		t.Errorf("Expected the inherited #525252 background, got %v", got)
+	}
+}
+
+func TestRender_ComponentSubtreeMatchesPageBox(t *testing.T) {
+	structure := subtreeStructure()
+	structure.Components[1].Layout = types.ComponentLayout{Height: 200, Background: "#E5E5E5"}
+	opts := RenderOptions{Width: 1200, Scale: 2, ContentWidth: 600}
+
+	page, err := NewRenderer(opts).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	opts.Component = "main"
+	subtree, err := NewRenderer(opts).Render(structure)
+	if err != nil {
+		t.Fatalf("Subtree render failed: %v", err)
+	}
+
+	// The stretched box keeps its page width inside the content column
+	box := page.Boxes["main"]
+	if subtree.Width != box.Width || subtree.Height != box.Height {
+		t.Errorf("Expected the %dx%d page box, got %dx%d", box.Width, box.Height, subtree.Width, subtree.Height)
+	}
+	if got := subtree.Boxes["main"]; got.X != 0 || got.Y != 0 {
+		t.Errorf("Expected the component at the canvas origin, got (%d,%d)", got.X, got.Y)
+	}
+	if _, ok := subtree.Boxes["header"]; ok {
+		t.Error("Expected boxes outside the subtree to be dropped")
+	}
+
+	card := page.Boxes["metrics"]
+	x, y := card.X-box.X+4, card.Y-box.Y+4
+	if got, want := subtree.Image.RGBAAt(x, y), page.Image.RGBAAt(card.X+4, card.Y+4); got != want {
+		t.Errorf("Expected the cropped card to match the page render, got %v want %v", got, want)
+	}
+}
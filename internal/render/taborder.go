@@ -0,0 +1,110 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+
+	"github.com/johanbellander/prism/internal/types"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Tab order overlay geometry in unscaled pixels
+const (
+	tabBadgeRadius = 9 // radius of a numbered badge
+	tabArrowLength = 8 // length of each arrowhead stroke
+)
+
+// renderTabOrder numbers the interactive components in keyboard focus order and
+// connects consecutive badges with arrows, so the keyboard journey can be reviewed
+func (r *Renderer) renderTabOrder(ctx *renderContext, components []types.Component) {
+	lineColor := palette.TextSecondary
+	radius := tabBadgeRadius * ctx.scale
+
+	// Badges sit inside the top-left corner of each component
+	var centers []image.Point
+	for _, comp := range types.FocusOrder(components) {
+		if box, ok := ctx.boxes[comp.ID]; ok {
+			centers = append(centers, image.Pt(box.X+radius, box.Y+radius))
+		}
+	}
+	r.logf("tab order: %d focusable components", len(centers))
+
+	// Arrows first so the badges stay readable on top of them
+	for i := 1; i < len(centers); i++ {
+		r.drawArrow(ctx.img, centers[i-1], centers[i], radius, ctx.scale, lineColor)
+	}
+	for i, center := range centers {
+		r.drawBadge(ctx.img, center, radius, strconv.Itoa(i+1))
+	}
+}
+
+// drawArrow draws a line between two badge centers, stopping at the badge edges,
+// with an arrowhead at the destination
+func (r *Renderer) drawArrow(img *image.RGBA, from, to image.Point, radius, scale int, col color.Color) {
+	dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+	length := math.Hypot(dx, dy)
+	if length <= float64(radius*2) {
+		return
+	}
+	ux, uy := dx/length, dy/length
+
+	startX, startY := float64(from.X)+ux*float64(radius), float64(from.Y)+uy*float64(radius)
+	endX, endY := float64(to.X)-ux*float64(radius), float64(to.Y)-uy*float64(radius)
+	r.drawLine(img, startX, startY, endX, endY, scale, col)
+
+	// Two strokes 30 degrees either side of the reversed direction
+	head := float64(tabArrowLength * scale)
+	for _, angle := range []float64{math.Pi / 6, -math.Pi / 6} {
+		sin, cos := math.Sincos(angle)
+		hx := -(ux*cos - uy*sin) * head
+		hy := -(ux*sin + uy*cos) * head
+		r.drawLine(img, endX, endY, endX+hx, endY+hy, scale, col)
+	}
+}
+
+// drawLine draws a straight line of the given thickness by stepping along its length
+func (r *Renderer) drawLine(img *image.RGBA, x0, y0, x1, y1 float64, thickness int, col color.Color) {
+	steps := int(math.Max(math.Abs(x1-x0), math.Abs(y1-y0)))
+	for i := 0; i <= steps; i++ {
+		t := 0.0
+		if steps > 0 {
+			t = float64(i) / float64(steps)
+		}
+		x := int(math.Round(x0 + (x1-x0)*t))
+		y := int(math.Round(y0 + (y1-y0)*t))
+		for ox := 0; ox < thickness; ox++ {
+			for oy := 0; oy < thickness; oy++ {
+				img.Set(x+ox, y+oy, col)
+			}
+		}
+	}
+}
+
+// drawBadge draws a black disc with a white rim and a centered white label
+func (r *Renderer) drawBadge(img *image.RGBA, center image.Point, radius int, label string) {
+	rim := radius + 1
+	for y := -rim; y <= rim; y++ {
+		for x := -rim; x <= rim; x++ {
+			distance := x*x + y*y
+			switch {
+			case distance <= radius*radius:
+				img.Set(center.X+x, center.Y+y, color.Black)
+			case distance <= rim*rim:
+				img.Set(center.X+x, center.Y+y, color.White)
+			}
+		}
+	}
+
+	// 7x13 glyphs, baseline roughly 4px below the vertical center
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(color.White),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(center.X-len(label)*7/2, center.Y+4),
+	}
+	d.DrawString(label)
+}
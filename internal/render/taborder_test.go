@@ -0,0 +1,48 @@
+package render
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestRender_TabOrderOverlay(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "email", Type: "input"},
+			{ID: "password", Type: "input"},
+			{ID: "skip", Type: "input", TabIndex: -1},
+		},
+	}
+
+	plain, err := NewRenderer(RenderOptions{Width: 400, Height: 200}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	if got := plain.Image.RGBAAt(9, 9); got != (color.RGBA{255, 255, 255, 255}) {
+		t.Errorf("Expected no badge without --tab-order, got %v", got)
+	}
+
+	result, err := NewRenderer(RenderOptions{Width: 400, Height: 200, TabOrder: true}).Render(structure)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+
+	black := color.RGBA{0, 0, 0, 255}
+	// Badges in the top-left corner of each focusable input (inputs are 40px tall)
+	if got := result.Image.RGBAAt(9-5, 9); got != black {
+		t.Errorf("Expected a badge on 'email', got %v", got)
+	}
+	if got := result.Image.RGBAAt(9-5, 40+9); got != black {
+		t.Errorf("Expected a badge on 'password', got %v", got)
+	}
+	// The arrow runs between them
+	if got := result.Image.RGBAAt(9, 29); got != (color.RGBA{115, 115, 115, 255}) {
+		t.Errorf("Expected an arrow between the badges, got %v", got)
+	}
+	// tabindex -1 is not in the focus order
+	if got := result.Image.RGBAAt(9-5, 80+9); got == black {
+		t.Error("Expected no badge on a component with tabindex -1")
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+// DefaultBackground is the page background behind top-level components
+const DefaultBackground = "#FFFFFF"
+
+// ComputeEffectiveBackgrounds returns the background each component is drawn on, by
+// component ID: its own layout.background when declared, otherwise the nearest
+// ancestor's, otherwise DefaultBackground. Values are the declared strings, so
+// translucent colors are not blended with what lies beneath them.
+func ComputeEffectiveBackgrounds(structure *Structure) map[string]string {
+	return LayerBackgrounds(structure, DefaultBackground, func(background, _ string) string {
+		return background
+	})
+}
+
+// LayerBackgrounds applies the same inheritance as ComputeEffectiveBackgrounds, but
+// lets the caller decide how a declared background combines with the one behind it:
+// layer receives the declared value and the parent's result, starting from base.
+// The renderer uses it to composite translucent backgrounds into opaque colors.
+func LayerBackgrounds[T any](structure *Structure, base T, layer func(background string, behind T) T) map[string]T {
+	backgrounds := map[string]T{}
+
+	var walk func(components []Component, inherited T)
+	walk = func(components []Component, inherited T) {
+		for i := range components {
+			comp := &components[i]
+			background := inherited
+			if comp.Layout.Background != "" {
+				background = layer(comp.Layout.Background, inherited)
+			}
+			backgrounds[comp.ID] = background
+			walk(comp.Children, background)
+		}
+	}
+	walk(structure.Components, base)
+
+	return backgrounds
+}
@@ -0,0 +1,93 @@
+package types
+
+import "testing"
+
+func TestComputeEffectiveBackgrounds(t *testing.T) {
+	structure := &Structure{
+		Components: []Component{
+			{
+				ID:     "page",
+				Type:   "box",
+				Layout: ComponentLayout{Background: "#E5E5E5"},
+				Children: []Component{
+					{
+						ID:   "section",
+						Type: "box",
+						Children: []Component{
+							{
+								ID:   "group",
+								Type: "box",
+								Children: []Component{
+									{ID: "caption", Type: "text"},
+								},
+							},
+							{
+								ID:       "banner",
+								Type:     "box",
+								Layout:   ComponentLayout{Background: "#000000"},
+								Children: []Component{{ID: "banner-text", Type: "text"}},
+							},
+						},
+					},
+				},
+			},
+			{ID: "footer", Type: "text"},
+		},
+	}
+
+	backgrounds := ComputeEffectiveBackgrounds(structure)
+
+	expected := map[string]string{
+		"page":        "#E5E5E5",
+		"section":     "#E5E5E5", // inherited one level down
+		"group":       "#E5E5E5",
+		"caption":     "#E5E5E5", // inherited through three levels
+		"banner":      "#000000", // its own background wins
+		"banner-text": "#000000",
+		"footer":      DefaultBackground,
+	}
+	if len(backgrounds) != len(expected) {
+		t.Errorf("Expected %d entries, got %d: %v", len(expected), len(backgrounds), backgrounds)
+	}
+	for id, want := range expected {
+		if got := backgrounds[id]; got != want {
+			t.Errorf("Background of '%s' = %q, expected %q", id, got, want)
+		}
+	}
+}
+
+func TestLayerBackgrounds(t *testing.T) {
+	structure := &Structure{
+		Components: []Component{
+			{
+				ID:     "panel",
+				Type:   "box",
+				Layout: ComponentLayout{Background: "#E5E5E5"},
+				Children: []Component{
+					{
+						ID:       "overlay",
+						Type:     "box",
+						Layout:   ComponentLayout{Background: "#00000080"},
+						Children: []Component{{ID: "label", Type: "text"}},
+					},
+				},
+			},
+		},
+	}
+
+	// Record the stack of declared backgrounds so layering order is visible
+	layers := LayerBackgrounds(structure, "page", func(background, behind string) string {
+		return behind + " > " + background
+	})
+
+	expected := map[string]string{
+		"panel":   "page > #E5E5E5",
+		"overlay": "page > #E5E5E5 > #00000080",
+		"label":   "page > #E5E5E5 > #00000080", // inherits without layering again
+	}
+	for id, want := range expected {
+		if got := layers[id]; got != want {
+			t.Errorf("Layers of '%s' = %q, expected %q", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// checksumPrefix identifies the hash algorithm in stored checksums
+const checksumPrefix = "sha256:"
+
+// ComputeChecksum returns a stable "sha256:<hex>" digest of the structure.
+// The Checksum field itself is excluded so the result can be stored in it;
+// every other field, including the lock metadata, is covered.
+func ComputeChecksum(s *Structure) string {
+	canonical := *s
+	canonical.Checksum = ""
+
+	// Struct fields marshal in declaration order and map keys are sorted,
+	// so the encoding is stable for equal structures
+	data, err := json.Marshal(&canonical)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(data)
+	return checksumPrefix + hex.EncodeToString(sum[:])
+}
+
+// VerifyChecksum checks that a locked structure still matches its stored checksum.
+// Unlocked structures always verify. A locked structure without a checksum
+// returns a missing_checksum error; one that was edited returns checksum_mismatch.
+func (s *Structure) VerifyChecksum() error {
+	if !s.Locked {
+		return nil
+	}
+	if s.Checksum == "" {
+		return newValidationError(ErrCodeMissingChecksum, "", "checksum", "locked structure %s has no checksum to verify", s.Version)
+	}
+	if actual := ComputeChecksum(s); actual != s.Checksum {
+		return newValidationError(ErrCodeChecksumMismatch, "", "checksum", "locked structure %s was modified after approval (checksum %s, expected %s)", s.Version, actual, s.Checksum)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newLockedStructure() *Structure {
+	return &Structure{
+		Version:    "v2",
+		Phase:      "structure",
+		Locked:     true,
+		ApprovedBy: "user",
+		Intent:     Intent{Purpose: "Dashboard"},
+		Layout:     Layout{Type: "stack"},
+		Components: []Component{{ID: "title", Type: "text", Content: "Overview"}},
+	}
+}
+
+func TestComputeChecksum(t *testing.T) {
+	s := newLockedStructure()
+	checksum := ComputeChecksum(s)
+
+	if !strings.HasPrefix(checksum, "sha256:") || len(checksum) != len("sha256:")+64 {
+		t.Fatalf("Unexpected checksum format: %q", checksum)
+	}
+	if again := ComputeChecksum(newLockedStructure()); again != checksum {
+		t.Errorf("Expected equal structures to hash equally, got %s and %s", checksum, again)
+	}
+
+	// Storing the checksum does not change it
+	s.Checksum = checksum
+	if got := ComputeChecksum(s); got != checksum {
+		t.Errorf("Expected the Checksum field to be excluded, got %s", got)
+	}
+
+	s.Components[0].Content = "Changed"
+	if got := ComputeChecksum(s); got == checksum {
+		t.Error("Expected a content change to change the checksum")
+	}
+}
+
+func TestVerifyChecksum(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		s := newLockedStructure()
+		s.Checksum = ComputeChecksum(s)
+		if err := s.VerifyChecksum(); err != nil {
+			t.Errorf("Expected checksum to verify, got %v", err)
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		s := newLockedStructure()
+		s.Checksum = ComputeChecksum(s)
+		s.Components = append(s.Components, Component{ID: "extra", Type: "box"})
+
+		var vErr *ValidationError
+		if err := s.VerifyChecksum(); !errors.As(err, &vErr) || vErr.Code != ErrCodeChecksumMismatch {
+			t.Errorf("Expected checksum_mismatch, got %v", err)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		var vErr *ValidationError
+		if err := newLockedStructure().VerifyChecksum(); !errors.As(err, &vErr) || vErr.Code != ErrCodeMissingChecksum {
+			t.Errorf("Expected missing_checksum, got %v", err)
+		}
+	})
+
+	t.Run("unlocked", func(t *testing.T) {
+		s := newLockedStructure()
+		s.Locked = false
+		s.Checksum = "sha256:stale"
+		if err := s.VerifyChecksum(); err != nil {
+			t.Errorf("Expected unlocked structures to skip verification, got %v", err)
+		}
+	})
+}
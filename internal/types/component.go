@@ -0,0 +1,234 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// componentBaseTypes maps every accepted component type to the primitive it renders as
+// ("box", "text", "input", "button" or "image")
+var componentBaseTypes = map[string]string{
+	"box":       "box",
+	"text":      "text",
+	"input":     "input",
+	"button":    "button",
+	"image":     "image",
+	"container": "box",
+	"card":      "box",
+	"list":      "box",
+	"nav":       "box",
+	"link":      "text",
+	"select":    "input",
+	"checkbox":  "input",
+	"radio":     "input",
+}
+
+// textWeights lists the accepted font weights; the bold ones render double-struck
+var textWeights = map[string]bool{
+	"normal":   false,
+	"medium":   false,
+	"semibold": true,
+	"bold":     true,
+}
+
+// ValidTextWeights returns the accepted font weights in sorted order
+func ValidTextWeights() []string {
+	names := make([]string, 0, len(textWeights))
+	for name := range textWeights {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsBold reports whether the component's weight is semibold or heavier
+func (c *Component) IsBold() bool {
+	return textWeights[c.Weight]
+}
+
+// textAligns lists the accepted horizontal text alignments within a component's box
+var textAligns = map[string]bool{
+	"left":   true,
+	"center": true,
+	"right":  true,
+}
+
+// ValidTextAligns returns the accepted text alignments in sorted order
+func ValidTextAligns() []string {
+	names := make([]string, 0, len(textAligns))
+	for name := range textAligns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// inputTypes lists the accepted input kinds, each drawn with its own control
+var inputTypes = map[string]bool{
+	"text":     true,
+	"checkbox": true,
+	"radio":    true,
+	"select":   true,
+	"textarea": true,
+}
+
+// ValidInputTypes returns the accepted input kinds in sorted order
+func ValidInputTypes() []string {
+	names := make([]string, 0, len(inputTypes))
+	for name := range inputTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// InputKind returns the kind of input the component is drawn as: its input_type,
+// else the checkbox, radio or select component type, else "text"
+func (c *Component) InputKind() string {
+	if c.InputType != "" {
+		return c.InputType
+	}
+	if inputTypes[c.Type] {
+		return c.Type
+	}
+	return "text"
+}
+
+// ValidComponentTypes returns the accepted component types in sorted order
+func ValidComponentTypes() []string {
+	names := make([]string, 0, len(componentBaseTypes))
+	for name := range componentBaseTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// BaseType returns the primitive type the component renders as, or its own type if unknown
+func (c *Component) BaseType() string {
+	if base, ok := componentBaseTypes[c.Type]; ok {
+		return base
+	}
+	return c.Type
+}
+
+// IsAbsolute reports whether the component is positioned against its parent box
+// instead of taking part in the parent's flow
+func (c *Component) IsAbsolute() bool {
+	return c.Layout.Position == "absolute"
+}
+
+// FindComponent returns the component with the given ID anywhere in the tree
+// (depth-first), or nil if there is none
+func FindComponent(components []Component, id string) *Component {
+	for i := range components {
+		if components[i].ID == id {
+			return &components[i]
+		}
+		if found := FindComponent(components[i].Children, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// FindParent returns the component whose direct child has the given ID, or nil when
+// the ID belongs to a top-level component or is not in the tree
+func FindParent(components []Component, id string) *Component {
+	for i := range components {
+		for _, child := range components[i].Children {
+			if child.ID == id {
+				return &components[i]
+			}
+		}
+		if found := FindParent(components[i].Children, id); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// FocusOrder returns the focusable components in keyboard order: positive tabindex
+// values first in ascending order, then tabindex 0 in document order. Non-interactive
+// components and those with a negative tabindex are skipped.
+func FocusOrder(components []Component) []*Component {
+	var explicit, natural []*Component
+	var walk func(components []Component)
+	walk = func(components []Component) {
+		for i := range components {
+			comp := &components[i]
+			if comp.IsInteractive() {
+				if comp.TabIndex > 0 {
+					explicit = append(explicit, comp)
+				} else if comp.TabIndex == 0 {
+					natural = append(natural, comp)
+				}
+			}
+			walk(comp.Children)
+		}
+	}
+	walk(components)
+
+	sort.SliceStable(explicit, func(i, j int) bool {
+		return explicit[i].TabIndex < explicit[j].TabIndex
+	})
+	return append(explicit, natural...)
+}
+
+// IsInteractive reports whether the component receives user interaction (and keyboard focus)
+func (c *Component) IsInteractive() bool {
+	interactiveTypes := map[string]bool{
+		"button":   true,
+		"input":    true,
+		"link":     true,
+		"select":   true,
+		"checkbox": true,
+		"radio":    true,
+	}
+
+	return interactiveTypes[c.Type]
+}
+
+// IsDestructive reports whether the component represents a dangerous/destructive action
+func (c *Component) IsDestructive() bool {
+	idLower := strings.ToLower(c.ID)
+	roleLower := strings.ToLower(c.Role)
+
+	dangerousKeywords := []string{"delete", "remove", "destroy", "clear", "reset", "cancel"}
+
+	for _, keyword := range dangerousKeywords {
+		if strings.Contains(idLower, keyword) || strings.Contains(roleLower, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HeadingLevel extracts the heading level (1-6) from the component ID or size, or 0 if not a heading
+func (c *Component) HeadingLevel() int {
+	// Check ID for explicit heading level (h1, h2, h3, etc.)
+	idLower := strings.ToLower(c.ID)
+	if strings.HasPrefix(idLower, "h") && len(idLower) >= 2 {
+		if idLower[1] >= '1' && idLower[1] <= '6' {
+			return int(idLower[1] - '0')
+		}
+	}
+
+	// Check for heading in ID or role
+	if strings.Contains(idLower, "heading") || strings.Contains(idLower, "title") {
+		// Infer level from size
+		sizeMap := map[string]int{
+			"4xl": 1,
+			"3xl": 2,
+			"2xl": 3,
+			"xl":  4,
+		}
+
+		if level, ok := sizeMap[c.Size]; ok {
+			return level
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,204 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComponent_HeadingLevel(t *testing.T) {
+	tests := []struct {
+		comp     Component
+		expected int
+	}{
+		{Component{ID: "h1", Type: "text"}, 1},
+		{Component{ID: "h2", Type: "text"}, 2},
+		{Component{ID: "h3", Type: "text"}, 3},
+		{Component{ID: "title", Type: "text", Size: "4xl"}, 1},
+		{Component{ID: "heading", Type: "text", Size: "3xl"}, 2},
+		{Component{ID: "normal-text", Type: "text", Size: "base"}, 0},
+	}
+
+	for _, test := range tests {
+		result := test.comp.HeadingLevel()
+		if result != test.expected {
+			t.Errorf("HeadingLevel(%s, %s) = %d, expected %d",
+				test.comp.ID, test.comp.Size, result, test.expected)
+		}
+	}
+}
+
+func TestComponent_IsInteractive(t *testing.T) {
+	tests := []struct {
+		compType string
+		expected bool
+	}{
+		{"button", true},
+		{"input", true},
+		{"text", false},
+		{"box", false},
+		{"image", false},
+		{"link", true},
+		{"select", true},
+		{"card", false},
+	}
+
+	for _, test := range tests {
+		comp := &Component{
+			ID:   "test",
+			Type: test.compType,
+		}
+
+		result := comp.IsInteractive()
+		if result != test.expected {
+			t.Errorf("IsInteractive(%s) = %v, expected %v", test.compType, result, test.expected)
+		}
+	}
+}
+
+func TestComponent_IsDestructive(t *testing.T) {
+	tests := []struct {
+		id       string
+		role     string
+		expected bool
+	}{
+		{"delete-btn", "", true},
+		{"remove-item", "", true},
+		{"cancel-action", "", true},
+		{"submit-form", "", false},
+		{"save-btn", "", false},
+		{"", "delete", true},
+		{"", "primary", false},
+	}
+
+	for _, test := range tests {
+		comp := &Component{
+			ID:   test.id,
+			Role: test.role,
+			Type: "button",
+		}
+
+		result := comp.IsDestructive()
+		if result != test.expected {
+			t.Errorf("IsDestructive(%s, %s) = %v, expected %v", test.id, test.role, result, test.expected)
+		}
+	}
+}
+
+func TestComponent_BaseType(t *testing.T) {
+	tests := []struct {
+		typ      string
+		expected string
+	}{
+		{"box", "box"},
+		{"text", "text"},
+		{"card", "box"},
+		{"container", "box"},
+		{"list", "box"},
+		{"nav", "box"},
+		{"link", "text"},
+		{"select", "input"},
+		{"checkbox", "input"},
+		{"unknown-widget", "unknown-widget"},
+	}
+
+	for _, test := range tests {
+		c := Component{ID: "c", Type: test.typ}
+		if got := c.BaseType(); got != test.expected {
+			t.Errorf("BaseType(%s) = %s, expected %s", test.typ, got, test.expected)
+		}
+	}
+}
+
+func TestComponent_InputKind(t *testing.T) {
+	tests := []struct {
+		typ       string
+		inputType string
+		expected  string
+	}{
+		{"input", "", "text"},
+		{"input", "textarea", "textarea"},
+		{"checkbox", "", "checkbox"},
+		{"radio", "", "radio"},
+		{"select", "", "select"},
+		{"checkbox", "radio", "radio"},
+	}
+
+	for _, test := range tests {
+		c := Component{ID: "c", Type: test.typ, InputType: test.inputType}
+		if got := c.InputKind(); got != test.expected {
+			t.Errorf("InputKind(%s, %q) = %s, expected %s", test.typ, test.inputType, got, test.expected)
+		}
+	}
+}
+
+func TestFindComponent_Nested(t *testing.T) {
+	components := []Component{
+		{ID: "header", Type: "box"},
+		{
+			ID:   "main",
+			Type: "box",
+			Children: []Component{
+				{ID: "metrics", Type: "card", Children: []Component{{ID: "metric-value", Type: "text"}}},
+			},
+		},
+	}
+
+	if found := FindComponent(components, "metric-value"); found == nil || found.Type != "text" {
+		t.Errorf("Expected to find nested 'metric-value', got %+v", found)
+	}
+	if found := FindComponent(components, "header"); found == nil || found.ID != "header" {
+		t.Errorf("Expected to find top-level 'header', got %+v", found)
+	}
+	if found := FindComponent(components, "missing"); found != nil {
+		t.Errorf("Expected nil for unknown ID, got %+v", found)
+	}
+}
+
+func TestFindParent(t *testing.T) {
+	components := []Component{
+		{ID: "header", Type: "box"},
+		{
+			ID:   "main",
+			Type: "box",
+			Children: []Component{
+				{ID: "metrics", Type: "card", Children: []Component{{ID: "metric-value", Type: "text"}}},
+			},
+		},
+	}
+
+	if parent := FindParent(components, "metric-value"); parent == nil || parent.ID != "metrics" {
+		t.Errorf("Expected 'metrics' as the parent of 'metric-value', got %+v", parent)
+	}
+	if parent := FindParent(components, "metrics"); parent == nil || parent.ID != "main" {
+		t.Errorf("Expected 'main' as the parent of 'metrics', got %+v", parent)
+	}
+	if parent := FindParent(components, "header"); parent != nil {
+		t.Errorf("Expected no parent for a top-level component, got %+v", parent)
+	}
+}
+
+func TestFocusOrder(t *testing.T) {
+	components := []Component{
+		{ID: "search", Type: "input"},
+		{
+			ID:   "form",
+			Type: "box",
+			Children: []Component{
+				{ID: "email", Type: "input", TabIndex: 2},
+				{ID: "skip", Type: "link", TabIndex: -1},
+				{ID: "name", Type: "input", TabIndex: 1},
+				{ID: "hint", Type: "text"},
+			},
+		},
+		{ID: "submit", Type: "button"},
+	}
+
+	var ids []string
+	for _, comp := range FocusOrder(components) {
+		ids = append(ids, comp.ID)
+	}
+	expected := []string{"name", "email", "search", "submit"}
+	if strings.Join(ids, ",") != strings.Join(expected, ",") {
+		t.Errorf("FocusOrder = %v, expected %v", ids, expected)
+	}
+}
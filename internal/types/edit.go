@@ -0,0 +1,188 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalEdited encodes a structure that was parsed from source and then changed
+// (by fix or approve) for writing back to disk. Ref nodes are kept as written (see
+// RestoreRefs), and fields the author never wrote are left out while they are still
+// empty, so the file only gains the values that were actually changed. Dropped
+// fields decode back to the same zero values, so checksums are unaffected.
+func MarshalEdited(source []byte, structure *Structure) ([]byte, error) {
+	if err := RestoreRefs(source, structure); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(structure)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode structure: %w", err)
+	}
+
+	written, err := parseJSONNode(source)
+	if err != nil {
+		written = nil // nothing to compare against; keep every field
+	}
+	edited, err := parseJSONNode(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode structure: %w", err)
+	}
+	if written != nil {
+		edited.prune(written)
+	}
+
+	var compact, indented bytes.Buffer
+	edited.encode(&compact)
+	if err := json.Indent(&indented, compact.Bytes(), "", "  "); err != nil {
+		return nil, fmt.Errorf("failed to encode structure: %w", err)
+	}
+	return indented.Bytes(), nil
+}
+
+// jsonNode is a decoded JSON value that keeps object keys in document order
+type jsonNode struct {
+	keys   []string    // object keys, in order
+	fields []*jsonNode // object values, parallel to keys
+	items  []*jsonNode // array elements
+	scalar []byte      // encoded string, number, bool or null
+	object bool
+	array  bool
+}
+
+// parseJSONNode decodes a single JSON document
+func parseJSONNode(data []byte) (*jsonNode, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // keep numbers exactly as written
+	return decodeJSONNode(decoder)
+}
+
+func decodeJSONNode(decoder *json.Decoder) (*jsonNode, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token {
+	case json.Delim('{'):
+		node := &jsonNode{object: true}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSONNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.keys = append(node.keys, key.(string))
+			node.fields = append(node.fields, value)
+		}
+		_, err := decoder.Token() // '}'
+		return node, err
+	case json.Delim('['):
+		node := &jsonNode{array: true}
+		for decoder.More() {
+			item, err := decodeJSONNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node.items = append(node.items, item)
+		}
+		_, err := decoder.Token() // ']'
+		return node, err
+	}
+
+	scalar, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	return &jsonNode{scalar: scalar}, nil
+}
+
+// field returns the value of an object key, or nil
+func (n *jsonNode) field(key string) *jsonNode {
+	if n == nil || !n.object {
+		return nil
+	}
+	for i, k := range n.keys {
+		if k == key {
+			return n.fields[i]
+		}
+	}
+	return nil
+}
+
+// prune drops the object keys that written (the same place in the source) does not
+// have and whose values are empty. Arrays are matched by index and never shortened.
+func (n *jsonNode) prune(written *jsonNode) {
+	switch {
+	case n.object:
+		keys, fields := n.keys[:0], n.fields[:0]
+		for i, key := range n.keys {
+			source := written.field(key)
+			value := n.fields[i]
+			value.prune(source)
+			if source == nil && value.empty() {
+				continue
+			}
+			keys = append(keys, key)
+			fields = append(fields, value)
+		}
+		n.keys, n.fields = keys, fields
+	case n.array:
+		for i, item := range n.items {
+			var source *jsonNode
+			if written != nil && written.array && i < len(written.items) {
+				source = written.items[i]
+			}
+			item.prune(source)
+		}
+	}
+}
+
+// empty reports whether a value encodes a zero value that decodes back unchanged
+// when its key is left out. Empty arrays are kept, since [] and null differ.
+func (n *jsonNode) empty() bool {
+	switch {
+	case n.object:
+		return len(n.keys) == 0
+	case n.array:
+		return false
+	}
+	switch string(n.scalar) {
+	case `""`, `0`, `false`, `null`:
+		return true
+	}
+	return false
+}
+
+// encode writes the value as compact JSON
+func (n *jsonNode) encode(buf *bytes.Buffer) {
+	switch {
+	case n.object:
+		buf.WriteByte('{')
+		for i, key := range n.keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			encodedKey, _ := json.Marshal(key)
+			buf.Write(encodedKey)
+			buf.WriteByte(':')
+			n.fields[i].encode(buf)
+		}
+		buf.WriteByte('}')
+	case n.array:
+		buf.WriteByte('[')
+		for i, item := range n.items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			item.encode(buf)
+		}
+		buf.WriteByte(']')
+	default:
+		buf.Write(n.scalar)
+	}
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarshalEdited_LeavesOutUnwrittenFields(t *testing.T) {
+	source := []byte(`{
+		"version": "v1",
+		"phase": "structure",
+		"intent": {"purpose": "Settings"},
+		"layout": {"type": "stack", "spacing": 0},
+		"components": [
+			{"id": "title", "type": "text", "content": "Settings", "color": "#737373"}
+		]
+	}`)
+	s, err := ParseAndValidateStructure(source)
+	if err != nil {
+		t.Fatalf("ParseAndValidateStructure failed: %v", err)
+	}
+	s.Components[0].Color = "#525252"
+	s.Layout.Padding = 16
+
+	data, err := MarshalEdited(source, s)
+	if err != nil {
+		t.Fatalf("MarshalEdited failed: %v", err)
+	}
+	out := string(data)
+
+	for _, unwritten := range []string{`"role"`, `"key_interactions"`, `"direction"`, `"max_width"`, `"locked"`, `"responsive"`} {
+		if strings.Contains(out, unwritten) {
+			t.Errorf("Expected %s to stay out of the file:\n%s", unwritten, out)
+		}
+	}
+	for _, kept := range []string{`"spacing": 0`, `"padding": 16`, `"color": "#525252"`} {
+		if !strings.Contains(out, kept) {
+			t.Errorf("Expected %s in the file:\n%s", kept, out)
+		}
+	}
+	if strings.Index(out, `"version"`) > strings.Index(out, `"phase"`) {
+		t.Errorf("Expected the schema's field order to be kept:\n%s", out)
+	}
+
+	// The file reads back as the same structure, so a stored checksum still holds
+	reread, err := ParseStructure(data)
+	if err != nil {
+		t.Fatalf("ParseStructure failed: %v", err)
+	}
+	if ComputeChecksum(reread) != ComputeChecksum(s) {
+		t.Error("Expected the written file to keep the structure's checksum")
+	}
+}
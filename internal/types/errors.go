@@ -0,0 +1,46 @@
+package types
+
+import "fmt"
+
+// Validation error codes returned in ValidationError.Code
+const (
+	ErrCodeInvalidPhase      = "invalid_phase"
+	ErrCodeMissingField      = "missing_field"
+	ErrCodeInvalidLayoutType = "invalid_layout_type"
+	ErrCodeNoComponents      = "no_components"
+	ErrCodeMaxNestingDepth   = "max_nesting_depth"
+	ErrCodeInvalidType       = "invalid_type"
+	ErrCodeInvalidColor      = "invalid_color"
+	ErrCodeInvalidWeight     = "invalid_weight"
+	ErrCodeInvalidInputType  = "invalid_input_type"
+	ErrCodeInvalidTextAlign  = "invalid_text_align"
+	ErrCodeShadowNotAllowed  = "shadow_not_allowed"
+	ErrCodeChecksumMismatch  = "checksum_mismatch"
+	ErrCodeMissingChecksum   = "missing_checksum"
+	ErrCodeUnknownRef        = "unknown_ref"
+	ErrCodeCircularRef       = "circular_ref"
+)
+
+// ValidationError describes a structure validation failure in a form tools can branch on.
+// Callers can recover it from wrapped errors with errors.As.
+type ValidationError struct {
+	Code        string `json:"code"`
+	ComponentID string `json:"component_id,omitempty"`
+	Field       string `json:"field,omitempty"`
+	Message     string `json:"message"`
+}
+
+// Error returns the human-readable message
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// newValidationError creates a ValidationError with a formatted message
+func newValidationError(code, componentID, field, format string, args ...interface{}) *ValidationError {
+	return &ValidationError{
+		Code:        code,
+		ComponentID: componentID,
+		Field:       field,
+		Message:     fmt.Sprintf(format, args...),
+	}
+}
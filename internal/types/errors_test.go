@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidationError_NestedColor(t *testing.T) {
+	s := &Structure{
+		Version: "v1",
+		Phase:   "structure",
+		Intent:  Intent{Purpose: "Test"},
+		Layout:  Layout{Type: "stack"},
+		Components: []Component{
+			{
+				ID:   "header",
+				Type: "box",
+				Children: []Component{
+					{ID: "title", Type: "text", Color: "#FF0000"},
+				},
+			},
+		},
+	}
+
+	err := s.ValidatePhase1()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError, got %T: %v", err, err)
+	}
+
+	if verr.Code != ErrCodeInvalidColor || verr.ComponentID != "title" || verr.Field != "color" {
+		t.Errorf("Unexpected error details: code=%s component=%s field=%s", verr.Code, verr.ComponentID, verr.Field)
+	}
+
+	// The wrapped message keeps the component path for compatibility
+	expected := "component[0]: component 'header'.children[0]: component 'title': invalid color '#FF0000'"
+	if !strings.HasPrefix(err.Error(), expected) {
+		t.Errorf("Expected message to start with %q, got %q", expected, err.Error())
+	}
+}
+
+func TestValidationError_Codes(t *testing.T) {
+	tests := []struct {
+		name        string
+		structure   *Structure
+		code        string
+		componentID string
+		field       string
+	}{
+		{
+			name:      "invalid phase",
+			structure: &Structure{Phase: "design"},
+			code:      ErrCodeInvalidPhase,
+			field:     "phase",
+		},
+		{
+			name:      "missing version",
+			structure: &Structure{Phase: "structure"},
+			code:      ErrCodeMissingField,
+			field:     "version",
+		},
+		{
+			name: "invalid layout type",
+			structure: &Structure{
+				Phase: "structure", Version: "v1", Intent: Intent{Purpose: "Test"},
+				Layout:     Layout{Type: "masonry"},
+				Components: []Component{{ID: "a", Type: "box"}},
+			},
+			code:  ErrCodeInvalidLayoutType,
+			field: "layout.type",
+		},
+		{
+			name: "shadow not allowed",
+			structure: &Structure{
+				Phase: "structure", Version: "v1", Intent: Intent{Purpose: "Test"},
+				Layout:     Layout{Type: "stack"},
+				Components: []Component{{ID: "card", Type: "box", Layout: ComponentLayout{Shadow: "0 1px 2px 0 rgba(0,0,0,0.05)"}}},
+			},
+			code:        ErrCodeShadowNotAllowed,
+			componentID: "card",
+			field:       "layout.shadow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verr *ValidationError
+			if err := tt.structure.ValidatePhase1(); !errors.As(err, &verr) {
+				t.Fatalf("Expected a ValidationError, got %T: %v", err, err)
+			}
+			if verr.Code != tt.code || verr.ComponentID != tt.componentID || verr.Field != tt.field {
+				t.Errorf("Got code=%s component=%s field=%s, expected code=%s component=%s field=%s",
+					verr.Code, verr.ComponentID, verr.Field, tt.code, tt.componentID, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidationError_MaxNestingDepth(t *testing.T) {
+	leaf := Component{ID: "too-deep", Type: "box"}
+	for i := 0; i < 5; i++ {
+		leaf = Component{ID: "level", Type: "box", Children: []Component{leaf}}
+	}
+
+	var verr *ValidationError
+	if err := validateComponent(&leaf, 0); !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError, got %T: %v", err, err)
+	}
+	if verr.Code != ErrCodeMaxNestingDepth || verr.ComponentID != "too-deep" {
+		t.Errorf("Unexpected error details: code=%s component=%s", verr.Code, verr.ComponentID)
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// FlowFileName is the flow definition looked up in the project directory
+const FlowFileName = "flow.json"
+
+// Flow is an ordered user journey across several screens
+type Flow struct {
+	Name    string       `json:"name,omitempty"`
+	Screens []FlowScreen `json:"screens"`
+}
+
+// FlowScreen references the structure file of one screen in a flow
+type FlowScreen struct {
+	Name string `json:"name,omitempty"` // label shown above the screen (default: file name)
+	File string `json:"file"`           // structure JSON, relative to the project directory
+}
+
+// ParseFlow parses a flow definition, defaulting each screen's name to its file name
+func ParseFlow(data []byte) (*Flow, error) {
+	var flow Flow
+	if err := json.Unmarshal(data, &flow); err != nil {
+		return nil, fmt.Errorf("failed to parse flow: %w", err)
+	}
+
+	if len(flow.Screens) == 0 {
+		return nil, fmt.Errorf("flow must list at least one screen")
+	}
+	for i := range flow.Screens {
+		screen := &flow.Screens[i]
+		if screen.File == "" {
+			return nil, fmt.Errorf("screens[%d]: file is required", i)
+		}
+		if screen.Name == "" {
+			screen.Name = strings.TrimSuffix(filepath.Base(screen.File), filepath.Ext(screen.File))
+		}
+	}
+
+	return &flow, nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import "testing"
+
+func TestParseFlow(t *testing.T) {
+	flow, err := ParseFlow([]byte(`{
+		"name": "Signup",
+		"screens": [
+			{"name": "Landing", "file": "landing/phase1-structure/v2.json"},
+			{"file": "phase1-structure/welcome.json"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseFlow failed: %v", err)
+	}
+	if len(flow.Screens) != 2 || flow.Screens[0].Name != "Landing" {
+		t.Fatalf("Unexpected screens: %+v", flow.Screens)
+	}
+	if flow.Screens[1].Name != "welcome" {
+		t.Errorf("Expected the name to default to the file name, got '%s'", flow.Screens[1].Name)
+	}
+
+	for _, data := range []string{`{"screens": []}`, `{"screens": [{"name": "Missing file"}]}`, `{`} {
+		if _, err := ParseFlow([]byte(data)); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
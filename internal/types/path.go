@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ResolvePath looks up a dotted path of JSON field names, the form used by responsive
+// changes: "layout.padding" addresses the structure itself and
+// "<component-id>.layout.padding" a component anywhere in the tree. Unset values
+// resolve to their zero value; unknown components and fields, and empty segments
+// ("c1." or "c1..layout"), are errors.
+func (s *Structure) ResolvePath(path string) (interface{}, error) {
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("'%s' has an empty path segment", path)
+		}
+	}
+	root := reflect.ValueOf(*s)
+
+	if _, ok := jsonField(root, segments[0]); ok {
+		return resolveFields(root, segments)
+	}
+
+	comp := FindComponent(s.Components, segments[0])
+	if comp == nil {
+		return nil, fmt.Errorf("no component or field named '%s'", segments[0])
+	}
+	if len(segments) == 1 {
+		return nil, fmt.Errorf("'%s' names a component, not a field", path)
+	}
+	return resolveFields(reflect.ValueOf(*comp), segments[1:])
+}
+
+// resolveFields follows segments through structs (by JSON name), maps and pointers
+func resolveFields(v reflect.Value, segments []string) (interface{}, error) {
+	for i, segment := range segments {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, nil
+			}
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Struct:
+			field, ok := jsonField(v, segment)
+			if !ok {
+				return nil, fmt.Errorf("unknown field '%s'", strings.Join(segments[:i+1], "."))
+			}
+			v = field
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return nil, fmt.Errorf("'%s' cannot be indexed", strings.Join(segments[:i], "."))
+			}
+			value := v.MapIndex(reflect.ValueOf(segment))
+			if !value.IsValid() {
+				return nil, nil
+			}
+			v = value
+		default:
+			return nil, fmt.Errorf("'%s' has no field '%s'", strings.Join(segments[:i], "."), segment)
+		}
+	}
+	return v.Interface(), nil
+}
+
+// jsonField returns the exported struct field whose JSON name is name; fields that
+// are unexported, untagged or tagged "-" are never serialized and never match
+func jsonField(v reflect.Value, name string) (reflect.Value, bool) {
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if tag == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
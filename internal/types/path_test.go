@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStructure_ResolvePath(t *testing.T) {
+	s := &Structure{
+		Layout: Layout{Padding: 32, Direction: "vertical"},
+		Components: []Component{
+			{
+				ID:   "main",
+				Type: "box",
+				Children: []Component{
+					{ID: "metrics-grid", Type: "box", Layout: ComponentLayout{Direction: "horizontal"}, Meta: map[string]string{"owner": "growth"}},
+					{ID: "c1", Type: "text"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		path     string
+		expected interface{}
+	}{
+		{"layout.padding", 32},
+		{"layout.direction", "vertical"},
+		{"metrics-grid.layout.direction", "horizontal"},
+		{"metrics-grid.layout.padding", 0}, // unset resolves to the zero value
+		{"metrics-grid.meta.owner", "growth"},
+		{"metrics-grid.skeleton.elements", nil},
+	}
+	for _, tt := range tests {
+		got, err := s.ResolvePath(tt.path)
+		if err != nil {
+			t.Errorf("ResolvePath(%q) failed: %v", tt.path, err)
+			continue
+		}
+		if got != tt.expected && !(tt.expected == nil && got == nil) {
+			t.Errorf("ResolvePath(%q) = %v, expected %v", tt.path, got, tt.expected)
+		}
+	}
+
+	errors := map[string]string{
+		"sidebar.layout.padding":           "no component or field named 'sidebar'",
+		"metrics-grid.layout.grid_columns": "unknown field 'layout.grid_columns'",
+		"metrics-grid":                     "names a component",
+		"layout.padding.top":               "has no field 'top'",
+		"c1.":                              "empty path segment",
+		"c1..layout":                       "empty path segment",
+		".layout":                          "empty path segment",
+	}
+	for path, expected := range errors {
+		if _, err := s.ResolvePath(path); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("ResolvePath(%q) error = %v, expected %q", path, err, expected)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// expandRefs replaces every component of the form {"ref": "name", ...} with a copy of
+// definitions[name]. Other keys on the referencing component override the definition's,
+// so an instance usually sets its own "id" and perhaps "content". When the instance
+// renames the component, the IDs of the copied descendants are prefixed with the new
+// ID ("card-2-title") so several instances stay unique. Definitions may reference
+// each other; a reference cycle is an error.
+func expandRefs(data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte(`"ref"`)) {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber() // keep numbers exactly as written
+	var doc map[string]interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	definitions, _ := doc["definitions"].(map[string]interface{})
+	components, ok := doc["components"].([]interface{})
+	if !ok {
+		return data, nil
+	}
+
+	expanded, err := expandComponentList(components, definitions, nil)
+	if err != nil {
+		return nil, err
+	}
+	doc["components"] = expanded
+
+	return json.Marshal(doc)
+}
+
+// expandComponentList expands the references in a list of raw components; stack
+// holds the definitions being expanded, outermost first
+func expandComponentList(components []interface{}, definitions map[string]interface{}, stack []string) ([]interface{}, error) {
+	expanded := make([]interface{}, len(components))
+	for i, item := range components {
+		node, ok := item.(map[string]interface{})
+		if !ok {
+			expanded[i] = item
+			continue
+		}
+		comp, err := expandComponent(node, definitions, stack)
+		if err != nil {
+			return nil, err
+		}
+		expanded[i] = comp
+	}
+	return expanded, nil
+}
+
+// expandComponent returns node with its reference, if any, and its children expanded
+func expandComponent(node map[string]interface{}, definitions map[string]interface{}, stack []string) (map[string]interface{}, error) {
+	ref, hasRef := node["ref"].(string)
+	if !hasRef {
+		if children, ok := node["children"].([]interface{}); ok {
+			expanded, err := expandComponentList(children, definitions, stack)
+			if err != nil {
+				return nil, err
+			}
+			node["children"] = expanded
+		}
+		return node, nil
+	}
+
+	id, _ := node["id"].(string)
+	for _, name := range stack {
+		if name == ref {
+			return nil, newValidationError(ErrCodeCircularRef, id, "ref", "circular ref: %s -> %s", strings.Join(stack, " -> "), ref)
+		}
+	}
+	definition, ok := definitions[ref].(map[string]interface{})
+	if !ok {
+		return nil, newValidationError(ErrCodeUnknownRef, id, "ref", "component '%s': ref '%s' is not in definitions", id, ref)
+	}
+
+	// Expand a private copy of the definition, then lay the instance's keys over it
+	base, err := expandComponent(copyJSON(definition).(map[string]interface{}), definitions, append(stack, ref))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range node {
+		if key == "ref" || key == "children" {
+			continue
+		}
+		base[key] = value
+	}
+
+	if defID, _ := definition["id"].(string); id != "" && id != defID {
+		prefixChildIDs(base, id)
+	}
+
+	// Children given on the instance replace the definition's
+	if children, ok := node["children"].([]interface{}); ok {
+		expanded, err := expandComponentList(children, definitions, stack)
+		if err != nil {
+			return nil, err
+		}
+		base["children"] = expanded
+	}
+	return base, nil
+}
+
+// prefixChildIDs prefixes the ID of every descendant of node with prefix and a hyphen
+func prefixChildIDs(node map[string]interface{}, prefix string) {
+	children, _ := node["children"].([]interface{})
+	for _, item := range children {
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, ok := child["id"].(string); ok && id != "" {
+			child["id"] = prefix + "-" + id
+		}
+		prefixChildIDs(child, prefix)
+	}
+}
+
+// copyJSON deep-copies a decoded JSON value
+func copyJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			out[key] = copyJSON(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = copyJSON(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// rawComponent is a component node as written in the file, before refs are expanded
+type rawComponent struct {
+	data     json.RawMessage
+	ref      string
+	children []rawComponent
+}
+
+// parseRawComponents decodes a list of component nodes without expanding them
+func parseRawComponents(list []json.RawMessage) ([]rawComponent, error) {
+	components := make([]rawComponent, len(list))
+	for i, data := range list {
+		var node struct {
+			Ref      string            `json:"ref"`
+			Children []json.RawMessage `json:"children"`
+		}
+		if err := json.Unmarshal(data, &node); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		children, err := parseRawComponents(node.Children)
+		if err != nil {
+			return nil, err
+		}
+		components[i] = rawComponent{data: data, ref: node.Ref, children: children}
+	}
+	return components, nil
+}
+
+// RestoreRefs undoes ParseStructure's ref expansion before structure is written back
+// over source, the JSON it was parsed from: every component that source declared as
+// a ref, and every definition, is marshaled as written again, so definitions stay
+// the single copy.
+// A change to an expanded component cannot be written back without losing it, so it
+// is an error naming the definition to edit instead.
+func RestoreRefs(source []byte, structure *Structure) error {
+	if !bytes.Contains(source, []byte(`"ref"`)) {
+		return nil
+	}
+
+	var doc struct {
+		Definitions map[string]json.RawMessage `json:"definitions"`
+		Components  []json.RawMessage          `json:"components"`
+	}
+	if err := json.Unmarshal(source, &doc); err != nil {
+		return fmt.Errorf("failed to parse JSON: %w", err)
+	}
+	raw, err := parseRawComponents(doc.Components)
+	if err != nil {
+		return err
+	}
+	original, err := ParseStructure(source)
+	if err != nil {
+		return err
+	}
+
+	// Definitions are written as they were read, so refs inside them survive too
+	for name, data := range doc.Definitions {
+		current, ok := structure.Definitions[name]
+		if !ok {
+			continue
+		}
+		if !reflect.DeepEqual(original.Definitions[name], current) {
+			return fmt.Errorf("definitions.%s was changed; cannot restore refs", name)
+		}
+		structure.Definitions[name] = Component{ID: current.ID, raw: data}
+	}
+
+	return restoreComponentRefs(raw, original.Components, structure.Components)
+}
+
+// restoreComponentRefs replaces the components in current that raw declared as refs,
+// comparing each against its original expansion
+func restoreComponentRefs(raw []rawComponent, original, current []Component) error {
+	if len(raw) != len(original) || len(original) != len(current) {
+		return fmt.Errorf("components were added or removed; cannot restore refs")
+	}
+
+	for i := range current {
+		if raw[i].ref == "" {
+			if err := restoreComponentRefs(raw[i].children, original[i].Children, current[i].Children); err != nil {
+				return err
+			}
+			continue
+		}
+		if !reflect.DeepEqual(original[i], current[i]) {
+			return fmt.Errorf("component '%s' is expanded from ref '%s' and was changed; change definitions.%s instead", current[i].ID, raw[i].ref, raw[i].ref)
+		}
+		current[i] = Component{ID: current[i].ID, Ref: raw[i].ref, raw: raw[i].data}
+	}
+	return nil
+}
+
+// MarshalJSON writes a component restored by RestoreRefs as the ref node it was read
+// from; every other component marshals field by field
+func (c Component) MarshalJSON() ([]byte, error) {
+	if c.raw != nil {
+		return c.raw, nil
+	}
+	type plain Component
+	return json.Marshal(plain(c))
+}
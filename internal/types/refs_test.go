@@ -0,0 +1,201 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const refStructure = `{
+	"version": "v1",
+	"phase": "structure",
+	"intent": {"purpose": "Pricing"},
+	"layout": {"type": "stack"},
+	"definitions": {
+		"plan-card": {
+			"id": "card",
+			"type": "box",
+			"layout": {"padding": 16},
+			"children": [
+				{"id": "title", "type": "text", "content": "Plan"},
+				{"ref": "cta", "id": "cta"}
+			]
+		},
+		"cta": {"id": "cta", "type": "button", "content": "Choose"}
+	},
+	"components": [
+		{"ref": "plan-card", "id": "basic"},
+		{"ref": "plan-card", "id": "pro", "layout": {"padding": 24}},
+		{"ref": "plan-card", "id": "card"}
+	]
+}`
+
+func TestParseStructure_ExpandsRefs(t *testing.T) {
+	s, err := ParseAndValidateStructure([]byte(refStructure))
+	if err != nil {
+		t.Fatalf("ParseAndValidateStructure failed: %v", err)
+	}
+
+	if len(s.Components) != 3 {
+		t.Fatalf("Expected 3 components, got %d", len(s.Components))
+	}
+	basic, pro, plain := s.Components[0], s.Components[1], s.Components[2]
+
+	if basic.ID != "basic" || basic.Type != "box" || basic.Ref != "" {
+		t.Errorf("Expected 'basic' expanded to a box, got %+v", basic)
+	}
+	if basic.Layout.Padding != 16 || pro.Layout.Padding != 24 {
+		t.Errorf("Expected padding 16 from the definition and 24 from the override, got %d and %d", basic.Layout.Padding, pro.Layout.Padding)
+	}
+
+	// Descendants of renamed instances take the instance ID as a prefix
+	if len(basic.Children) != 2 || basic.Children[0].ID != "basic-title" || basic.Children[1].ID != "basic-cta" {
+		t.Errorf("Expected children basic-title and basic-cta, got %+v", basic.Children)
+	}
+	if got := pro.Children[1]; got.ID != "pro-cta" || got.Type != "button" || got.Content != "Choose" {
+		t.Errorf("Expected the nested ref expanded as pro-cta, got %+v", got)
+	}
+
+	// An instance that keeps the definition's ID keeps its children's IDs
+	if plain.ID != "card" || plain.Children[0].ID != "title" {
+		t.Errorf("Expected the definition's own IDs, got %s / %s", plain.ID, plain.Children[0].ID)
+	}
+
+	// Definitions are kept as written
+	if def, ok := s.Definitions["plan-card"]; !ok || def.Children[1].Ref != "cta" {
+		t.Errorf("Expected the plan-card definition to keep its ref, got %+v", s.Definitions)
+	}
+}
+
+func TestSchema_AcceptsRefs(t *testing.T) {
+	root := loadSchema(t)
+	var doc interface{}
+	if err := json.Unmarshal([]byte(refStructure), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if errs := schemaErrors(root, root, doc, "$"); len(errs) > 0 {
+		t.Errorf("Structure with refs does not match the schema:\n  %s", strings.Join(errs, "\n  "))
+	}
+}
+
+func TestParseStructure_RefErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		definitions string
+		component   string
+		code        string
+		want        string
+	}{
+		{
+			name:        "circular",
+			definitions: `{"a": {"id": "a", "type": "box", "children": [{"ref": "b"}]}, "b": {"id": "b", "type": "box", "children": [{"ref": "a"}]}}`,
+			component:   `{"ref": "a", "id": "outer"}`,
+			code:        ErrCodeCircularRef,
+			want:        "circular ref: a -> b -> a",
+		},
+		{
+			name:        "self",
+			definitions: `{"a": {"id": "a", "type": "box", "children": [{"ref": "a"}]}}`,
+			component:   `{"ref": "a"}`,
+			code:        ErrCodeCircularRef,
+			want:        "circular ref: a -> a",
+		},
+		{
+			name:        "unknown",
+			definitions: `{}`,
+			component:   `{"ref": "missing", "id": "hero"}`,
+			code:        ErrCodeUnknownRef,
+			want:        "ref 'missing' is not in definitions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "definitions": ` + tt.definitions + `, "components": [` + tt.component + `]}`
+			_, err := ParseStructure([]byte(data))
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			var vErr *ValidationError
+			if !errors.As(err, &vErr) || vErr.Code != tt.code || vErr.Field != "ref" {
+				t.Fatalf("Expected a %s error on ref, got %v", tt.code, err)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected %q in %q", tt.want, err.Error())
+			}
+		})
+	}
+}
+
+func TestRestoreRefs_RoundTrip(t *testing.T) {
+	s, err := ParseStructure([]byte(refStructure))
+	if err != nil {
+		t.Fatalf("ParseStructure failed: %v", err)
+	}
+	expanded, _ := ParseStructure([]byte(refStructure))
+
+	// A change outside the instances, like approving, keeps every ref
+	s.Locked = true
+	s.Checksum = ComputeChecksum(s)
+	if err := RestoreRefs([]byte(refStructure), s); err != nil {
+		t.Fatalf("RestoreRefs failed: %v", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent failed: %v", err)
+	}
+
+	var written struct {
+		Definitions map[string]map[string]interface{} `json:"definitions"`
+		Components  []map[string]interface{}          `json:"components"`
+	}
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("Failed to decode written structure: %v", err)
+	}
+	for i, comp := range written.Components {
+		if comp["ref"] != "plan-card" || comp["type"] != nil || comp["children"] != nil {
+			t.Errorf("Expected component %d written as a plan-card ref, got %v", i, comp)
+		}
+	}
+	if pro := written.Components[1]; len(pro) != 3 {
+		t.Errorf("Expected the pro instance to keep only ref, id and layout, got %v", pro)
+	}
+	cta := written.Definitions["plan-card"]["children"].([]interface{})[1].(map[string]interface{})
+	if len(cta) != 2 || cta["ref"] != "cta" {
+		t.Errorf("Expected the nested ref in the definition written as is, got %v", cta)
+	}
+
+	// Reading it back expands to the same structure, so the checksum still verifies
+	reread, err := ParseStructure(data)
+	if err != nil {
+		t.Fatalf("ParseStructure of the written structure failed: %v", err)
+	}
+	if !reflect.DeepEqual(reread.Components, expanded.Components) {
+		t.Errorf("Expected the same expansion after the round trip, got %+v", reread.Components)
+	}
+	if err := reread.VerifyChecksum(); err != nil {
+		t.Errorf("Expected the checksum to verify after the round trip: %v", err)
+	}
+}
+
+func TestRestoreRefs_ChangedInstance(t *testing.T) {
+	s, err := ParseStructure([]byte(refStructure))
+	if err != nil {
+		t.Fatalf("ParseStructure failed: %v", err)
+	}
+
+	// A fix inside an expanded instance would be lost when the ref is written back
+	s.Components[1].Children[0].Layout.Padding = 8
+	err = RestoreRefs([]byte(refStructure), s)
+	if err == nil || !strings.Contains(err.Error(), "pro") || !strings.Contains(err.Error(), "definitions.plan-card") {
+		t.Errorf("Expected an error naming 'pro' and definitions.plan-card, got %v", err)
+	}
+
+	// Structures without refs are left alone
+	plain := &Structure{Components: []Component{{ID: "title", Type: "text"}}}
+	if err := RestoreRefs([]byte(`{"components": [{"id": "title", "type": "text"}]}`), plain); err != nil {
+		t.Errorf("Expected no error without refs, got %v", err)
+	}
+}
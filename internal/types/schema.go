@@ -0,0 +1,15 @@
+package types
+
+import _ "embed"
+
+// schema is the JSON Schema (draft-07) for structure files. Keep it in sync with
+// the Structure types; TestSchema_MatchesTypes fails when a field is missing.
+//
+//go:embed schema.json
+var schema []byte
+
+// JSONSchema returns the JSON Schema (draft-07) describing structure files, for
+// editors to autocomplete and validate them inline
+func JSONSchema() []byte {
+	return append([]byte(nil), schema...)
+}
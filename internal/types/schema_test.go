@@ -0,0 +1,300 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// schemaNode is the subset of JSON Schema draft-07 that schema.json uses
+type schemaNode struct {
+	Ref                  string                 `json:"$ref"`
+	Type                 interface{}            `json:"type"`
+	Required             []string               `json:"required"`
+	Properties           map[string]*schemaNode `json:"properties"`
+	AdditionalProperties interface{}            `json:"additionalProperties"`
+	Items                *schemaNode            `json:"items"`
+	Enum                 []interface{}          `json:"enum"`
+	Minimum              *float64               `json:"minimum"`
+	MinLength            *int                   `json:"minLength"`
+	MinItems             *int                   `json:"minItems"`
+	Definitions          map[string]*schemaNode `json:"definitions"`
+}
+
+func loadSchema(t *testing.T) *schemaNode {
+	t.Helper()
+	var root schemaNode
+	if err := json.Unmarshal(JSONSchema(), &root); err != nil {
+		t.Fatalf("schema.json is not valid JSON: %v", err)
+	}
+	return &root
+}
+
+// schemaErrors checks value against node and returns one message per violation
+func schemaErrors(root, node *schemaNode, value interface{}, path string) []string {
+	if node.Ref != "" {
+		return schemaErrors(root, root.Definitions[strings.TrimPrefix(node.Ref, "#/definitions/")], value, path)
+	}
+
+	var errs []string
+	if node.Type != nil && !schemaTypeMatches(node.Type, value) {
+		return []string{fmt.Sprintf("%s: expected %v, got %T", path, node.Type, value)}
+	}
+	if node.Enum != nil {
+		found := false
+		for _, allowed := range node.Enum {
+			if allowed == value {
+				found = true
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("%s: %v is not one of %v", path, value, node.Enum))
+		}
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if node.Minimum != nil && v < *node.Minimum {
+			errs = append(errs, fmt.Sprintf("%s: %v is below the minimum %v", path, v, *node.Minimum))
+		}
+	case string:
+		if node.MinLength != nil && len(v) < *node.MinLength {
+			errs = append(errs, fmt.Sprintf("%s: shorter than %d", path, *node.MinLength))
+		}
+	case []interface{}:
+		if node.MinItems != nil && len(v) < *node.MinItems {
+			errs = append(errs, fmt.Sprintf("%s: fewer than %d items", path, *node.MinItems))
+		}
+		if node.Items != nil {
+			for i, item := range v {
+				errs = append(errs, schemaErrors(root, node.Items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range node.Required {
+			if _, ok := v[name]; !ok {
+				errs = append(errs, fmt.Sprintf("%s: missing required property '%s'", path, name))
+			}
+		}
+		for name, field := range v {
+			if property, ok := node.Properties[name]; ok {
+				errs = append(errs, schemaErrors(root, property, field, path+"."+name)...)
+				continue
+			}
+			// Unknown properties are allowed, as PRISM ignores them
+			if extra, ok := node.AdditionalProperties.(map[string]interface{}); ok {
+				data, _ := json.Marshal(extra)
+				var additional schemaNode
+				json.Unmarshal(data, &additional)
+				errs = append(errs, schemaErrors(root, &additional, field, path+"."+name)...)
+			}
+		}
+	}
+	return errs
+}
+
+func schemaTypeMatches(schemaType, value interface{}) bool {
+	types := []interface{}{schemaType}
+	if list, ok := schemaType.([]interface{}); ok {
+		types = list
+	}
+	for _, t := range types {
+		switch t {
+		case "object":
+			if _, ok := value.(map[string]interface{}); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]interface{}); ok {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "integer":
+			if n, ok := value.(float64); ok && n == float64(int64(n)) {
+				return true
+			}
+		case "number":
+			if _, ok := value.(float64); ok {
+				return true
+			}
+		case "null":
+			if value == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestSchema_ValidFixtures(t *testing.T) {
+	root := loadSchema(t)
+	files, err := filepath.Glob("../../test/fixtures/*/phase1-structure/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("No fixtures found: %v", err)
+	}
+
+	checked := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Only structures PRISM accepts must match the schema
+		if _, err := ParseAndValidateStructure(data); err != nil {
+			continue
+		}
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Fatal(err)
+		}
+		if errs := schemaErrors(root, root, doc, "$"); len(errs) > 0 {
+			t.Errorf("%s does not match the schema:\n  %s", file, strings.Join(errs, "\n  "))
+		}
+		checked++
+	}
+	if checked == 0 {
+		t.Error("Expected at least one valid fixture")
+	}
+}
+
+func TestSchema_InvalidStructures(t *testing.T) {
+	root := loadSchema(t)
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{
+			name: "unknown component type",
+			json: `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "components": [{"id": "a", "type": "slider"}]}`,
+			want: "$.components[0].type: slider is not one of",
+		},
+		{
+			name: "missing component id",
+			json: `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "components": [{"type": "box"}]}`,
+			want: "$.components[0]: missing required property 'id'",
+		},
+		{
+			name: "unknown phase",
+			json: `{"version": "v1", "phase": "wireframe", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "components": [{"id": "a", "type": "box"}]}`,
+			want: "$.phase: wireframe is not one of",
+		},
+		{
+			name: "negative width",
+			json: `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "components": [{"id": "a", "type": "box", "layout": {"width": -20}}]}`,
+			want: "$.components[0].layout.width: -20 is below the minimum 0",
+		},
+		{
+			name: "no components",
+			json: `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack"}, "components": []}`,
+			want: "$.components: fewer than 1 items",
+		},
+		{
+			name: "string where a number belongs",
+			json: `{"version": "v1", "phase": "structure", "intent": {"purpose": "x"}, "layout": {"type": "stack", "spacing": "16px"}, "components": [{"id": "a", "type": "box"}]}`,
+			want: "$.layout.spacing: expected integer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var doc interface{}
+			if err := json.Unmarshal([]byte(tt.json), &doc); err != nil {
+				t.Fatal(err)
+			}
+			errs := schemaErrors(root, root, doc, "$")
+			found := false
+			for _, err := range errs {
+				if strings.HasPrefix(err, tt.want) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected an error starting with %q, got %v", tt.want, errs)
+			}
+		})
+	}
+}
+
+// TestSchema_MatchesTypes keeps schema.json in sync with the Go types: every JSON
+// field must be described, and the schema must not describe fields that don't exist
+func TestSchema_MatchesTypes(t *testing.T) {
+	root := loadSchema(t)
+	definitions := map[string]*schemaNode{"Structure": root}
+	for name, def := range root.Definitions {
+		definitions[name] = def
+	}
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(Structure{}),
+		reflect.TypeOf(Intent{}),
+		reflect.TypeOf(Layout{}),
+		reflect.TypeOf(Component{}),
+		reflect.TypeOf(SkeletonConfig{}),
+		reflect.TypeOf(SkeletonElement{}),
+		reflect.TypeOf(ComponentLayout{}),
+		reflect.TypeOf(Responsive{}),
+		reflect.TypeOf(ResponsiveBreakpoint{}),
+		reflect.TypeOf(Accessibility{}),
+		reflect.TypeOf(Validation{}),
+	} {
+		def, ok := definitions[typ.Name()]
+		if !ok {
+			t.Errorf("schema has no definition for %s", typ.Name())
+			continue
+		}
+
+		fields := []string{}
+		for i := 0; i < typ.NumField(); i++ {
+			if !typ.Field(i).IsExported() {
+				continue // never serialized
+			}
+			name := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+			fields = append(fields, name)
+			if _, ok := def.Properties[name]; !ok {
+				t.Errorf("schema definition %s is missing property '%s'", typ.Name(), name)
+			}
+		}
+		sort.Strings(fields)
+		for name := range def.Properties {
+			if i := sort.SearchStrings(fields, name); i == len(fields) || fields[i] != name {
+				t.Errorf("schema definition %s describes '%s', which %s does not have", typ.Name(), name, typ.Name())
+			}
+		}
+	}
+
+	// Enums mirror the accepted values
+	if got := enumStrings(definitions["Component"].Properties["type"]); !reflect.DeepEqual(got, ValidComponentTypes()) {
+		t.Errorf("component type enum %v does not match ValidComponentTypes %v", got, ValidComponentTypes())
+	}
+	if got := enumStrings(definitions["Component"].Properties["weight"]); !reflect.DeepEqual(got, ValidTextWeights()) {
+		t.Errorf("weight enum %v does not match ValidTextWeights %v", got, ValidTextWeights())
+	}
+	if got := enumStrings(definitions["Component"].Properties["text_align"]); !reflect.DeepEqual(got, ValidTextAligns()) {
+		t.Errorf("text_align enum %v does not match ValidTextAligns %v", got, ValidTextAligns())
+	}
+	if got := enumStrings(definitions["Component"].Properties["input_type"]); !reflect.DeepEqual(got, ValidInputTypes()) {
+		t.Errorf("input_type enum %v does not match ValidInputTypes %v", got, ValidInputTypes())
+	}
+}
+
+func enumStrings(node *schemaNode) []string {
+	values := []string{}
+	for _, v := range node.Enum {
+		values = append(values, v.(string))
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,96 @@
+package types
+
+import "sort"
+
+// Stats summarizes the size and consistency of a structure
+type Stats struct {
+	TotalComponents     int            `json:"total_components"`
+	MaxDepth            int            `json:"max_depth"` // as Structure.MaxDepth: top-level components are depth 0
+	ByType              map[string]int `json:"by_type"`
+	ByRole              map[string]int `json:"by_role"`
+	InteractiveElements int            `json:"interactive_elements"`
+	Colors              []string       `json:"colors"`         // distinct text and background colors
+	TextSizes           []string       `json:"text_sizes"`     // distinct size tokens
+	SpacingValues       []int          `json:"spacing_values"` // distinct padding, gap and margin values
+}
+
+// ComputeStats walks the component tree and returns aggregate metrics for the structure
+func ComputeStats(s *Structure) Stats {
+	stats := Stats{
+		ByType: map[string]int{},
+		ByRole: map[string]int{},
+	}
+
+	colors := map[string]bool{}
+	sizes := map[string]bool{}
+	spacing := map[int]bool{}
+
+	addSpacing := func(value int) {
+		if value > 0 {
+			spacing[value] = true
+		}
+	}
+	addSpacing(s.Layout.Spacing)
+	addSpacing(s.Layout.Padding)
+
+	if depth := s.MaxDepth(); depth > 0 {
+		stats.MaxDepth = depth
+	}
+
+	var walk func(components []Component)
+	walk = func(components []Component) {
+		for i := range components {
+			comp := &components[i]
+
+			stats.TotalComponents++
+
+			stats.ByType[comp.Type]++
+			if comp.Role != "" {
+				stats.ByRole[comp.Role]++
+			}
+			if comp.IsInteractive() {
+				stats.InteractiveElements++
+			}
+
+			if comp.Color != "" {
+				colors[comp.Color] = true
+			}
+			if comp.Layout.Background != "" {
+				colors[comp.Layout.Background] = true
+			}
+			if comp.Size != "" {
+				sizes[comp.Size] = true
+			}
+
+			addSpacing(comp.Layout.Padding)
+			addSpacing(comp.Layout.Gap)
+			addSpacing(comp.Layout.RowGap)
+			addSpacing(comp.Layout.ColumnGap)
+			addSpacing(comp.Layout.MarginBottom)
+
+			walk(comp.Children)
+		}
+	}
+	walk(s.Components)
+
+	stats.Colors = sortedKeys(colors)
+	stats.TextSizes = sortedKeys(sizes)
+
+	stats.SpacingValues = make([]int, 0, len(spacing))
+	for value := range spacing {
+		stats.SpacingValues = append(stats.SpacingValues, value)
+	}
+	sort.Ints(stats.SpacingValues)
+
+	return stats
+}
+
+// sortedKeys returns the keys of a string set in sorted order
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeStats(t *testing.T) {
+	s := &Structure{
+		Layout: Layout{Spacing: 16, Padding: 24},
+		Components: []Component{
+			{
+				ID:     "header",
+				Type:   "box",
+				Role:   "header",
+				Layout: ComponentLayout{Padding: 16, Background: "#FFFFFF"},
+				Children: []Component{
+					{ID: "title", Type: "text", Size: "2xl", Color: "#000000"},
+					{ID: "subtitle", Type: "text", Size: "base", Color: "#737373"},
+				},
+			},
+			{
+				ID:     "form",
+				Type:   "box",
+				Layout: ComponentLayout{Gap: 12},
+				Children: []Component{
+					{ID: "email", Type: "input"},
+					{
+						ID:     "actions",
+						Type:   "box",
+						Layout: ComponentLayout{Gap: 8},
+						Children: []Component{
+							{ID: "submit", Type: "button", Role: "primary"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	stats := ComputeStats(s)
+
+	if stats.TotalComponents != 7 {
+		t.Errorf("TotalComponents = %d, expected 7", stats.TotalComponents)
+	}
+	// Counted like Structure.MaxDepth, with top-level components at depth 0
+	if stats.MaxDepth != s.MaxDepth() || stats.MaxDepth != 2 {
+		t.Errorf("MaxDepth = %d, expected 2", stats.MaxDepth)
+	}
+	if stats.ByType["box"] != 3 || stats.ByType["text"] != 2 || stats.ByType["input"] != 1 || stats.ByType["button"] != 1 {
+		t.Errorf("Unexpected ByType: %v", stats.ByType)
+	}
+	if stats.ByRole["header"] != 1 || stats.ByRole["primary"] != 1 || len(stats.ByRole) != 2 {
+		t.Errorf("Unexpected ByRole: %v", stats.ByRole)
+	}
+	if stats.InteractiveElements != 2 {
+		t.Errorf("InteractiveElements = %d, expected 2", stats.InteractiveElements)
+	}
+	if expected := []string{"#000000", "#737373", "#FFFFFF"}; !reflect.DeepEqual(stats.Colors, expected) {
+		t.Errorf("Colors = %v, expected %v", stats.Colors, expected)
+	}
+	if expected := []string{"2xl", "base"}; !reflect.DeepEqual(stats.TextSizes, expected) {
+		t.Errorf("TextSizes = %v, expected %v", stats.TextSizes, expected)
+	}
+	if expected := []int{8, 12, 16, 24}; !reflect.DeepEqual(stats.SpacingValues, expected) {
+		t.Errorf("SpacingValues = %v, expected %v", stats.SpacingValues, expected)
+	}
+}
+
+func TestComputeStats_Empty(t *testing.T) {
+	stats := ComputeStats(&Structure{})
+
+	if stats.TotalComponents != 0 || stats.MaxDepth != 0 {
+		t.Errorf("Expected empty stats, got %+v", stats)
+	}
+	if stats.Colors == nil || stats.TextSizes == nil || stats.SpacingValues == nil {
+		t.Error("Expected empty slices rather than nil for JSON output")
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -21,6 +22,8 @@ type Structure struct {
 	Note          string        `json:"note,omitempty"`
 	Intent        Intent        `json:"intent"`
 	Layout        Layout        `json:"layout"`
+	Palette       []string      `json:"palette,omitempty"` // colors a Phase 2 design may use
+	Definitions   map[string]Component `json:"definitions,omitempty"` // reusable components, referenced with {"ref": name}
 	Components    []Component   `json:"components"`
 	Responsive    Responsive    `json:"responsive"`
 	Accessibility Accessibility `json:"accessibility"`
@@ -37,26 +40,39 @@ type Intent struct {
 
 // Layout defines the top-level layout configuration
 type Layout struct {
-	Type      string `json:"type"`       // "stack", "grid", "sidebar"
-	Direction string `json:"direction"`  // "vertical", "horizontal"
-	Spacing   int    `json:"spacing"`    // spacing in pixels
-	MaxWidth  int    `json:"max_width"`  // max width in pixels
-	Padding   int    `json:"padding"`    // padding in pixels
+	Type           string `json:"type"`                      // "stack", "grid", "sidebar"
+	Direction      string `json:"direction"`                 // "vertical", "horizontal"
+	Spacing        int    `json:"spacing"`                   // spacing in pixels
+	MaxWidth       int    `json:"max_width"`                 // max width in pixels
+	Padding        int    `json:"padding"`                   // padding in pixels
+	JustifyContent string `json:"justify_content,omitempty"` // vertical placement: "flex-start", "center", "flex-end", "space-between"
+	AlignItems     string `json:"align_items,omitempty"`     // horizontal placement: "flex-start", "center", "flex-end"
 }
 
 // Component represents a UI component
 type Component struct {
 	ID       string           `json:"id"`
-	Type     string           `json:"type"`     // "box", "text", "input", "button", "image"
+	Type     string           `json:"type"`     // see ValidComponentTypes
 	Role     string           `json:"role"`     // "header", "navigation", "content", "footer", etc
 	State    string           `json:"state,omitempty"`    // "loading", "error", "empty", "default"
 	Layout   ComponentLayout  `json:"layout"`
 	Content  string           `json:"content,omitempty"`
+	Value    string           `json:"value,omitempty"`    // filled-in input value; Content is then only the placeholder
+	InputType string          `json:"input_type,omitempty"` // see ValidInputTypes; inputs default to "text"
 	Size     string           `json:"size,omitempty"`     // "xs", "sm", "base", "lg", "xl", "2xl", "3xl", "4xl"
-	Weight   string           `json:"weight,omitempty"`   // "normal", "bold"
+	Weight   string           `json:"weight,omitempty"`   // "normal", "medium", "semibold", "bold"
+	TextAlign string          `json:"text_align,omitempty"` // see ValidTextAligns; text defaults to "left"
 	Color    string           `json:"color,omitempty"`    // hex color
 	Children []Component      `json:"children,omitempty"`
 	Skeleton *SkeletonConfig  `json:"skeleton,omitempty"` // Skeleton placeholder configuration
+	TabIndex int              `json:"tabindex,omitempty"` // explicit focus order (0 = natural order, -1 = not focusable)
+	ZIndex   int              `json:"z_index,omitempty"`  // paint order among siblings; higher draws on top (ties keep document order)
+	Alt      string           `json:"alt,omitempty"`      // text alternative for images (decorative images use role "presentation")
+	Note     string            `json:"note,omitempty"`     // designer rationale; never validated or rendered
+	Meta     map[string]string `json:"meta,omitempty"`     // free-form annotations; never validated or rendered
+	Ref      string            `json:"ref,omitempty"`      // name of a Structure.Definitions entry; expanded by ParseStructure
+
+	raw json.RawMessage `json:"-"` // the ref node as written, restored by RestoreRefs; marshaled verbatim
 }
 
 // SkeletonConfig defines the skeleton/placeholder structure for loading states
@@ -82,15 +98,26 @@ type ComponentLayout struct {
 	BorderBottom        string `json:"border_bottom,omitempty"`        // e.g., "1px solid #E5E5E5"
 	BorderRight         string `json:"border_right,omitempty"`         // e.g., "1px solid #E5E5E5"
 	Gap                 int    `json:"gap,omitempty"`                  // gap in pixels
+	RowGap              int    `json:"row_gap,omitempty"`              // grid gap between rows (falls back to gap)
+	ColumnGap           int    `json:"column_gap,omitempty"`           // grid gap between columns (falls back to gap)
 	GridTemplateColumns string `json:"grid_template_columns,omitempty"` // e.g., "repeat(4, 1fr)"
+	GridColumnSpan      int    `json:"grid_column_span,omitempty"`     // columns spanned inside a grid parent (default 1)
+	GridRowSpan         int    `json:"grid_row_span,omitempty"`        // rows spanned inside a grid parent (default 1)
 	Width               int    `json:"width,omitempty"`                // width in pixels
 	Height              int    `json:"height,omitempty"`               // height in pixels
 	MinHeight           string `json:"min_height,omitempty"`           // e.g., "calc(100vh - 64px)"
+	MinWidth            int    `json:"min_width,omitempty"`            // min width in pixels (flex children)
 	MaxWidth            int    `json:"max_width,omitempty"`            // max width in pixels
 	Flex                int    `json:"flex,omitempty"`                 // flex grow factor
 	JustifyContent      string `json:"justify_content,omitempty"`      // "flex-start", "center", "space-between"
 	AlignItems          string `json:"align_items,omitempty"`          // "flex-start", "center", "flex-end"
 	MarginBottom        int    `json:"margin_bottom,omitempty"`        // margin bottom in pixels
+	Shadow              string `json:"shadow,omitempty"`               // e.g., "0 1px 2px 0 rgba(0,0,0,0.05)"
+	Position            string `json:"position,omitempty"`             // "absolute" to place against the parent box, out of flow
+	Top                 *int   `json:"top,omitempty"`                  // absolute offset from the parent's top edge in pixels
+	Left                *int   `json:"left,omitempty"`                 // absolute offset from the parent's left edge in pixels
+	Right               *int   `json:"right,omitempty"`                // absolute offset from the parent's right edge (when left is unset)
+	Bottom              *int   `json:"bottom,omitempty"`               // absolute offset from the parent's bottom edge (when top is unset)
 }
 
 // Responsive defines responsive breakpoints and changes
@@ -128,27 +155,27 @@ type Validation struct {
 func (s *Structure) ValidatePhase1() error {
 	// Check phase
 	if s.Phase != "structure" {
-		return fmt.Errorf("invalid phase: expected 'structure', got '%s'", s.Phase)
+		return newValidationError(ErrCodeInvalidPhase, "", "phase", "invalid phase: expected 'structure', got '%s'", s.Phase)
 	}
 
 	// Validate required fields
 	if s.Version == "" {
-		return fmt.Errorf("version is required")
+		return newValidationError(ErrCodeMissingField, "", "version", "version is required")
 	}
 	if s.Intent.Purpose == "" {
-		return fmt.Errorf("intent.purpose is required")
+		return newValidationError(ErrCodeMissingField, "", "intent.purpose", "intent.purpose is required")
 	}
 	if s.Layout.Type == "" {
-		return fmt.Errorf("layout.type is required")
+		return newValidationError(ErrCodeMissingField, "", "layout.type", "layout.type is required")
 	}
 	if len(s.Components) == 0 {
-		return fmt.Errorf("at least one component is required")
+		return newValidationError(ErrCodeNoComponents, "", "components", "at least one component is required")
 	}
 
 	// Validate layout type
 	validLayoutTypes := map[string]bool{"stack": true, "grid": true, "sidebar": true}
 	if !validLayoutTypes[s.Layout.Type] {
-		return fmt.Errorf("invalid layout.type: %s (must be stack, grid, or sidebar)", s.Layout.Type)
+		return newValidationError(ErrCodeInvalidLayoutType, "", "layout.type", "invalid layout.type: %s (must be stack, grid, or sidebar)", s.Layout.Type)
 	}
 
 	// Validate components
@@ -161,25 +188,63 @@ func (s *Structure) ValidatePhase1() error {
 	return nil
 }
 
+// MaxNestingDepth is the deepest a component may be nested in Phase 1. Top-level
+// components are at depth 0 and each level of children adds one.
+const MaxNestingDepth = 4
+
+// MaxDepth returns the depth of the most deeply nested component, with top-level
+// components at depth 0, or -1 when there are no components
+func (s *Structure) MaxDepth() int {
+	var deepest func(components []Component, depth int) int
+	deepest = func(components []Component, depth int) int {
+		max := -1
+		for i := range components {
+			d := depth
+			if child := deepest(components[i].Children, depth+1); child > d {
+				d = child
+			}
+			if d > max {
+				max = d
+			}
+		}
+		return max
+	}
+	return deepest(s.Components, 0)
+}
+
 // validateComponent recursively validates a component and its children
 func validateComponent(c *Component, depth int) error {
 	// Check max nesting depth
-	if depth > 4 {
-		return fmt.Errorf("component '%s': max nesting depth (4) exceeded", c.ID)
+	if depth > MaxNestingDepth {
+		return newValidationError(ErrCodeMaxNestingDepth, c.ID, "children", "component '%s': max nesting depth (%d) exceeded", c.ID, MaxNestingDepth)
 	}
 
 	// Validate required fields
 	if c.ID == "" {
-		return fmt.Errorf("component ID is required")
+		return newValidationError(ErrCodeMissingField, "", "id", "component ID is required")
 	}
 	if c.Type == "" {
-		return fmt.Errorf("component '%s': type is required", c.ID)
+		return newValidationError(ErrCodeMissingField, c.ID, "type", "component '%s': type is required", c.ID)
 	}
 
 	// Validate component type
-	validTypes := map[string]bool{"box": true, "text": true, "input": true, "button": true, "image": true}
-	if !validTypes[c.Type] {
-		return fmt.Errorf("component '%s': invalid type '%s' (must be box, text, input, button, or image)", c.ID, c.Type)
+	if _, ok := componentBaseTypes[c.Type]; !ok {
+		return newValidationError(ErrCodeInvalidType, c.ID, "type", "component '%s': invalid type '%s' (must be one of %s)", c.ID, c.Type, strings.Join(ValidComponentTypes(), ", "))
+	}
+
+	// Validate weight
+	if _, ok := textWeights[c.Weight]; c.Weight != "" && !ok {
+		return newValidationError(ErrCodeInvalidWeight, c.ID, "weight", "component '%s': invalid weight '%s' (must be one of %s)", c.ID, c.Weight, strings.Join(ValidTextWeights(), ", "))
+	}
+
+	// Validate text alignment
+	if _, ok := textAligns[c.TextAlign]; c.TextAlign != "" && !ok {
+		return newValidationError(ErrCodeInvalidTextAlign, c.ID, "text_align", "component '%s': invalid text_align '%s' (must be one of %s)", c.ID, c.TextAlign, strings.Join(ValidTextAligns(), ", "))
+	}
+
+	// Validate input kind
+	if _, ok := inputTypes[c.InputType]; c.InputType != "" && !ok {
+		return newValidationError(ErrCodeInvalidInputType, c.ID, "input_type", "component '%s': invalid input_type '%s' (must be one of %s)", c.ID, c.InputType, strings.Join(ValidInputTypes(), ", "))
 	}
 
 	// Validate colors (Phase 1 constraint: only black, white, and grays)
@@ -192,11 +257,16 @@ func validateComponent(c *Component, depth int) error {
 	}
 	
 	if c.Color != "" && !validColors[c.Color] {
-		return fmt.Errorf("component '%s': invalid color '%s' (Phase 1 only allows #FFFFFF, #000000, #E5E5E5, #737373, #525252)", c.ID, c.Color)
+		return newValidationError(ErrCodeInvalidColor, c.ID, "color", "component '%s': invalid color '%s' (Phase 1 only allows #FFFFFF, #000000, #E5E5E5, #737373, #525252)", c.ID, c.Color)
 	}
 	
 	if c.Layout.Background != "" && !validColors[c.Layout.Background] {
-		return fmt.Errorf("component '%s': invalid background color '%s' (Phase 1 only allows #FFFFFF, #000000, #E5E5E5, #737373, #525252)", c.ID, c.Layout.Background)
+		return newValidationError(ErrCodeInvalidColor, c.ID, "layout.background", "component '%s': invalid background color '%s' (Phase 1 only allows #FFFFFF, #000000, #E5E5E5, #737373, #525252)", c.ID, c.Layout.Background)
+	}
+
+	// Shadows are a Phase 2 (design) concept
+	if c.Layout.Shadow != "" {
+		return newValidationError(ErrCodeShadowNotAllowed, c.ID, "layout.shadow", "component '%s': shadow '%s' not allowed in Phase 1 (shadows are applied in Phase 2)", c.ID, c.Layout.Shadow)
 	}
 
 	// Validate children recursively
@@ -209,8 +279,13 @@ func validateComponent(c *Component, depth int) error {
 	return nil
 }
 
-// ParseStructure parses a JSON byte array into a Structure
+// ParseStructure parses a JSON byte array into a Structure, expanding component refs
 func ParseStructure(data []byte) (*Structure, error) {
+	data, err := expandRefs(data)
+	if err != nil {
+		return nil, err
+	}
+
 	var s Structure
 	if err := json.Unmarshal(data, &s); err != nil {
 		return nil, fmt.Errorf("failed to parse JSON: %w", err)
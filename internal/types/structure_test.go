@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 )
@@ -239,6 +240,55 @@ func TestValidateComponent_InvalidType(t *testing.T) {
 	}
 }
 
+func TestValidateComponent_ExtendedTypes(t *testing.T) {
+	for _, typ := range []string{"container", "card", "list", "nav", "link", "select", "checkbox", "radio"} {
+		c := &Component{ID: "comp-" + typ, Type: typ}
+		if err := validateComponent(c, 0); err != nil {
+			t.Errorf("Expected type '%s' to be valid, got: %v", typ, err)
+		}
+	}
+}
+
+func TestParseAndValidateStructure_ExtendedTypes(t *testing.T) {
+	data := `{
+		"version": "v1",
+		"phase": "structure",
+		"created_at": "2025-10-25T12:00:00Z",
+		"locked": false,
+		"intent": {"purpose": "Test", "primary_action": "Browse"},
+		"layout": {"type": "stack"},
+		"components": [
+			{
+				"id": "main-nav",
+				"type": "nav",
+				"layout": {"display": "flex"},
+				"children": [
+					{"id": "home-link", "type": "link", "content": "Home", "layout": {}},
+					{"id": "sort-select", "type": "select", "layout": {}}
+				]
+			},
+			{
+				"id": "results",
+				"type": "list",
+				"layout": {"display": "grid"},
+				"children": [
+					{"id": "result-card", "type": "card", "layout": {}, "children": [
+						{"id": "card-body", "type": "container", "layout": {}}
+					]}
+				]
+			}
+		]
+	}`
+
+	s, err := ParseAndValidateStructure([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseAndValidateStructure failed: %v", err)
+	}
+	if s.Components[0].Type != "nav" || s.Components[1].Children[0].Type != "card" {
+		t.Errorf("Unexpected component types: %s, %s", s.Components[0].Type, s.Components[1].Children[0].Type)
+	}
+}
+
 func TestValidateComponent_InvalidColor(t *testing.T) {
 	c := &Component{
 		ID:    "comp1",
@@ -252,6 +302,21 @@ func TestValidateComponent_InvalidColor(t *testing.T) {
 	}
 }
 
+func TestValidateComponent_ShadowNotAllowed(t *testing.T) {
+	c := &Component{
+		ID:   "card",
+		Type: "box",
+		Layout: ComponentLayout{
+			Shadow: "0 1px 2px 0 rgba(0,0,0,0.05)",
+		},
+	}
+
+	err := validateComponent(c, 0)
+	if err == nil {
+		t.Error("Expected error for shadow in Phase 1, got nil")
+	}
+}
+
 func TestValidateComponent_ValidColors(t *testing.T) {
 	validColors := []string{"#FFFFFF", "#000000", "#E5E5E5", "#737373", "#525252"}
 
@@ -269,6 +334,66 @@ func TestValidateComponent_ValidColors(t *testing.T) {
 	}
 }
 
+func TestValidateComponent_InvalidWeight(t *testing.T) {
+	c := &Component{ID: "title", Type: "text", Weight: "bolder"}
+
+	err := validateComponent(c, 0)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError for weight 'bolder', got %v", err)
+	}
+	if verr.Code != ErrCodeInvalidWeight || verr.Field != "weight" {
+		t.Errorf("Expected %s on field weight, got %s on %s", ErrCodeInvalidWeight, verr.Code, verr.Field)
+	}
+}
+
+func TestValidateComponent_InputType(t *testing.T) {
+	for _, inputType := range ValidInputTypes() {
+		c := &Component{ID: "field", Type: "input", InputType: inputType}
+		if err := validateComponent(c, 0); err != nil {
+			t.Errorf("Expected input_type %s to pass, got error: %v", inputType, err)
+		}
+	}
+
+	c := &Component{ID: "field", Type: "input", InputType: "toggle"}
+	err := validateComponent(c, 0)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError for input_type 'toggle', got %v", err)
+	}
+	if verr.Code != ErrCodeInvalidInputType || verr.Field != "input_type" {
+		t.Errorf("Expected %s on field input_type, got %s on %s", ErrCodeInvalidInputType, verr.Code, verr.Field)
+	}
+}
+
+func TestValidateComponent_ValidWeights(t *testing.T) {
+	for _, weight := range append(ValidTextWeights(), "") {
+		c := &Component{ID: "title", Type: "text", Weight: weight}
+		if err := validateComponent(c, 0); err != nil {
+			t.Errorf("Expected weight %q to pass, got error: %v", weight, err)
+		}
+	}
+}
+
+func TestValidateComponent_TextAlign(t *testing.T) {
+	for _, align := range append(ValidTextAligns(), "") {
+		c := &Component{ID: "title", Type: "text", TextAlign: align}
+		if err := validateComponent(c, 0); err != nil {
+			t.Errorf("Expected text_align %q to pass, got error: %v", align, err)
+		}
+	}
+
+	c := &Component{ID: "title", Type: "text", TextAlign: "justify"}
+	err := validateComponent(c, 0)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError for text_align 'justify', got %v", err)
+	}
+	if verr.Code != ErrCodeInvalidTextAlign || verr.Field != "text_align" {
+		t.Errorf("Expected %s on field text_align, got %s on %s", ErrCodeInvalidTextAlign, verr.Code, verr.Field)
+	}
+}
+
 func TestValidateComponent_MaxNestingDepth(t *testing.T) {
 	// Create a component with 5 levels of nesting (exceeds max of 4)
 	c := &Component{
@@ -337,6 +462,22 @@ func TestValidateComponent_ValidNesting(t *testing.T) {
 	}
 }
 
+func TestStructure_MaxDepth(t *testing.T) {
+	s := &Structure{Components: []Component{
+		{ID: "header", Type: "box"},
+		{ID: "main", Type: "box", Children: []Component{
+			{ID: "card", Type: "box", Children: []Component{{ID: "title", Type: "text"}}},
+		}},
+	}}
+	if got := s.MaxDepth(); got != 2 {
+		t.Errorf("MaxDepth() = %d, expected 2 (top-level components are depth 0)", got)
+	}
+
+	if got := (&Structure{}).MaxDepth(); got != -1 {
+		t.Errorf("MaxDepth() of an empty structure = %d, expected -1", got)
+	}
+}
+
 func TestParseAndValidateStructure_Valid(t *testing.T) {
 	validJSON := `{
 		"version": "v1",
@@ -483,3 +624,46 @@ func TestStructure_JSONRoundTrip(t *testing.T) {
 		t.Errorf("Intent.Purpose mismatch: expected '%s', got '%s'", original.Intent.Purpose, parsed.Intent.Purpose)
 	}
 }
+
+func TestComponent_NoteAndMetaRoundTrip(t *testing.T) {
+	input := `{
+		"version": "v1",
+		"phase": "structure",
+		"created_at": "2025-10-25T12:00:00Z",
+		"intent": {"purpose": "Test", "primary_action": "Action", "user_context": "User"},
+		"layout": {"type": "stack", "direction": "vertical", "spacing": 8},
+		"components": [
+			{
+				"id": "cta",
+				"type": "button",
+				"role": "primary-action",
+				"content": "Start",
+				"note": "Kept above the fold after the March usability test",
+				"meta": {"owner": "growth", "ticket": "UX-42"}
+			}
+		]
+	}`
+
+	// Notes and metadata are not validated against the Phase 1 constraints
+	structure, err := ParseAndValidateStructure([]byte(input))
+	if err != nil {
+		t.Fatalf("ParseAndValidateStructure failed: %v", err)
+	}
+
+	data, err := json.Marshal(structure)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	parsed, err := ParseStructure(data)
+	if err != nil {
+		t.Fatalf("Failed to parse round-tripped JSON: %v", err)
+	}
+
+	cta := parsed.Components[0]
+	if cta.Note != "Kept above the fold after the March usability test" {
+		t.Errorf("Note not preserved, got %q", cta.Note)
+	}
+	if len(cta.Meta) != 2 || cta.Meta["owner"] != "growth" || cta.Meta["ticket"] != "UX-42" {
+		t.Errorf("Meta not preserved, got %v", cta.Meta)
+	}
+}
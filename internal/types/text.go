@@ -0,0 +1,59 @@
+package types
+
+import "strings"
+
+// textCharWidths approximates the average glyph width in pixels for each text size
+var textCharWidths = map[string]int{
+	"xs":   5,
+	"sm":   6,
+	"base": 7,
+	"lg":   9,
+	"xl":   11,
+	"2xl":  14,
+	"3xl":  18,
+}
+
+// TextCharWidth returns the approximate width of one character at the given text size
+// (unknown or empty sizes use the base width of 7px)
+func TextCharWidth(size string) int {
+	if width, ok := textCharWidths[size]; ok {
+		return width
+	}
+	return textCharWidths["base"]
+}
+
+// EstimatedTextWidth returns the approximate unscaled width of the component's longest
+// content line. This is the width model shared by the renderer and validators.
+func (c *Component) EstimatedTextWidth() int {
+	if c.Content == "" {
+		return 0
+	}
+
+	maxLen := 0
+	for _, line := range c.TextLines() {
+		if len(line) > maxLen {
+			maxLen = len(line)
+		}
+	}
+
+	return maxLen * TextCharWidth(c.Size)
+}
+
+// TextLines returns the component's content split into lines, with leading and
+// trailing blank lines dropped; blank lines between paragraphs are kept. Empty or
+// whitespace-only content has no lines. This is the line model shared by layout
+// and rendering.
+func (c *Component) TextLines() []string {
+	lines := strings.Split(c.Content, "\n")
+	start, end := 0, len(lines)
+	for start < end && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	for end > start && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+	if start == end {
+		return nil
+	}
+	return lines[start:end]
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTextCharWidth(t *testing.T) {
+	tests := []struct {
+		size     string
+		expected int
+	}{
+		{"xs", 5},
+		{"base", 7},
+		{"", 7},
+		{"2xl", 14},
+		{"huge", 7},
+	}
+
+	for _, tt := range tests {
+		if got := TextCharWidth(tt.size); got != tt.expected {
+			t.Errorf("TextCharWidth(%q) = %d, expected %d", tt.size, got, tt.expected)
+		}
+	}
+}
+
+func TestComponent_EstimatedTextWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		comp     Component
+		expected int
+	}{
+		{"empty", Component{Type: "text"}, 0},
+		{"base", Component{Type: "text", Content: "Hello"}, 35},
+		{"longest line wins", Component{Type: "text", Size: "2xl", Content: "Hi\nWelcome"}, 98},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.comp.EstimatedTextWidth(); got != tt.expected {
+				t.Errorf("EstimatedTextWidth() = %d, expected %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComponent_TextLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{"empty", "", nil},
+		{"whitespace only", "  \n\t\n ", nil},
+		{"trailing newlines", "Hello\n\n", []string{"Hello"}},
+		{"leading blank lines", "\n  \nHello", []string{"Hello"}},
+		{"inner blank line kept", "Hello\n\nWorld\n", []string{"Hello", "", "World"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comp := Component{Type: "text", Content: tt.content}
+			if got := comp.TextLines(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("TextLines() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
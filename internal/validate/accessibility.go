@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -11,9 +12,13 @@ import (
 type A11yRule struct {
 	RequireLabels         bool // All interactive elements need labels
 	RequireHeadingOrder   bool // h1 → h2 → h3 (no skipping)
-	MaxNestingDepth       int  // 4 levels
+	RequireSingleH1       bool // Text content needs headings, with exactly one h1
+	MaxNestingDepth       int  // deepest allowed depth, top-level components at 0 (types.MaxNestingDepth)
 	RequireFocusIndicator bool // All interactive elements
 	CheckTabOrder         bool // Verify logical tab sequence
+	RequireAltText        bool // Informative images need alt text
+	FlagWrappers          bool // Anonymous single-child boxes that could be flattened
+	RequireModalParts     bool // Modals need a close affordance and a title
 }
 
 // DefaultA11yRule returns the default accessibility validation rules
@@ -21,9 +26,13 @@ func DefaultA11yRule() A11yRule {
 	return A11yRule{
 		RequireLabels:         true,
 		RequireHeadingOrder:   true,
-		MaxNestingDepth:       4,
+		RequireSingleH1:       true,
+		MaxNestingDepth:       types.MaxNestingDepth,
 		RequireFocusIndicator: true,
 		CheckTabOrder:         true,
+		RequireAltText:        true,
+		FlagWrappers:          true,
+		RequireModalParts:     true,
 	}
 }
 
@@ -57,6 +66,8 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 	// Collect all components with their order and depth
 	orderedComponents := []ComponentWithOrder{}
 	interactiveComponents := []*types.Component{}
+	images := []*types.Component{}
+	textCount := 0
 	headings := []struct {
 		component *types.Component
 		level     int
@@ -64,7 +75,7 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 
 	var traverse func(comp *types.Component, order *int, depth int)
 	traverse = func(comp *types.Component, order *int, depth int) {
-		// Check nesting depth
+		// Check nesting depth, counting like types.Structure.MaxDepth (top level at 0)
 		if depth > rule.MaxNestingDepth {
 			result.Issues = append(result.Issues, A11yIssue{
 				Severity:  "error",
@@ -83,13 +94,46 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 		*order++
 
 		// Check if it's interactive
-		if isInteractiveElement(comp) {
+		if comp.IsInteractive() {
 			interactiveComponents = append(interactiveComponents, comp)
 		}
 
+		// Check for wrappers that add nesting without meaning
+		if rule.FlagWrappers && isUnnecessaryWrapper(comp) {
+			result.Issues = append(result.Issues, A11yIssue{
+				Severity:  "warning",
+				Message:   fmt.Sprintf("A11y: Box '%s' only wraps '%s' and has no role, background or border - consider flattening it (unnecessary wrapper)", comp.ID, comp.Children[0].ID),
+				Component: comp.ID,
+			})
+		}
+
+		// Check that modals can be dismissed and are announced by name
+		if rule.RequireModalParts && isModal(comp) {
+			if !hasCloseAffordance(comp) {
+				result.Issues = append(result.Issues, A11yIssue{
+					Severity:  "warning",
+					Message:   fmt.Sprintf("A11y: Modal '%s' has no close affordance - add a close button (e.g. 'close-btn') so it can be dismissed", comp.ID),
+					Component: comp.ID,
+				})
+			}
+			if !hasModalTitle(comp) {
+				result.Issues = append(result.Issues, A11yIssue{
+					Severity:  "warning",
+					Message:   fmt.Sprintf("A11y: Modal '%s' has no accessible label - add a title or heading so screen readers can announce it", comp.ID),
+					Component: comp.ID,
+				})
+			}
+		}
+
+		// Check if it's an image
+		if comp.Type == "image" {
+			images = append(images, comp)
+		}
+
 		// Check if it's a heading
 		if comp.Type == "text" {
-			level := getHeadingLevel(comp)
+			textCount++
+			level := comp.HeadingLevel()
 			if level > 0 {
 				headings = append(headings, struct {
 					component *types.Component
@@ -124,6 +168,20 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 		}
 	}
 
+	// Check for missing alt text on informative images
+	if rule.RequireAltText {
+		for _, comp := range images {
+			if comp.Alt == "" && comp.Role != "presentation" {
+				result.Issues = append(result.Issues, A11yIssue{
+					Severity:  "error",
+					Message:   fmt.Sprintf("A11y: Image '%s' missing alt text (use role 'presentation' if it is decorative)", comp.ID),
+					Component: comp.ID,
+				})
+				result.Passed = false
+			}
+		}
+	}
+
 	// Check heading order
 	if rule.RequireHeadingOrder && len(headings) > 1 {
 		for i := 1; i < len(headings); i++ {
@@ -142,6 +200,31 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 		}
 	}
 
+	// Check the document outline: text content needs headings, and only one h1
+	if rule.RequireSingleH1 {
+		h1s := []string{}
+		for _, heading := range headings {
+			if heading.level == 1 {
+				h1s = append(h1s, heading.component.ID)
+			}
+		}
+
+		if len(headings) == 0 && textCount > 0 {
+			result.Issues = append(result.Issues, A11yIssue{
+				Severity:  "warning",
+				Message:   fmt.Sprintf("A11y: Page has %d text component(s) but no headings - add an h1 so screen reader users can navigate the outline", textCount),
+				Component: "",
+			})
+		} else if len(h1s) > 1 {
+			result.Issues = append(result.Issues, A11yIssue{
+				Severity:  "error",
+				Message:   fmt.Sprintf("A11y: %d level-1 headings found (%s) - a page should have a single h1", len(h1s), strings.Join(h1s, ", ")),
+				Component: h1s[1],
+			})
+			result.Passed = false
+		}
+	}
+
 	// Check focus indicators
 	if rule.RequireFocusIndicator {
 		// In Phase 1 structure, we check that focus_indicators is defined in accessibility
@@ -165,7 +248,7 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 		// Analyze if interactive elements appear in a logical order
 		interactiveOrder := []ComponentWithOrder{}
 		for _, ordered := range orderedComponents {
-			if isInteractiveElement(ordered.Component) {
+			if ordered.Component.IsInteractive() {
 				interactiveOrder = append(interactiveOrder, ordered)
 			}
 		}
@@ -186,6 +269,8 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 				}
 			}
 		}
+
+		validateTabIndex(interactiveOrder, &result)
 	}
 
 	// Check semantic structure
@@ -231,15 +316,9 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 		}
 		
 		if len(orderedComponents) > 0 {
-			maxDepth := 0
-			for _, comp := range orderedComponents {
-				if comp.Depth > maxDepth {
-					maxDepth = comp.Depth
-				}
-			}
 			result.Issues = append(result.Issues, A11yIssue{
 				Severity: "info",
-				Message:  fmt.Sprintf("✓ Nesting depth (%d) within acceptable limits (%d)", maxDepth, rule.MaxNestingDepth),
+				Message:  fmt.Sprintf("✓ Nesting depth (%d) within acceptable limits (%d)", structure.MaxDepth(), rule.MaxNestingDepth),
 			})
 		}
 	}
@@ -247,32 +326,51 @@ func ValidateAccessibility(structure *types.Structure, rule A11yRule) A11yResult
 	return result
 }
 
-// getHeadingLevel extracts heading level from component ID or size
-func getHeadingLevel(comp *types.Component) int {
-	// Check ID for explicit heading level (h1, h2, h3, etc.)
-	idLower := strings.ToLower(comp.ID)
-	if strings.HasPrefix(idLower, "h") && len(idLower) >= 2 {
-		if idLower[1] >= '1' && idLower[1] <= '6' {
-			return int(idLower[1] - '0')
+// validateTabIndex checks explicit tabindex values for positive values, duplicates and gaps
+func validateTabIndex(interactiveOrder []ComponentWithOrder, result *A11yResult) {
+	byIndex := make(map[int][]string)
+	for _, ordered := range interactiveOrder {
+		comp := ordered.Component
+		if comp.TabIndex <= 0 {
+			continue
 		}
+
+		result.Issues = append(result.Issues, A11yIssue{
+			Severity:  "warning",
+			Message:   fmt.Sprintf("A11y: '%s' uses positive tabindex %d - prefer 0 and document order so focus follows the layout", comp.ID, comp.TabIndex),
+			Component: comp.ID,
+		})
+		byIndex[comp.TabIndex] = append(byIndex[comp.TabIndex], comp.ID)
+	}
+
+	indices := make([]int, 0, len(byIndex))
+	for index := range byIndex {
+		indices = append(indices, index)
 	}
+	sort.Ints(indices)
+
+	for i, index := range indices {
+		ids := byIndex[index]
+		if len(ids) > 1 {
+			result.Issues = append(result.Issues, A11yIssue{
+				Severity:  "warning",
+				Message:   fmt.Sprintf("A11y: Duplicate tabindex %d on %s - focus order between them is ambiguous", index, strings.Join(ids, ", ")),
+				Component: ids[0],
+			})
+		}
 
-	// Check for heading in ID or role
-	if strings.Contains(idLower, "heading") || strings.Contains(idLower, "title") {
-		// Infer level from size
-		sizeMap := map[string]int{
-			"4xl": 1,
-			"3xl": 2,
-			"2xl": 3,
-			"xl":  4,
+		expected := 1
+		if i > 0 {
+			expected = indices[i-1] + 1
 		}
-		
-		if level, ok := sizeMap[comp.Size]; ok {
-			return level
+		if index > expected {
+			result.Issues = append(result.Issues, A11yIssue{
+				Severity:  "info",
+				Message:   fmt.Sprintf("A11y: Gap in tabindex sequence before '%s' (expected %d, got %d)", ids[0], expected, index),
+				Component: ids[0],
+			})
 		}
 	}
-
-	return 0
 }
 
 // hasLabel checks if an interactive component has an associated label
@@ -323,6 +421,59 @@ func hasLabel(comp *types.Component, structure *types.Structure) bool {
 	return false
 }
 
+// isModal reports whether comp is declared as a modal dialog
+func isModal(comp *types.Component) bool {
+	return comp.Role == "modal" || comp.Role == "dialog"
+}
+
+// hasCloseAffordance reports whether any descendant looks like a way to dismiss
+// the component: a close control by ID or type, or a button labeled to dismiss
+func hasCloseAffordance(comp *types.Component) bool {
+	for i := range comp.Children {
+		child := &comp.Children[i]
+		if strings.Contains(strings.ToLower(child.ID), "close") ||
+			strings.Contains(strings.ToLower(child.Type), "close") {
+			return true
+		}
+		if child.IsInteractive() {
+			switch strings.ToLower(strings.TrimSpace(child.Content)) {
+			case "×", "x", "close", "cancel", "dismiss":
+				return true
+			}
+		}
+		if hasCloseAffordance(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasModalTitle reports whether any descendant is a heading or title text that can label the modal
+func hasModalTitle(comp *types.Component) bool {
+	for i := range comp.Children {
+		child := &comp.Children[i]
+		if child.BaseType() == "text" && child.Content != "" &&
+			(child.HeadingLevel() > 0 || strings.Contains(strings.ToLower(child.ID), "title")) {
+			return true
+		}
+		if hasModalTitle(child) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnnecessaryWrapper reports whether comp is an anonymous box around a single
+// child: with no role and nothing drawn, it only adds a level of nesting.
+func isUnnecessaryWrapper(comp *types.Component) bool {
+	if comp.Type != "box" || comp.Role != "" || len(comp.Children) != 1 {
+		return false
+	}
+	layout := comp.Layout
+	return layout.Background == "" && layout.Border == "" && layout.BorderBottom == "" &&
+		layout.BorderRight == "" && layout.Shadow == ""
+}
+
 // sharesPrefix checks if two component IDs share a common prefix
 func sharesPrefix(id1, id2 string) bool {
 	parts1 := strings.Split(id1, "-")
@@ -1,6 +1,8 @@
 package validate
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -255,26 +257,63 @@ func TestValidateAccessibility_ValidStructure(t *testing.T) {
 	}
 }
 
-func TestGetHeadingLevel(t *testing.T) {
-	tests := []struct {
-		comp     types.Component
-		expected int
-	}{
-		{types.Component{ID: "h1", Type: "text"}, 1},
-		{types.Component{ID: "h2", Type: "text"}, 2},
-		{types.Component{ID: "h3", Type: "text"}, 3},
-		{types.Component{ID: "title", Type: "text", Size: "4xl"}, 1},
-		{types.Component{ID: "heading", Type: "text", Size: "3xl"}, 2},
-		{types.Component{ID: "normal-text", Type: "text", Size: "base"}, 0},
+func TestValidateAccessibility_PositiveTabIndex(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "search-input", Type: "input", Content: "Search", TabIndex: 1},
+			{ID: "submit-btn", Type: "button", Content: "Submit"},
+		},
+		Accessibility: types.Accessibility{
+			FocusIndicators: "visible",
+		},
 	}
 
-	for _, test := range tests {
-		result := getHeadingLevel(&test.comp)
-		if result != test.expected {
-			t.Errorf("getHeadingLevel(%s, %s) = %d, expected %d",
-				test.comp.ID, test.comp.Size, result, test.expected)
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && issue.Component == "search-input" {
+			found = true
+			break
 		}
 	}
+
+	if !found {
+		t.Error("Expected warning about positive tabindex on search-input")
+	}
+}
+
+func TestValidateAccessibility_DuplicateTabIndex(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "email-input", Type: "input", Content: "Email", TabIndex: 1},
+			{ID: "password-input", Type: "input", Content: "Password", TabIndex: 1},
+			{ID: "submit-btn", Type: "button", Content: "Submit", TabIndex: 3},
+		},
+		Accessibility: types.Accessibility{
+			FocusIndicators: "visible",
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	foundDuplicate := false
+	foundGap := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && strings.Contains(issue.Message, "Duplicate tabindex 1") {
+			foundDuplicate = true
+		}
+		if issue.Severity == "info" && issue.Component == "submit-btn" && strings.Contains(issue.Message, "Gap") {
+			foundGap = true
+		}
+	}
+
+	if !foundDuplicate {
+		t.Error("Expected warning about duplicate tabindex")
+	}
+	if !foundGap {
+		t.Error("Expected info about gap in tabindex sequence")
+	}
 }
 
 func TestHasLabel(t *testing.T) {
@@ -332,3 +371,237 @@ func TestSharesPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAccessibility_ImageMissingAlt(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "hero-image", Type: "image"},
+			{ID: "avatar", Type: "image", Alt: "Profile photo of the account owner"},
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	if result.Passed {
+		t.Error("Expected validation to fail for image without alt text")
+	}
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Component == "avatar" && strings.Contains(issue.Message, "alt text") {
+			t.Errorf("Image with alt text should not be flagged: %s", issue.Message)
+		}
+		if issue.Component == "hero-image" && issue.Severity == "error" && strings.Contains(issue.Message, "missing alt text") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("Expected missing alt text error for 'hero-image'")
+	}
+}
+
+func TestValidateAccessibility_DecorativeImageExempt(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "divider-flourish", Type: "image", Role: "presentation"},
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	for _, issue := range result.Issues {
+		if strings.Contains(issue.Message, "alt text") {
+			t.Errorf("Decorative image should be exempt from alt text: %s", issue.Message)
+		}
+	}
+}
+
+func TestValidateAccessibility_NoHeadings(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "intro", Type: "text", Content: "Welcome back"},
+			{ID: "details", Type: "text", Content: "Here is what changed"},
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && strings.Contains(issue.Message, "no headings") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a no-headings warning, got: %v", result.Issues)
+	}
+
+	// A page without text content has no outline to speak of
+	empty := &types.Structure{Components: []types.Component{{ID: "hero-image", Type: "image", Alt: "Hero"}}}
+	for _, issue := range ValidateAccessibility(empty, DefaultA11yRule()).Issues {
+		if strings.Contains(issue.Message, "no headings") {
+			t.Errorf("Page without text should not need headings: %s", issue.Message)
+		}
+	}
+}
+
+func TestValidateAccessibility_MultipleH1(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "h1-title", Type: "text", Content: "Dashboard"},
+			{ID: "h2-section", Type: "text", Content: "Recent activity"},
+			{ID: "h1-footer", Type: "text", Content: "Footer"},
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	if result.Passed {
+		t.Error("Expected validation to fail with two h1 headings")
+	}
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "error" && issue.Component == "h1-footer" && strings.Contains(issue.Message, "single h1") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a multiple-h1 error on 'h1-footer', got: %v", result.Issues)
+	}
+
+	rule := DefaultA11yRule()
+	rule.RequireSingleH1 = false
+	for _, issue := range ValidateAccessibility(structure, rule).Issues {
+		if strings.Contains(issue.Message, "single h1") {
+			t.Errorf("Check should be off when RequireSingleH1 is false: %s", issue.Message)
+		}
+	}
+}
+
+func TestValidateAccessibility_NestingDepthAgreesWithParse(t *testing.T) {
+	// nested builds a chain of boxes whose innermost component sits at the given depth
+	nested := func(depth int) *types.Structure {
+		comp := types.Component{ID: "leaf", Type: "text", Content: "Leaf"}
+		for d := depth; d > 0; d-- {
+			comp = types.Component{ID: fmt.Sprintf("level-%d", d-1), Type: "box", Children: []types.Component{comp}}
+		}
+		return &types.Structure{
+			Version:    "v1",
+			Phase:      "structure",
+			Intent:     types.Intent{Purpose: "Nesting"},
+			Layout:     types.Layout{Type: "stack"},
+			Components: []types.Component{comp},
+		}
+	}
+
+	for _, depth := range []int{types.MaxNestingDepth, types.MaxNestingDepth + 1} {
+		structure := nested(depth)
+		if got := structure.MaxDepth(); got != depth {
+			t.Fatalf("MaxDepth() = %d, expected %d", got, depth)
+		}
+
+		parseErr := structure.ValidatePhase1()
+		flagged := false
+		for _, issue := range ValidateAccessibility(structure, DefaultA11yRule()).Issues {
+			if strings.Contains(issue.Message, "exceeds max nesting depth") {
+				flagged = true
+			}
+		}
+
+		if (parseErr != nil) != flagged {
+			t.Errorf("depth %d: parse validation error %v but accessibility flagged=%v", depth, parseErr, flagged)
+		}
+		if want := depth > types.MaxNestingDepth; flagged != want {
+			t.Errorf("depth %d: flagged=%v, expected %v", depth, flagged, want)
+		}
+	}
+}
+
+func TestValidateAccessibility_UnnecessaryWrapper(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "wrapper",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "h1-title", Type: "text", Content: "Dashboard"},
+				},
+			},
+			{
+				ID:     "card",
+				Type:   "box",
+				Layout: types.ComponentLayout{Border: "1px solid #E5E5E5"},
+				Children: []types.Component{
+					{ID: "h2-card", Type: "text", Content: "Revenue"},
+				},
+			},
+			{
+				ID:   "site-nav",
+				Type: "box",
+				Role: "navigation",
+				Children: []types.Component{
+					{ID: "home-link", Type: "button", Content: "Home"},
+				},
+			},
+		},
+	}
+
+	result := ValidateAccessibility(structure, DefaultA11yRule())
+
+	flagged := map[string]bool{}
+	for _, issue := range result.Issues {
+		if strings.Contains(issue.Message, "unnecessary wrapper") {
+			flagged[issue.Component] = true
+		}
+	}
+	if !flagged["wrapper"] {
+		t.Errorf("Expected 'wrapper' to be flagged, got: %v", result.Issues)
+	}
+	if flagged["card"] || flagged["site-nav"] {
+		t.Errorf("Containers with a border or role should pass, got: %v", flagged)
+	}
+}
+
+func TestValidateAccessibility_ModalParts(t *testing.T) {
+	modalIssues := func(children []types.Component) []string {
+		structure := &types.Structure{
+			Components: []types.Component{
+				{ID: "confirm-delete", Type: "box", Role: "modal", Children: children},
+			},
+		}
+		var messages []string
+		for _, issue := range ValidateAccessibility(structure, DefaultA11yRule()).Issues {
+			if issue.Component == "confirm-delete" && strings.Contains(issue.Message, "Modal") {
+				messages = append(messages, issue.Message)
+			}
+		}
+		return messages
+	}
+
+	bare := modalIssues([]types.Component{
+		{ID: "modal-title", Type: "text", Content: "Delete project?"},
+		{ID: "confirm-btn", Type: "button", Content: "Delete"},
+	})
+	if len(bare) != 1 || !strings.Contains(bare[0], "close affordance") {
+		t.Errorf("Expected a single close-affordance warning, got %v", bare)
+	}
+
+	untitled := modalIssues([]types.Component{
+		{ID: "body", Type: "text", Content: "This cannot be undone"},
+		{ID: "actions", Type: "box", Children: []types.Component{
+			{ID: "cancel-btn", Type: "button", Content: "Cancel"},
+		}},
+	})
+	if len(untitled) != 1 || !strings.Contains(untitled[0], "accessible label") {
+		t.Errorf("Expected a single label warning (Cancel closes the modal), got %v", untitled)
+	}
+
+	complete := modalIssues([]types.Component{
+		{ID: "modal-title", Type: "text", Content: "Delete project?"},
+		{ID: "close-btn", Type: "button", Content: "×"},
+	})
+	if len(complete) != 0 {
+		t.Errorf("Expected a complete modal to pass, got %v", complete)
+	}
+}
@@ -0,0 +1,246 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// Score penalties applied per issue when scoring a validator (scores are clamped to 0-100)
+const (
+	errorPenalty   = 15
+	warningPenalty = 5
+)
+
+// AuditEntry is the outcome of a single validator within an audit
+type AuditEntry struct {
+	Name       string      `json:"name"`  // machine name, e.g. "touch_targets"
+	Title      string      `json:"title"` // display name, e.g. "Touch Targets (Fitts's Law)"
+	Passed     bool        `json:"passed"`
+	Score      int         `json:"score"` // 0-100
+	Errors     int         `json:"errors"`
+	Warnings   int         `json:"warnings"`
+	Infos      int         `json:"infos"`
+	IssueCount int         `json:"issue_count"`
+	Issues     interface{} `json:"issues"` // the validator's own issue slice
+}
+
+// AuditIssue is a validator issue reduced to the fields every validator shares
+type AuditIssue struct {
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	ComponentID string `json:"component_id,omitempty"`
+}
+
+// AuditReport is the combined result of running every validator on a structure
+type AuditReport struct {
+	Passed       bool         `json:"passed"`
+	OverallScore int          `json:"overall_score"` // average of validator scores
+	Entries      []AuditEntry `json:"validators"`
+	Skipped      []string     `json:"skipped,omitempty"` // validators left out by the selection
+}
+
+// auditValidatorNames lists every audit validator in the order they run
+var auditValidatorNames = registeredAuditNames()
+
+// AuditValidatorNames returns the machine names of every audit validator in run order
+func AuditValidatorNames() []string {
+	return slices.Clone(auditValidatorNames)
+}
+
+// AuditRules holds the rules for the validators whose thresholds can be configured
+// and which validators run
+type AuditRules struct {
+	Spacing        SpacingRule
+	Typography     TypographyRule
+	ChoiceOverload ChoiceRule
+	Contrast       ContrastRule
+	Palette        PaletteRule
+	Validators     []string // machine names to run; empty runs every validator
+}
+
+// SelectAuditValidators resolves --only and --skip lists to the validators to run,
+// in run order. Names may use hyphens (dark-mode) or underscores (dark_mode).
+func SelectAuditValidators(only, skip []string) ([]string, error) {
+	normalize := func(names []string) ([]string, error) {
+		normalized := []string{}
+		for _, name := range names {
+			name = strings.ReplaceAll(strings.TrimSpace(name), "-", "_")
+			if name == "" {
+				continue
+			}
+			if !slices.Contains(auditValidatorNames, name) {
+				return nil, fmt.Errorf("unknown validator '%s' (known: %s)", name, strings.Join(auditValidatorNames, ", "))
+			}
+			normalized = append(normalized, name)
+		}
+		return normalized, nil
+	}
+
+	onlyNames, err := normalize(only)
+	if err != nil {
+		return nil, err
+	}
+	skipNames, err := normalize(skip)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := []string{}
+	for _, name := range auditValidatorNames {
+		if len(onlyNames) > 0 && !slices.Contains(onlyNames, name) {
+			continue
+		}
+		if slices.Contains(skipNames, name) {
+			continue
+		}
+		selected = append(selected, name)
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no validators left to run after applying the selection")
+	}
+	return selected, nil
+}
+
+// DefaultAuditRules returns the default rules used by RunAudit
+func DefaultAuditRules() AuditRules {
+	return AuditRules{
+		Spacing:        DefaultSpacingRule(),
+		Typography:     DefaultTypographyRule(),
+		ChoiceOverload: DefaultChoiceRule(),
+		Contrast:       DefaultContrastRule(),
+		Palette:        DefaultPaletteRule(),
+	}
+}
+
+// RunAudit runs all validators with their default rules and scores each one
+func RunAudit(structure *types.Structure) AuditReport {
+	return RunAuditWithRules(structure, DefaultAuditRules())
+}
+
+// RunAuditWithRules runs the selected validators, using the given rules where configurable
+func RunAuditWithRules(structure *types.Structure, rules AuditRules) AuditReport {
+	report := AuditReport{Passed: true}
+
+	enabled := func(name string) bool {
+		if len(rules.Validators) == 0 || slices.Contains(rules.Validators, name) {
+			return true
+		}
+		report.Skipped = append(report.Skipped, name)
+		return false
+	}
+
+	add := func(name, title string, passed bool, issues interface{}) {
+		entry := AuditEntry{
+			Name:   name,
+			Title:  title,
+			Passed: passed,
+			Issues: issues,
+		}
+
+		for _, issue := range auditIssues(issues) {
+			switch issue.Severity {
+			case "error":
+				entry.Errors++
+			case "warning":
+				entry.Warnings++
+			case "info":
+				entry.Infos++
+			}
+			entry.IssueCount++
+		}
+
+		entry.Score = 100 - entry.Errors*errorPenalty - entry.Warnings*warningPenalty
+		if entry.Score < 0 {
+			entry.Score = 0
+		}
+
+		report.Entries = append(report.Entries, entry)
+		report.Passed = report.Passed && passed
+	}
+
+	// Proximity, target spacing and button prominence are measured on the desktop
+	// layout; without it the declared sizes and gaps are used
+	boxes, _ := render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+
+	for _, v := range validators {
+		if !v.Audit || !enabled(v.Name) {
+			continue
+		}
+		if v.applies != nil && !v.applies(structure, rules) {
+			continue
+		}
+		passed, issues := v.run(structure, boxes, rules)
+		add(v.Name, v.Title, passed, issues)
+	}
+
+	total := 0
+	for _, entry := range report.Entries {
+		total += entry.Score
+	}
+	if len(report.Entries) > 0 {
+		report.OverallScore = total / len(report.Entries)
+	}
+
+	return report
+}
+
+// Entry returns the audit entry for a validator by machine name
+func (r AuditReport) Entry(name string) (AuditEntry, bool) {
+	for _, entry := range r.Entries {
+		if entry.Name == name {
+			return entry, true
+		}
+	}
+	return AuditEntry{}, false
+}
+
+// IssueList returns the entry's issues in their common form
+func (e AuditEntry) IssueList() []AuditIssue {
+	return auditIssues(e.Issues)
+}
+
+// auditIssues reads the Severity, Message and component fields from each element of a validator's issue slice
+func auditIssues(issues interface{}) []AuditIssue {
+	v := reflect.ValueOf(issues)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	list := make([]AuditIssue, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := reflect.Indirect(v.Index(i))
+		if item.Kind() != reflect.Struct {
+			continue
+		}
+		list = append(list, AuditIssue{
+			Severity:    stringField(item, "Severity"),
+			Message:     stringField(item, "Message"),
+			ComponentID: firstNonEmpty(stringField(item, "ComponentID"), stringField(item, "Component")),
+		})
+	}
+	return list
+}
+
+// stringField returns the named string field of a struct value, or "" if absent
+func stringField(v reflect.Value, name string) string {
+	field := v.FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+// firstNonEmpty returns the first non-empty string
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
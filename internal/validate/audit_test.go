@@ -0,0 +1,153 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestRunAudit(t *testing.T) {
+	structure := &types.Structure{
+		Accessibility: types.Accessibility{FocusIndicators: "visible"},
+		Components: []types.Component{
+			{ID: "hero-image", Type: "image"}, // missing alt text
+			{ID: "submit", Type: "button", Content: "Submit", Layout: types.ComponentLayout{Width: 120, Height: 44}},
+		},
+	}
+
+	report := RunAudit(structure)
+
+	// No palette is declared, so palette adherence is left out of the score
+	if len(report.Entries) != 13 {
+		t.Fatalf("Expected 13 validators, got %d", len(report.Entries))
+	}
+	if _, ok := report.Entry("palette"); ok {
+		t.Error("Expected no palette entry without a declared palette")
+	}
+
+	a11y, ok := report.Entry("accessibility")
+	if !ok {
+		t.Fatal("Expected an accessibility entry")
+	}
+	if a11y.Passed || a11y.Errors == 0 {
+		t.Errorf("Expected accessibility to fail with errors, got %+v", a11y)
+	}
+	if expected := 100 - a11y.Errors*errorPenalty - a11y.Warnings*warningPenalty; a11y.Score != expected && a11y.Score != 0 {
+		t.Errorf("Accessibility score = %d, expected %d", a11y.Score, expected)
+	}
+	if report.Passed {
+		t.Error("Expected overall audit to fail")
+	}
+
+	total := 0
+	for _, entry := range report.Entries {
+		if entry.Score < 0 || entry.Score > 100 {
+			t.Errorf("%s score %d out of range", entry.Name, entry.Score)
+		}
+		if entry.IssueCount < entry.Errors+entry.Warnings+entry.Infos {
+			t.Errorf("%s issue count %d lower than severity counts", entry.Name, entry.IssueCount)
+		}
+		total += entry.Score
+	}
+	if report.OverallScore != total/len(report.Entries) {
+		t.Errorf("OverallScore = %d, expected average %d", report.OverallScore, total/len(report.Entries))
+	}
+}
+
+func TestRunAudit_DeclaredPalette(t *testing.T) {
+	structure := &types.Structure{
+		Phase:      "design",
+		Palette:    []string{"#FFFFFF", "#000000"},
+		Components: []types.Component{{ID: "title", Type: "text", Content: "Settings", Color: "#737373"}},
+	}
+
+	report := RunAudit(structure)
+	if len(report.Entries) != 14 {
+		t.Fatalf("Expected 14 validators with a declared palette, got %d", len(report.Entries))
+	}
+	if entry, ok := report.Entry("palette"); !ok || entry.Passed {
+		t.Errorf("Expected palette adherence to flag #737373, got %+v", entry)
+	}
+
+	rules := DefaultAuditRules()
+	rules.Palette.Colors = []string{"#FFFFFF", "#000000"}
+	structure.Palette = nil
+	if _, ok := RunAuditWithRules(structure, rules).Entry("palette"); !ok {
+		t.Error("Expected a configured palette to include palette adherence")
+	}
+}
+
+func TestRunAudit_UnknownEntry(t *testing.T) {
+	report := RunAudit(&types.Structure{})
+	if _, ok := report.Entry("does_not_exist"); ok {
+		t.Error("Expected no entry for an unknown validator name")
+	}
+}
+
+func TestRunAuditWithRules_Selection(t *testing.T) {
+	rules := DefaultAuditRules()
+	rules.Validators = []string{"contrast", "hierarchy"}
+
+	report := RunAuditWithRules(&types.Structure{}, rules)
+	if len(report.Entries) != 2 || report.Entries[0].Name != "hierarchy" || report.Entries[1].Name != "contrast" {
+		t.Fatalf("Expected hierarchy and contrast in run order, got %+v", report.Entries)
+	}
+	if len(report.Skipped) != 12 {
+		t.Errorf("Expected 12 skipped validators, got %v", report.Skipped)
+	}
+}
+
+func TestSelectAuditValidators(t *testing.T) {
+	tests := []struct {
+		name     string
+		only     []string
+		skip     []string
+		expected []string
+		err      string
+	}{
+		{name: "only", only: []string{"contrast", "hierarchy"}, expected: []string{"hierarchy", "contrast"}},
+		{name: "skip with hyphens", skip: []string{"dark-mode", "elevation", "touch-targets", "gestalt", "accessibility", "choice-overload", "spacing", "typography", "loading-states", "responsive", "palette"}, expected: []string{"hierarchy", "contrast", "focus"}},
+		{name: "only then skip", only: []string{"focus", "contrast"}, skip: []string{"focus"}, expected: []string{"contrast"}},
+		{name: "unknown name", only: []string{"colour"}, err: "unknown validator 'colour'"},
+		{name: "nothing left", only: []string{"focus"}, skip: []string{"focus"}, err: "no validators left"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := SelectAuditValidators(tt.only, tt.skip)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("Expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SelectAuditValidators failed: %v", err)
+			}
+			if !reflect.DeepEqual(selected, tt.expected) {
+				t.Errorf("SelectAuditValidators() = %v, expected %v", selected, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAuditEntry_IssueList(t *testing.T) {
+	entry := AuditEntry{Issues: []A11yIssue{
+		{Severity: "error", Message: "A11y: missing label", Component: "email"},
+	}}
+	issues := entry.IssueList()
+	if len(issues) != 1 {
+		t.Fatalf("Expected 1 issue, got %d", len(issues))
+	}
+	expected := AuditIssue{Severity: "error", Message: "A11y: missing label", ComponentID: "email"}
+	if issues[0] != expected {
+		t.Errorf("IssueList() = %+v, expected %+v", issues[0], expected)
+	}
+
+	entry = AuditEntry{Issues: []FocusIssue{{Severity: "warning", Message: "Focus", ComponentID: "btn"}}}
+	if got := entry.IssueList()[0].ComponentID; got != "btn" {
+		t.Errorf("Expected ComponentID field to be read, got %q", got)
+	}
+}
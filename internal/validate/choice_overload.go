@@ -47,10 +47,14 @@ func ValidateChoiceOverload(structure *types.Structure, rule ChoiceRule) ChoiceR
 	}
 
 	// Track containers and their interactive element counts
-	var analyzeContainer func(comp *types.Component, depth int)
-	analyzeContainer = func(comp *types.Component, depth int) {
+	// insideNav is set while walking the structural wrappers of a navigation that was already counted
+	var analyzeContainer func(comp *types.Component, depth int, insideNav bool)
+	analyzeContainer = func(comp *types.Component, depth int, insideNav bool) {
+		// Wrappers inside a counted navigation are part of it, not separate navigations
+		countedByParent := insideNav && isStructuralWrapper(comp)
+
 		// Check if this is a navigation container
-		if isNavigationContainer(comp) {
+		if isNavigationContainer(comp) && !countedByParent {
 			navItemCount := countInteractiveChildren(comp)
 			if navItemCount > rule.MaxNavItems {
 				result.Issues = append(result.Issues, ChoiceIssue{
@@ -107,14 +111,15 @@ func ValidateChoiceOverload(structure *types.Structure, rule ChoiceRule) ChoiceR
 		}
 
 		// Recurse into children
+		childInsideNav := countedByParent || isNavigationContainer(comp)
 		for i := range comp.Children {
-			analyzeContainer(&comp.Children[i], depth+1)
+			analyzeContainer(&comp.Children[i], depth+1, childInsideNav)
 		}
 	}
 
 	// Analyze all top-level components
 	for i := range structure.Components {
-		analyzeContainer(&structure.Components[i], 0)
+		analyzeContainer(&structure.Components[i], 0, false)
 	}
 
 	return result
@@ -168,19 +173,26 @@ func isCardGrid(comp *types.Component) bool {
 		len(comp.Children) > 0
 }
 
-// countInteractiveChildren counts interactive elements in direct children
+// countInteractiveChildren counts interactive elements in children, looking through
+// structural wrappers so items grouped in inner boxes are still counted
 func countInteractiveChildren(comp *types.Component) int {
 	count := 0
 	for i := range comp.Children {
-		if isInteractiveElement(&comp.Children[i]) {
+		child := &comp.Children[i]
+		if child.IsInteractive() {
 			count++
+		} else if isStructuralWrapper(child) {
+			count += countInteractiveChildren(child)
 		}
-		// Also count nested interactive elements (e.g., nav items with links)
-		count += countInteractiveChildren(&comp.Children[i])
 	}
 	return count
 }
 
+// isStructuralWrapper checks if a component is a plain grouping box without its own role
+func isStructuralWrapper(comp *types.Component) bool {
+	return (comp.Type == "box" || comp.Type == "container") && comp.Role == ""
+}
+
 // countFormFields counts input fields in a form
 func countFormFields(comp *types.Component) int {
 	count := 0
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -54,6 +55,58 @@ func TestValidateChoiceOverload_NavigationOverload(t *testing.T) {
 	}
 }
 
+func TestValidateChoiceOverload_NestedNavigationItems(t *testing.T) {
+	// Items grouped under role-less wrappers still count toward the navigation
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "main-nav",
+				Type: "box",
+				Role: "navigation",
+				Children: []types.Component{
+					{
+						ID:   "nav-primary",
+						Type: "box",
+						Children: []types.Component{
+							{ID: "item1", Type: "button"},
+							{ID: "item2", Type: "button"},
+							{ID: "item3", Type: "button"},
+							{ID: "item4", Type: "button"},
+						},
+					},
+					{
+						ID:   "nav-secondary",
+						Type: "box",
+						Children: []types.Component{
+							{ID: "item5", Type: "button"},
+							{ID: "item6", Type: "button"},
+							{ID: "item7", Type: "button"},
+							{ID: "item8", Type: "button"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result := ValidateChoiceOverload(structure, DefaultChoiceRule())
+
+	navIssues := []ChoiceIssue{}
+	for _, issue := range result.Issues {
+		if issue.Category == "navigation_overload" {
+			navIssues = append(navIssues, issue)
+		}
+	}
+
+	// The wrappers are part of main-nav and must not be reported on their own
+	if len(navIssues) != 1 {
+		t.Fatalf("Expected 1 navigation_overload issue, got %d: %+v", len(navIssues), navIssues)
+	}
+	if navIssues[0].ComponentID != "main-nav" || !strings.Contains(navIssues[0].Message, "8 items") {
+		t.Errorf("Expected main-nav with 8 items, got %s: %s", navIssues[0].ComponentID, navIssues[0].Message)
+	}
+}
+
 func TestValidateChoiceOverload_FormFieldOverload(t *testing.T) {
 	// Too many form fields (>7)
 	structure := &types.Structure{
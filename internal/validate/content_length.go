@@ -0,0 +1,122 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// ContentLengthIssue represents content that is too long for its component
+type ContentLengthIssue struct {
+	ComponentID    string `json:"component_id"`
+	Message        string `json:"message"`
+	Severity       string `json:"severity"` // "warning", "info"
+	EstimatedWidth int    `json:"estimated_width"`
+	AvailableWidth int    `json:"available_width"`
+}
+
+// ContentLengthResult contains the validation results
+type ContentLengthResult struct {
+	Passed bool                 `json:"passed"`
+	Issues []ContentLengthIssue `json:"issues"`
+}
+
+// ContentLengthRule defines the content length validation rules
+type ContentLengthRule struct {
+	ViewportWidth int     // Width assumed for the page when layout.max_width is unset (default: 1200)
+	WarningRatio  float64 // Estimated/available width ratio above which content is a warning (default: 1.5)
+}
+
+// DefaultContentLengthRule returns the default content length validation rules
+func DefaultContentLengthRule() ContentLengthRule {
+	return ContentLengthRule{
+		ViewportWidth: 1200,
+		WarningRatio:  1.5,
+	}
+}
+
+// ValidateContentLength estimates the rendered width of text content and flags
+// components whose content cannot plausibly fit in the width available to them
+func ValidateContentLength(structure *types.Structure, rule ContentLengthRule) ContentLengthResult {
+	result := ContentLengthResult{
+		Passed: true,
+		Issues: []ContentLengthIssue{},
+	}
+
+	available := rule.ViewportWidth
+	if structure.Layout.MaxWidth > 0 && structure.Layout.MaxWidth < available {
+		available = structure.Layout.MaxWidth
+	}
+	available -= structure.Layout.Padding * 2
+
+	for i := range structure.Components {
+		validateComponentContentLength(&result, &structure.Components[i], available, rule)
+	}
+
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" {
+			result.Passed = false
+			break
+		}
+	}
+
+	return result
+}
+
+// validateComponentContentLength checks a component against the width its parent offers
+// and recurses into its children with the component's inner width
+func validateComponentContentLength(result *ContentLengthResult, comp *types.Component, available int, rule ContentLengthRule) {
+	width := available
+	if comp.Layout.Width > 0 {
+		width = comp.Layout.Width
+	} else if comp.Layout.MaxWidth > 0 && comp.Layout.MaxWidth < width {
+		width = comp.Layout.MaxWidth
+	}
+	inner := width - comp.Layout.Padding*2
+
+	if comp.Content != "" && inner > 0 {
+		estimated := comp.EstimatedTextWidth()
+		ratio := float64(estimated) / float64(inner)
+
+		if ratio > 1 {
+			severity := "info"
+			message := fmt.Sprintf("Content: '%s' text is ~%dpx wide but only %dpx is available - it will wrap or overflow", comp.ID, estimated, inner)
+			if ratio > rule.WarningRatio {
+				severity = "warning"
+				message = fmt.Sprintf("Content: '%s' text is ~%dpx wide but only %dpx is available (%.1fx) - shorten the copy or use a smaller size", comp.ID, estimated, inner, ratio)
+			}
+			result.Issues = append(result.Issues, ContentLengthIssue{
+				ComponentID:    comp.ID,
+				Message:        message,
+				Severity:       severity,
+				EstimatedWidth: estimated,
+				AvailableWidth: inner,
+			})
+		}
+	}
+
+	if len(comp.Children) == 0 {
+		return
+	}
+
+	// Horizontal children share the row: fixed widths first, the rest split evenly
+	childWidth := inner
+	if comp.Layout.Direction == "horizontal" {
+		remaining := inner - comp.Layout.Gap*(len(comp.Children)-1)
+		flexible := 0
+		for _, child := range comp.Children {
+			if child.Layout.Width > 0 {
+				remaining -= child.Layout.Width
+			} else {
+				flexible++
+			}
+		}
+		if flexible > 0 {
+			childWidth = remaining / flexible
+		}
+	}
+
+	for i := range comp.Children {
+		validateComponentContentLength(result, &comp.Children[i], childWidth, rule)
+	}
+}
@@ -0,0 +1,85 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestValidateContentLength(t *testing.T) {
+	tests := []struct {
+		name             string
+		component        types.Component
+		expectedSeverity string // "" for no issue
+	}{
+		{
+			name:      "short heading fits",
+			component: types.Component{ID: "title", Type: "text", Size: "2xl", Content: "Dashboard"},
+		},
+		{
+			name:             "slightly too long wraps",
+			component:        types.Component{ID: "title", Type: "text", Size: "2xl", Content: "A heading that is a little too long", Layout: types.ComponentLayout{Width: 400}},
+			expectedSeverity: "info",
+		},
+		{
+			name:             "far too long overflows",
+			component:        types.Component{ID: "title", Type: "text", Size: "2xl", Content: "A very long heading that goes on and on and will never fit in this slot", Layout: types.ComponentLayout{Width: 400}},
+			expectedSeverity: "warning",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			structure := &types.Structure{Components: []types.Component{tt.component}}
+			result := ValidateContentLength(structure, DefaultContentLengthRule())
+
+			if tt.expectedSeverity == "" {
+				if len(result.Issues) != 0 {
+					t.Errorf("Expected no issues, got %v", result.Issues)
+				}
+				return
+			}
+			if len(result.Issues) != 1 {
+				t.Fatalf("Expected 1 issue, got %v", result.Issues)
+			}
+			if got := result.Issues[0].Severity; got != tt.expectedSeverity {
+				t.Errorf("Expected severity %s, got %s", tt.expectedSeverity, got)
+			}
+			if result.Passed != (tt.expectedSeverity != "warning") {
+				t.Errorf("Expected Passed=%v, got %v", tt.expectedSeverity != "warning", result.Passed)
+			}
+		})
+	}
+}
+
+func TestValidateContentLength_HorizontalSiblingsShareWidth(t *testing.T) {
+	// 30 base characters need ~210px; alone in a 400px card they fit, split three ways they do not
+	label := "Thirty characters of label...."
+	newCard := func(children ...types.Component) *types.Structure {
+		return &types.Structure{Components: []types.Component{{
+			ID:       "card",
+			Type:     "box",
+			Layout:   types.ComponentLayout{Width: 400, Direction: "horizontal"},
+			Children: children,
+		}}}
+	}
+
+	alone := ValidateContentLength(newCard(types.Component{ID: "a", Type: "text", Content: label}), DefaultContentLengthRule())
+	if len(alone.Issues) != 0 {
+		t.Errorf("Expected single child to fit, got %v", alone.Issues)
+	}
+
+	shared := ValidateContentLength(newCard(
+		types.Component{ID: "a", Type: "text", Content: label},
+		types.Component{ID: "b", Type: "text", Content: label},
+		types.Component{ID: "c", Type: "text", Content: label},
+	), DefaultContentLengthRule())
+	if len(shared.Issues) != 3 {
+		t.Errorf("Expected all three siblings flagged, got %v", shared.Issues)
+	}
+	for _, issue := range shared.Issues {
+		if issue.AvailableWidth != 133 {
+			t.Errorf("Expected 133px available for '%s', got %d", issue.ComponentID, issue.AvailableWidth)
+		}
+	}
+}
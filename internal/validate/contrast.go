@@ -2,10 +2,11 @@ package validate
 
 import (
 	"fmt"
+	"image/color"
 	"math"
-	"strconv"
 	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -29,6 +30,20 @@ func DefaultContrastRule() ContrastRule {
 	}
 }
 
+// ContrastRuleForLevel returns the contrast rule for a WCAG conformance level: "AA"
+// (the default) or "AAA", which also requires 7:1 for normal and 4.5:1 for large text
+func ContrastRuleForLevel(level string) (ContrastRule, error) {
+	rule := DefaultContrastRule()
+	switch strings.ToUpper(level) {
+	case "", "AA":
+	case "AAA":
+		rule.RequireWCAG_AAA = true
+	default:
+		return rule, fmt.Errorf("unknown WCAG level '%s' (must be AA or AAA)", level)
+	}
+	return rule, nil
+}
+
 // ContrastIssue represents a single contrast validation issue
 type ContrastIssue struct {
 	Severity       string  // "error", "warning", "info"
@@ -54,14 +69,12 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 		Issues: []ContrastIssue{},
 	}
 
+	backgrounds := types.ComputeEffectiveBackgrounds(structure)
+
 	// Analyze all components for text/background color combinations
-	var analyzeComponent func(comp *types.Component, parentBg string, depth int)
-	analyzeComponent = func(comp *types.Component, parentBg string, depth int) {
-		// Determine the effective background color for this component
-		effectiveBg := parentBg
-		if comp.Layout.Background != "" {
-			effectiveBg = comp.Layout.Background
-		}
+	var analyzeComponent func(comp *types.Component)
+	analyzeComponent = func(comp *types.Component) {
+		effectiveBg := backgrounds[comp.ID]
 
 		// Check if this component has text with a color
 		if comp.Type == "text" && comp.Color != "" && effectiveBg != "" {
@@ -82,7 +95,7 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 				result.Issues = append(result.Issues, ContrastIssue{
 					Severity:        "error",
 					Category:        "contrast_fail",
-					Message:         fmt.Sprintf("Contrast: '%s' (%s) on %s fails WCAG AA (%.1f:1, requires %.1f:1)", comp.ID, comp.Color, effectiveBg, ratio, requiredRatio),
+					Message:         fmt.Sprintf("Contrast: '%s' (%s) on %s %s", comp.ID, comp.Color, effectiveBg, wcagFailure(rule, ratio, requiredRatio, aaaTextRatio(isLargeText))),
 					ComponentID:     comp.ID,
 					ForegroundColor: comp.Color,
 					BackgroundColor: effectiveBg,
@@ -105,10 +118,7 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 				}
 			} else if rule.RequireWCAG_AAA {
 				// Check AAA compliance
-				aaaRatio := 7.0
-				if isLargeText {
-					aaaRatio = 4.5
-				}
+				aaaRatio := aaaTextRatio(isLargeText)
 				
 				if ratio < aaaRatio {
 					result.Issues = append(result.Issues, ContrastIssue{
@@ -121,15 +131,20 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 						ContrastRatio:   ratio,
 						RequiredRatio:   aaaRatio,
 					})
+					result.Passed = false
 				}
 			}
 		}
 
 		// Check button text contrast
 		if comp.Type == "button" && comp.Content != "" {
-			// Buttons typically have white text on colored background
-			textColor := "#FFFFFF" // Default button text color
-			buttonBg := effectiveBg
+			// Match the renderer: the button's own color, else white text, on its own
+			// background, else black
+			textColor := "#FFFFFF"
+			if comp.Color != "" {
+				textColor = comp.Color
+			}
+			buttonBg := "#000000"
 			if comp.Layout.Background != "" {
 				buttonBg = comp.Layout.Background
 			}
@@ -142,7 +157,7 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 					result.Issues = append(result.Issues, ContrastIssue{
 						Severity:        "error",
 						Category:        "contrast_fail",
-						Message:         fmt.Sprintf("Contrast: Button '%s' text (%s) on %s fails WCAG AA (%.1f:1, requires %.1f:1)", comp.ID, textColor, buttonBg, ratio, requiredRatio),
+						Message:         fmt.Sprintf("Contrast: Button '%s' text (%s) on %s %s", comp.ID, textColor, buttonBg, wcagFailure(rule, ratio, requiredRatio, aaaTextRatio(false))),
 						ComponentID:     comp.ID,
 						ForegroundColor: textColor,
 						BackgroundColor: buttonBg,
@@ -150,30 +165,57 @@ func ValidateContrast(structure *types.Structure, rule ContrastRule) ContrastRes
 						RequiredRatio:   requiredRatio,
 					})
 					result.Passed = false
+				} else if rule.RequireWCAG_AAA && ratio < 7.0 {
+					result.Issues = append(result.Issues, ContrastIssue{
+						Severity:        "warning",
+						Category:        "contrast_aaa",
+						Message:         fmt.Sprintf("Contrast: Button '%s' text passes AA but fails AAA (%.1f:1, requires 7.0:1 for AAA)", comp.ID, ratio),
+						ComponentID:     comp.ID,
+						ForegroundColor: textColor,
+						BackgroundColor: buttonBg,
+						ContrastRatio:   ratio,
+						RequiredRatio:   7.0,
+					})
+					result.Passed = false
 				}
 			}
 		}
 
 		// Recurse into children
 		for i := range comp.Children {
-			analyzeComponent(&comp.Children[i], effectiveBg, depth+1)
+			analyzeComponent(&comp.Children[i])
 		}
 	}
 
-	// Default background is white for Phase 1
-	defaultBg := "#FFFFFF"
-	
 	// Analyze all top-level components
 	for i := range structure.Components {
-		analyzeComponent(&structure.Components[i], defaultBg, 0)
+		analyzeComponent(&structure.Components[i])
 	}
 
 	return result
 }
 
-// calculateContrastRatio calculates the WCAG contrast ratio between two colors
+// aaaTextRatio returns the WCAG AAA minimum for normal or large text
+func aaaTextRatio(large bool) float64 {
+	if large {
+		return 4.5
+	}
+	return 7.0
+}
+
+// wcagFailure describes a ratio below the AA minimum, naming the level the rule
+// enforces: under AAA the AAA requirement leads and the missed AA minimum follows
+func wcagFailure(rule ContrastRule, ratio, aaRatio, aaaRatio float64) string {
+	if rule.RequireWCAG_AAA {
+		return fmt.Sprintf("fails WCAG AAA (%.1f:1, requires %.1f:1; below even the AA minimum of %.1f:1)", ratio, aaaRatio, aaRatio)
+	}
+	return fmt.Sprintf("fails WCAG AA (%.1f:1, requires %.1f:1)", ratio, aaRatio)
+}
+
+// calculateContrastRatio calculates the WCAG contrast ratio between two colors.
+// A translucent foreground is measured as it appears, blended over the background.
 func calculateContrastRatio(fg, bg string) float64 {
-	fgLum := relativeLuminance(fg)
+	fgLum := relativeLuminance(blendOver(fg, bg))
 	bgLum := relativeLuminance(bg)
 	
 	lighter := math.Max(fgLum, bgLum)
@@ -209,28 +251,26 @@ func gammaCorrect(channel float64) float64 {
 	return math.Pow((channel+0.055)/1.055, 2.4)
 }
 
-// hexToRGB converts a hex color string to RGB values
+// hexToRGB converts a hex color string to RGB values, ignoring any alpha channel.
+// Parsing is shared with (and cached by) the renderer; invalid colors yield black.
 func hexToRGB(hexColor string) (r, g, b int) {
-	// Remove # if present
-	hex := strings.TrimPrefix(hexColor, "#")
-	
-	// Parse hex values
-	if len(hex) == 6 {
-		val, _ := strconv.ParseInt(hex, 16, 64)
-		r = int((val >> 16) & 0xFF)
-		g = int((val >> 8) & 0xFF)
-		b = int(val & 0xFF)
-	} else if len(hex) == 3 {
-		// Handle shorthand hex (#RGB)
-		rh, _ := strconv.ParseInt(string(hex[0]), 16, 64)
-		gh, _ := strconv.ParseInt(string(hex[1]), 16, 64)
-		bh, _ := strconv.ParseInt(string(hex[2]), 16, 64)
-		r = int(rh*17) // Convert F to FF
-		g = int(gh*17)
-		b = int(bh*17)
+	c, ok := render.ParseColor("#" + strings.TrimPrefix(hexColor, "#"))
+	if !ok {
+		return 0, 0, 0
 	}
-	
-	return r, g, b
+	return int(c.R), int(c.G), int(c.B)
+}
+
+// blendOver returns fg alpha-blended over bg as an opaque hex color, the way the
+// renderer paints it; opaque and invalid colors are returned unchanged
+func blendOver(fg, bg string) string {
+	c, ok := render.ParseColor("#" + strings.TrimPrefix(fg, "#"))
+	if !ok || c.A == 255 {
+		return fg
+	}
+	r, g, b := hexToRGB(bg)
+	over := render.CompositeOver(c, color.NRGBA{uint8(r), uint8(g), uint8(b), 255})
+	return rgbToHex(int(over.R), int(over.G), int(over.B))
 }
 
 // isLargeTextSize determines if text is considered "large" for WCAG purposes
@@ -308,3 +348,56 @@ func suggestCompliantColor(fg, bg string, requiredRatio float64) string {
 func rgbToHex(r, g, b int) string {
 	return fmt.Sprintf("#%02X%02X%02X", r, g, b)
 }
+
+// ContrastFix describes a text color that was replaced to meet the required contrast ratio
+type ContrastFix struct {
+	ComponentID string  `json:"component_id"`
+	Background  string  `json:"background"`
+	From        string  `json:"from"`
+	To          string  `json:"to"`
+	Ratio       float64 `json:"ratio"` // contrast ratio after the fix
+}
+
+// FixContrast replaces failing text colors with the suggested compliant color.
+// The structure is modified in place and the applied fixes are returned.
+// Components without a compliant suggestion are left untouched.
+func FixContrast(structure *types.Structure, rule ContrastRule) []ContrastFix {
+	fixes := []ContrastFix{}
+
+	backgrounds := types.ComputeEffectiveBackgrounds(structure)
+
+	var fixComponent func(comp *types.Component)
+	fixComponent = func(comp *types.Component) {
+		effectiveBg := backgrounds[comp.ID]
+
+		if comp.Type == "text" && comp.Color != "" {
+			requiredRatio := rule.NormalTextRatio
+			if isLargeTextSize(comp.Size, comp.Weight) {
+				requiredRatio = rule.LargeTextRatio
+			}
+
+			if calculateContrastRatio(comp.Color, effectiveBg) < requiredRatio {
+				if suggestion := suggestCompliantColor(comp.Color, effectiveBg, requiredRatio); suggestion != "" {
+					fixes = append(fixes, ContrastFix{
+						ComponentID: comp.ID,
+						Background:  effectiveBg,
+						From:        comp.Color,
+						To:          suggestion,
+						Ratio:       calculateContrastRatio(suggestion, effectiveBg),
+					})
+					comp.Color = suggestion
+				}
+			}
+		}
+
+		for i := range comp.Children {
+			fixComponent(&comp.Children[i])
+		}
+	}
+
+	for i := range structure.Components {
+		fixComponent(&structure.Components[i])
+	}
+
+	return fixes
+}
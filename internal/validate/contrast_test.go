@@ -2,6 +2,7 @@ package validate
 
 import (
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -62,6 +63,23 @@ func TestCalculateContrastRatio(t *testing.T) {
 	}
 }
 
+func TestValidateContrast_TranslucentText(t *testing.T) {
+	// #00000033 is black at 20% opacity: it reads as a light gray on white
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "hint", Type: "text", Content: "Optional", Color: "#00000033"},
+		},
+	}
+
+	result := ValidateContrast(structure, DefaultContrastRule())
+	if result.Passed {
+		t.Fatal("Expected translucent text on white to fail contrast")
+	}
+	if ratio := result.Issues[0].ContrastRatio; ratio > 2 {
+		t.Errorf("Expected the blended ratio (about 1.5:1), got %.1f:1", ratio)
+	}
+}
+
 func TestHexToRGB(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -105,6 +123,13 @@ func TestHexToRGB(t *testing.T) {
 			expectedG: 130,
 			expectedB: 246,
 		},
+		{
+			name:     "Blue with alpha",
+			hex:      "#3B82F680",
+			expectedR: 59,
+			expectedG: 130,
+			expectedB: 246,
+		},
 	}
 
 	for _, tt := range tests {
@@ -311,6 +336,75 @@ func TestValidateContrast_ButtonText(t *testing.T) {
 	}
 }
 
+func TestValidateContrast_ButtonTextColor(t *testing.T) {
+	// The renderer draws a button's own color, so black text on a dark button is unreadable
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:      "delete",
+				Type:    "button",
+				Content: "Delete",
+				Color:   "#000000",
+				Layout:  types.ComponentLayout{Background: "#525252"},
+			},
+			{
+				ID:      "cancel",
+				Type:    "button",
+				Content: "Cancel",
+				Layout:  types.ComponentLayout{Background: "#525252"}, // default white text passes
+			},
+		},
+	}
+
+	result := ValidateContrast(structure, DefaultContrastRule())
+	if result.Passed {
+		t.Fatal("Expected black text on a dark button to fail")
+	}
+
+	failures := 0
+	for _, issue := range result.Issues {
+		if issue.Category != "contrast_fail" {
+			continue
+		}
+		failures++
+		if issue.ComponentID != "delete" {
+			t.Errorf("Expected only 'delete' to fail, got '%s'", issue.ComponentID)
+		}
+		if issue.ForegroundColor != "#000000" || issue.BackgroundColor != "#525252" {
+			t.Errorf("Expected #000000 on #525252, got %s on %s", issue.ForegroundColor, issue.BackgroundColor)
+		}
+	}
+	if failures != 1 {
+		t.Errorf("Expected 1 contrast failure, got %d: %+v", failures, result.Issues)
+	}
+}
+
+func TestValidateContrast_ButtonDefaultBackground(t *testing.T) {
+	// Without a background the renderer paints the button black, whatever the page is
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "save", Type: "button", Content: "Save"},
+			{ID: "skip", Type: "button", Content: "Skip", Color: "#000000"},
+		},
+	}
+
+	result := ValidateContrast(structure, DefaultContrastRule())
+	if result.Passed {
+		t.Fatal("Expected black text on the default black button to fail")
+	}
+	for _, issue := range result.Issues {
+		if issue.Category != "contrast_fail" {
+			continue
+		}
+		if issue.ComponentID != "skip" {
+			t.Errorf("Expected only 'skip' to fail, got '%s': %s", issue.ComponentID, issue.Message)
+		}
+		if issue.BackgroundColor != "#000000" {
+			t.Errorf("Expected the button's black background, got %s", issue.BackgroundColor)
+		}
+	}
+}
+
 func TestValidateContrast_InheritedBackground(t *testing.T) {
 	// Text should inherit background from parent container
 	structure := &types.Structure{
@@ -418,3 +512,101 @@ func TestValidateContrast_EmptyStructure(t *testing.T) {
 		t.Error("Expected validation to pass for empty structure")
 	}
 }
+
+func TestFixContrast(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "container",
+				Type: "box",
+				Layout: types.ComponentLayout{
+					Background: "#FFFFFF",
+				},
+				Children: []types.Component{
+					{ID: "faint", Type: "text", Content: "Hello", Color: "#999999", Size: "base"},
+					{ID: "readable", Type: "text", Content: "World", Color: "#000000", Size: "base"},
+				},
+			},
+		},
+	}
+
+	rule := DefaultContrastRule()
+	fixes := FixContrast(structure, rule)
+
+	if len(fixes) != 1 {
+		t.Fatalf("Expected 1 fix, got %d: %+v", len(fixes), fixes)
+	}
+	if fixes[0].ComponentID != "faint" || fixes[0].From != "#999999" {
+		t.Errorf("Unexpected fix: %+v", fixes[0])
+	}
+	if structure.Components[0].Children[0].Color != fixes[0].To {
+		t.Errorf("Expected color to be updated to %s, got %s", fixes[0].To, structure.Components[0].Children[0].Color)
+	}
+	if fixes[0].Ratio < rule.NormalTextRatio {
+		t.Errorf("Expected fixed ratio >= %.1f, got %.2f", rule.NormalTextRatio, fixes[0].Ratio)
+	}
+	if structure.Components[0].Children[1].Color != "#000000" {
+		t.Error("Expected passing text color to be unchanged")
+	}
+
+	if result := ValidateContrast(structure, rule); !result.Passed {
+		t.Error("Expected structure to pass contrast validation after fixing")
+	}
+}
+
+func TestValidateContrast_AAALevel(t *testing.T) {
+	// #737373 on white is about 4.7:1 - enough for AA, not for AAA
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "caption", Type: "text", Content: "Updated today", Color: "#737373"},
+		},
+	}
+
+	aa, err := ContrastRuleForLevel("AA")
+	if err != nil {
+		t.Fatalf("ContrastRuleForLevel(AA) failed: %v", err)
+	}
+	if result := ValidateContrast(structure, aa); !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected AA to pass cleanly, got %+v", result.Issues)
+	}
+
+	aaa, err := ContrastRuleForLevel("aaa")
+	if err != nil {
+		t.Fatalf("ContrastRuleForLevel(aaa) failed: %v", err)
+	}
+	result := ValidateContrast(structure, aaa)
+	if result.Passed {
+		t.Error("Expected AAA to fail")
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Category != "contrast_aaa" || result.Issues[0].Severity != "warning" || result.Issues[0].RequiredRatio != 7.0 {
+		t.Errorf("Expected one AAA warning requiring 7:1, got %+v", result.Issues)
+	}
+
+	// Failures below AA name the AAA level being enforced
+	structure.Components = append(structure.Components,
+		types.Component{ID: "hint", Type: "text", Content: "Optional", Color: "#E5E5E5"},
+		types.Component{ID: "ghost", Type: "button", Content: "More", Color: "#525252"},
+	)
+	failures := 0
+	for _, issue := range ValidateContrast(structure, aaa).Issues {
+		if issue.Category != "contrast_fail" {
+			continue
+		}
+		failures++
+		if !strings.Contains(issue.Message, "fails WCAG AAA") || strings.Contains(issue.Message, "fails WCAG AA (") {
+			t.Errorf("Expected the AAA level in %q", issue.Message)
+		}
+	}
+	if failures != 2 {
+		t.Errorf("Expected 'hint' and 'ghost' to fail, got %d failures", failures)
+	}
+	for _, issue := range ValidateContrast(structure, aa).Issues {
+		if issue.Category == "contrast_fail" && !strings.Contains(issue.Message, "fails WCAG AA (") {
+			t.Errorf("Expected the AA level in %q", issue.Message)
+		}
+	}
+
+	if _, err := ContrastRuleForLevel("A"); err == nil {
+		t.Error("Expected an error for an unknown WCAG level")
+	}
+}
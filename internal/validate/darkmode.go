@@ -58,8 +58,9 @@ func ValidateDarkMode(structure *types.Structure, rule DarkModeRule) DarkModeRes
 	}
 
 	// Check components for hardcoded colors
+	backgrounds := types.ComputeEffectiveBackgrounds(structure)
 	for _, component := range structure.Components {
-		validateComponentDarkMode(&result, &component, rule)
+		validateComponentDarkMode(&result, &component, backgrounds, rule)
 	}
 
 	// If no errors found, mark as passed
@@ -80,14 +81,14 @@ func ValidateDarkMode(structure *types.Structure, rule DarkModeRule) DarkModeRes
 	return result
 }
 
-func validateComponentDarkMode(result *DarkModeResult, component *types.Component, rule DarkModeRule) {
+func validateComponentDarkMode(result *DarkModeResult, component *types.Component, backgrounds map[string]string, rule DarkModeRule) {
 	// Check for hardcoded colors that might not work well in dark mode
 	if component.Color != "" && rule.RecommendAdaptive {
 		// Pure black or pure white text might not be ideal for both modes
 		if component.Color == "#000000" || component.Color == "#FFFFFF" {
 			result.Issues = append(result.Issues, DarkModeIssue{
 				ComponentID: component.ID,
-				Message:     fmt.Sprintf("Component '%s' uses absolute color '%s' which may not adapt well to dark mode. Consider using semantic color tokens.", component.ID, component.Color),
+				Message:     fmt.Sprintf("Component '%s' uses absolute color '%s' on '%s' which may not adapt well to dark mode. Consider using semantic color tokens.", component.ID, component.Color, backgrounds[component.ID]),
 				Severity:    "info",
 				Mode:        "both",
 			})
@@ -108,6 +109,6 @@ func validateComponentDarkMode(result *DarkModeResult, component *types.Componen
 
 	// Check children recursively
 	for _, child := range component.Children {
-		validateComponentDarkMode(result, &child, rule)
+		validateComponentDarkMode(result, &child, backgrounds, rule)
 	}
 }
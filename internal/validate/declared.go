@@ -0,0 +1,91 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// DeclaredIssue represents a check the structure's validation block claims passed
+// but the live validator fails
+type DeclaredIssue struct {
+	Field       string `json:"field"`     // the validation field making the claim, e.g. "visual_hierarchy"
+	Validator   string `json:"validator"` // the validator that was run, e.g. "hierarchy"
+	Message     string `json:"message"`
+	Severity    string `json:"severity"` // "warning"
+	IssuesFound int    `json:"issues_found"`
+}
+
+// DeclaredResult contains the validation results
+type DeclaredResult struct {
+	Passed bool            `json:"passed"`
+	Issues []DeclaredIssue `json:"issues"`
+}
+
+// ValidateDeclared cross-checks the author-asserted validation block against the
+// validators it names. visual_hierarchy and touch_targets map to the hierarchy and
+// touch target validators, and each checks_passed entry that names an audit
+// validator is run as well, with the same rules the audit would use; any claim of
+// "passed" that the validator now fails is reported as stale. Entries that do not
+// name a validator are ignored.
+func ValidateDeclared(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) DeclaredResult {
+	result := DeclaredResult{
+		Passed: true,
+		Issues: []DeclaredIssue{},
+	}
+
+	type claim struct {
+		field     string
+		validator string
+	}
+	claims := []claim{}
+	if structure.Validation.VisualHierarchy == "passed" {
+		claims = append(claims, claim{"visual_hierarchy", "hierarchy"})
+	}
+	if structure.Validation.TouchTargets == "passed" {
+		claims = append(claims, claim{"touch_targets", "touch_targets"})
+	}
+	for _, name := range structure.Validation.ChecksPassed {
+		claims = append(claims, claim{"checks_passed", name})
+	}
+
+	checked := map[string]bool{}
+	for _, c := range claims {
+		if checked[c.validator] {
+			continue
+		}
+		checked[c.validator] = true
+
+		info, ok := auditValidator(c.validator)
+		if !ok {
+			continue
+		}
+		passed, issues := info.run(structure, boxes, rules)
+		if passed {
+			continue
+		}
+
+		count := len(auditIssues(issues))
+		result.Issues = append(result.Issues, DeclaredIssue{
+			Field:       c.field,
+			Validator:   c.validator,
+			Message:     fmt.Sprintf("Declared Validation: %s claims '%s' passed, but it now fails with %d issue(s) - re-run the validator and update the validation block", c.field, info.Title, count),
+			Severity:    "warning",
+			IssuesFound: count,
+		})
+		result.Passed = false
+	}
+
+	return result
+}
+
+// auditValidator looks up a registered audit validator by machine name
+func auditValidator(name string) (ValidatorInfo, bool) {
+	for _, v := range validators {
+		if v.Audit && v.Name == name {
+			return v, true
+		}
+	}
+	return ValidatorInfo{}, false
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestValidateDeclared_StaleClaims(t *testing.T) {
+	height := 20
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "title", Type: "text", Content: "Settings"},
+			{ID: "save", Type: "button", Content: "Save", Layout: types.ComponentLayout{Height: height}},
+		},
+		Validation: types.Validation{
+			TouchTargets: "passed",
+			ChecksPassed: []string{"touch_targets", "readability"},
+		},
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	result := ValidateDeclared(structure, boxes, DefaultAuditRules())
+	if result.Passed {
+		t.Fatal("Expected a stale touch_targets claim to fail")
+	}
+	if len(result.Issues) != 1 {
+		t.Fatalf("Expected one issue per failing validator, got %+v", result.Issues)
+	}
+	issue := result.Issues[0]
+	if issue.Field != "touch_targets" || issue.Validator != "touch_targets" || issue.Severity != "warning" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+	if issue.IssuesFound == 0 {
+		t.Errorf("Expected the live issue count to be recorded, got %+v", issue)
+	}
+}
+
+func TestValidateDeclared_AccurateClaims(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "title", Type: "text", Content: "Settings"},
+			{ID: "save", Type: "button", Content: "Save"},
+		},
+		Validation: types.Validation{
+			TouchTargets: "passed",
+			ChecksPassed: []string{"touch_targets"},
+		},
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	result := ValidateDeclared(structure, boxes, DefaultAuditRules())
+	if !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected accurate claims to pass, got %+v", result.Issues)
+	}
+}
+
+func TestValidateDeclared_FailedClaimsNotChecked(t *testing.T) {
+	height := 20
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "save", Type: "button", Content: "Save", Layout: types.ComponentLayout{Height: height}},
+		},
+		Validation: types.Validation{TouchTargets: "failed"},
+	}
+
+	result := ValidateDeclared(structure, nil, DefaultAuditRules())
+	if !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected a declared failure to be left alone, got %+v", result.Issues)
+	}
+}
+
+func TestValidateDeclared_UsesGivenRules(t *testing.T) {
+	// #767676 on white is 4.5:1: AA passes, AAA (7:1) does not
+	structure := &types.Structure{
+		Phase: "design",
+		Components: []types.Component{
+			{ID: "caption", Type: "text", Content: "Updated today", Color: "#767676"},
+		},
+		Validation: types.Validation{ChecksPassed: []string{"contrast"}},
+	}
+
+	if result := ValidateDeclared(structure, nil, DefaultAuditRules()); !result.Passed {
+		t.Errorf("Expected the claim to hold at AA, got %+v", result.Issues)
+	}
+
+	rules := DefaultAuditRules()
+	aaa, err := ContrastRuleForLevel("AAA")
+	if err != nil {
+		t.Fatalf("ContrastRuleForLevel failed: %v", err)
+	}
+	rules.Contrast = aaa
+	if result := ValidateDeclared(structure, nil, rules); result.Passed || result.Issues[0].Validator != "contrast" {
+		t.Errorf("Expected the claim to be stale at AAA, got %+v", result.Issues)
+	}
+}
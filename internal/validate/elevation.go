@@ -3,15 +3,16 @@ package validate
 import (
 	"fmt"
 	"regexp"
-	"strconv"
 	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
 // ElevationRule defines the rules for shadow/elevation validation
 type ElevationRule struct {
-	Levels map[string]string // elevation level -> shadow CSS value
+	Levels            map[string]string // elevation level -> shadow CSS value
+	MaxDistinctLevels int               // maximum distinct non-none shadows in use (default: 4)
 }
 
 // ElevationIssue represents an elevation validation issue
@@ -38,6 +39,7 @@ func DefaultElevationRule() ElevationRule {
 			"4": "0 8px 16px 0 rgba(0,0,0,0.15)",  // Overlays (modals)
 			"5": "0 16px 32px 0 rgba(0,0,0,0.2)",  // Maximum (important dialogs)
 		},
+		MaxDistinctLevels: 4,
 	}
 }
 
@@ -48,31 +50,73 @@ func ValidateElevation(structure *types.Structure, rule ElevationRule) Elevation
 		Issues: []ElevationIssue{},
 	}
 
+	// Shadow values are a Phase 2 concept; only check them against the level table for designs
+	checkValues := structure.Phase == "design"
+
 	// Validate all components recursively
-	validateComponentElevation(structure.Components, rule, &result)
+	validateComponentElevation(structure.Components, rule, checkValues, &result)
+
+	// Check that the number of distinct elevations stays within the system
+	distinct := collectDistinctShadows(structure.Components, map[string]bool{})
+	if rule.MaxDistinctLevels > 0 && len(distinct) > rule.MaxDistinctLevels {
+		result.Passed = false
+		result.Issues = append(result.Issues, ElevationIssue{
+			ComponentID: "structure",
+			Message:     fmt.Sprintf("Elevation: %d distinct shadow values in use (recommended max: %d) - too many levels muddy the visual hierarchy", len(distinct), rule.MaxDistinctLevels),
+			Severity:    "warning",
+		})
+	}
 
 	return result
 }
 
-func validateComponentElevation(components []types.Component, rule ElevationRule, result *ElevationResult) {
+// collectDistinctShadows gathers the normalized non-none shadow values used across components
+func collectDistinctShadows(components []types.Component, seen map[string]bool) map[string]bool {
+	for _, comp := range components {
+		shadow := normalizeShadow(comp.Layout.Shadow)
+		if shadow != "" && shadow != "none" {
+			seen[shadow] = true
+		}
+
+		if len(comp.Children) > 0 {
+			collectDistinctShadows(comp.Children, seen)
+		}
+	}
+	return seen
+}
+
+func validateComponentElevation(components []types.Component, rule ElevationRule, checkValues bool, result *ElevationResult) {
 	for _, comp := range components {
-		// Check for shadow property in layout
-		// Note: Shadow property doesn't exist in current Phase 1 schema
-		// This validator is prepared for when it's added
-		validateShadow(comp, rule, result)
+		// Check the shadow property in layout
+		validateShadow(comp, rule, checkValues, result)
 
 		// Recursively validate children
 		if len(comp.Children) > 0 {
-			validateComponentElevation(comp.Children, rule, result)
+			validateComponentElevation(comp.Children, rule, checkValues, result)
 		}
 	}
 }
 
-func validateShadow(comp types.Component, rule ElevationRule, result *ElevationResult) {
-	// For future implementation when shadow/elevation is added to schema
-	// Currently this would check comp.Layout.Shadow or comp.Elevation
-	// For now, we'll provide informational validation framework
-	
+func validateShadow(comp types.Component, rule ElevationRule, checkValues bool, result *ElevationResult) {
+	// Check declared shadow values against the elevation level table
+	if checkValues && comp.Layout.Shadow != "" {
+		valid, _, suggestion := ValidateShadowValue(comp.Layout.Shadow, rule)
+		if !valid {
+			result.Passed = false
+			result.Issues = append(result.Issues, ElevationIssue{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("Elevation: '%s' shadow '%s' does not match any elevation level", comp.ID, comp.Layout.Shadow),
+				Severity:    "warning",
+			})
+			result.Issues = append(result.Issues, ElevationIssue{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("   Suggestion: %s", suggestion),
+				Severity:    "info",
+			})
+		}
+		return
+	}
+
 	// Check component type for recommended elevation levels
 	recommendedLevel := getRecommendedElevationLevel(comp.Type, comp.Role)
 	if recommendedLevel != "" {
@@ -167,18 +211,11 @@ func findClosestElevationLevel(shadow string, rule ElevationRule) string {
 }
 
 func extractBlurRadius(shadow string) int {
-	// Simple regex to extract blur radius (3rd number in box-shadow)
 	// Format: offset-x offset-y blur-radius spread-radius color
 	// Example: "0 4px 8px 0 rgba(0,0,0,0.12)"
-	re := regexp.MustCompile(`(-?\d+)\s+(-?\d+)px\s+(-?\d+)px`)
-	matches := re.FindStringSubmatch(shadow)
-	
-	if len(matches) >= 4 {
-		blur, err := strconv.Atoi(matches[3])
-		if err == nil {
-			return blur
-		}
+	parsed, ok := render.ParseShadow(shadow)
+	if !ok {
+		return 0
 	}
-	
-	return 0
+	return parsed.Blur
 }
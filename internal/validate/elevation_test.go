@@ -311,3 +311,101 @@ func TestValidateElevation_NestedComponents(t *testing.T) {
 		t.Errorf("Expected recommendation for nested button component")
 	}
 }
+
+func TestValidateElevation_TooManyDistinctLevels(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "card-1", Type: "box", Layout: types.ComponentLayout{Shadow: "0 1px 2px 0 rgba(0,0,0,0.05)"}},
+			{ID: "card-2", Type: "box", Layout: types.ComponentLayout{Shadow: "0 2px 4px 0 rgba(0,0,0,0.1)"}},
+			{ID: "card-3", Type: "box", Layout: types.ComponentLayout{Shadow: "0 4px 8px 0 rgba(0,0,0,0.12)"}},
+			{
+				ID:     "panel",
+				Type:   "box",
+				Layout: types.ComponentLayout{Shadow: "0 8px 16px 0 rgba(0,0,0,0.15)"},
+				Children: []types.Component{
+					{ID: "popover", Type: "box", Layout: types.ComponentLayout{Shadow: "0 16px 32px 0 rgba(0,0,0,0.2)"}},
+					{ID: "flat", Type: "box", Layout: types.ComponentLayout{Shadow: "none"}},
+				},
+			},
+		},
+	}
+
+	result := ValidateElevation(structure, DefaultElevationRule())
+
+	if result.Passed {
+		t.Errorf("Expected validation to fail with 5 distinct shadows")
+	}
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && issue.ComponentID == "structure" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected warning about too many distinct elevation levels")
+	}
+}
+
+func TestValidateElevation_DistinctLevelsWithinLimit(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "card-1", Type: "box", Layout: types.ComponentLayout{Shadow: "0 1px 2px 0 rgba(0,0,0,0.05)"}},
+			{ID: "card-2", Type: "box", Layout: types.ComponentLayout{Shadow: "0  1px 2px 0 RGBA(0,0,0,0.05)"}},
+			{ID: "card-3", Type: "box", Layout: types.ComponentLayout{Shadow: "0 4px 8px 0 rgba(0,0,0,0.12)"}},
+		},
+	}
+
+	result := ValidateElevation(structure, DefaultElevationRule())
+
+	if !result.Passed {
+		t.Errorf("Expected validation to pass with 2 distinct shadows")
+	}
+}
+
+func TestValidateElevation_Phase2ShadowLevels(t *testing.T) {
+	structure := &types.Structure{
+		Phase: "design",
+		Components: []types.Component{
+			{ID: "card", Type: "box", Role: "card", Layout: types.ComponentLayout{Shadow: "0 1px 2px 0 rgba(0,0,0,0.05)"}},
+			{ID: "dropdown", Type: "box", Layout: types.ComponentLayout{Shadow: "0 3px 7px 0 rgba(0,0,0,0.3)"}},
+		},
+	}
+
+	result := ValidateElevation(structure, DefaultElevationRule())
+
+	if result.Passed {
+		t.Errorf("Expected validation to fail for shadow outside the level table")
+	}
+
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "card" && issue.Severity != "info" {
+			t.Errorf("Expected matching shadow on 'card' to produce no warnings, got: %s", issue.Message)
+		}
+	}
+
+	foundDropdown := false
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "dropdown" && issue.Severity == "warning" {
+			foundDropdown = true
+		}
+	}
+	if !foundDropdown {
+		t.Errorf("Expected warning for non-matching shadow on 'dropdown'")
+	}
+}
+
+func TestValidateElevation_Phase1IgnoresShadowValues(t *testing.T) {
+	structure := &types.Structure{
+		Phase: "structure",
+		Components: []types.Component{
+			{ID: "dropdown", Type: "box", Layout: types.ComponentLayout{Shadow: "0 3px 7px 0 rgba(0,0,0,0.3)"}},
+		},
+	}
+
+	result := ValidateElevation(structure, DefaultElevationRule())
+
+	if !result.Passed {
+		t.Errorf("Expected shadow values not to be checked against levels in Phase 1")
+	}
+}
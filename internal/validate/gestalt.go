@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -48,6 +49,16 @@ type ComponentRelationship struct {
 
 // ValidateGestalt validates Gestalt principles (proximity and similarity)
 func ValidateGestalt(structure *types.Structure, rule GestaltRule) GestaltResult {
+	return ValidateGestaltWithLayout(structure, nil, rule)
+}
+
+// ValidateGestaltWithLayout validates Gestalt principles, measuring proximity from
+// computed layout boxes (at scale 1) instead of the declared parent gap. Margins and
+// stacked distances are then reflected in the spacing. Only neighbouring siblings are
+// compared, since the distance between non-adjacent siblings spans the ones in between.
+// Components missing from boxes fall back to the declared gap; nil boxes behave like
+// ValidateGestalt.
+func ValidateGestaltWithLayout(structure *types.Structure, boxes map[string]render.LayoutBox, rule GestaltRule) GestaltResult {
 	result := GestaltResult{
 		Passed: true,
 		Issues: []GestaltIssue{},
@@ -66,7 +77,12 @@ func ValidateGestalt(structure *types.Structure, rule GestaltRule) GestaltResult
 				
 				// Calculate spacing between adjacent components
 				var spacing int
-				if parent != nil {
+				if measured, ok := measuredSpacing(boxes, comp1.ID, comp2.ID); ok {
+					if j > i+1 {
+						continue
+					}
+					spacing = measured
+				} else if parent != nil {
 					if parent.Layout.Direction == "vertical" {
 						spacing = parent.Layout.Gap
 					} else if parent.Layout.Direction == "horizontal" {
@@ -151,8 +167,20 @@ func ValidateGestalt(structure *types.Structure, rule GestaltRule) GestaltResult
 	// Check for similarity in related components
 	if rule.SimilarityCheck {
 		groups := findComponentGroups(structure)
+		parents := componentParents(structure)
 		
 		for groupName, components := range groups {
+			// Siblings of one kind (a row of cards) should share their borders and backgrounds
+			for _, siblings := range splitByParent(components, parents) {
+				if len(siblings) < rule.MinGroupSize {
+					continue
+				}
+				for _, issue := range checkDecorationConsistency(siblings, parents[siblings[0]]) {
+					issue.Message = fmt.Sprintf("Similarity: %s in group '%s' - give every sibling the same decoration", issue.Message, groupName)
+					result.Issues = append(result.Issues, issue)
+				}
+			}
+
 			if len(components) >= rule.MinGroupSize {
 				// Check that similar components have consistent styling
 				inconsistencies := checkSimilarity(components)
@@ -171,7 +199,7 @@ func ValidateGestalt(structure *types.Structure, rule GestaltRule) GestaltResult
 	}
 	
 	// Detect potential groupings by proximity
-	detectedGroups := detectGroupsByProximity(structure, rule)
+	detectedGroups := detectGroupsByProximity(structure, boxes, rule)
 	for groupID, group := range detectedGroups {
 		if len(group) >= rule.MinGroupSize {
 			// Find the dominant spacing within the group
@@ -200,6 +228,9 @@ func ValidateGestalt(structure *types.Structure, rule GestaltRule) GestaltResult
 		}
 	}
 	
+	// Group and similarity checks iterate maps, so sort for stable output
+	sortGestaltIssues(result.Issues)
+	
 	return result
 }
 
@@ -305,8 +336,108 @@ func checkSimilarity(components []*types.Component) []string {
 	return inconsistencies
 }
 
+// componentParents maps every component to the ID of its parent ("" at the top level)
+func componentParents(structure *types.Structure) map[*types.Component]string {
+	parents := map[*types.Component]string{}
+	var traverse func(components []types.Component, parent string)
+	traverse = func(components []types.Component, parent string) {
+		for i := range components {
+			parents[&components[i]] = parent
+			traverse(components[i].Children, components[i].ID)
+		}
+	}
+	traverse(structure.Components, "")
+	return parents
+}
+
+// splitByParent splits a group into its sets of siblings, in document order
+func splitByParent(components []*types.Component, parents map[*types.Component]string) [][]*types.Component {
+	var sets [][]*types.Component
+	index := map[string]int{}
+	for _, comp := range components {
+		parent := parents[comp]
+		i, ok := index[parent]
+		if !ok {
+			i = len(sets)
+			index[parent] = i
+			sets = append(sets, nil)
+		}
+		sets[i] = append(sets[i], comp)
+	}
+	return sets
+}
+
+// checkDecorationConsistency flags siblings of one kind where only some have a
+// border or a background, listing which members have it and which don't
+func checkDecorationConsistency(siblings []*types.Component, parent string) []GestaltIssue {
+	issues := []GestaltIssue{}
+	decorations := []struct {
+		name string
+		has  func(comp *types.Component) bool
+	}{
+		{"border", func(comp *types.Component) bool { return comp.Layout.Border != "" }},
+		{"background", func(comp *types.Component) bool { return comp.Layout.Background != "" }},
+	}
+
+	where := "top-level siblings"
+	if parent != "" {
+		where = fmt.Sprintf("siblings under '%s'", parent)
+	}
+	for _, decoration := range decorations {
+		var with, without []string
+		for _, comp := range siblings {
+			if decoration.has(comp) {
+				with = append(with, comp.ID)
+			} else {
+				without = append(without, comp.ID)
+			}
+		}
+		if len(with) == 0 || len(without) == 0 {
+			continue
+		}
+		issues = append(issues, GestaltIssue{
+			Severity:  "warning",
+			Message:   fmt.Sprintf("%s have a %s on '%s' but not on '%s'", where, decoration.name, strings.Join(with, "', '"), strings.Join(without, "', '")),
+			Component: without[0],
+		})
+	}
+	return issues
+}
+
+// measuredSpacing returns the distance between two components' layout boxes: the
+// gap along the axis that separates them, or 0 when they touch or overlap
+func measuredSpacing(boxes map[string]render.LayoutBox, id1, id2 string) (int, bool) {
+	box1, ok1 := boxes[id1]
+	box2, ok2 := boxes[id2]
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+
+	dx := max(box2.X-(box1.X+box1.Width), box1.X-(box2.X+box2.Width), 0)
+	dy := max(box2.Y-(box1.Y+box1.Height), box1.Y-(box2.Y+box2.Height), 0)
+	return max(dx, dy), true
+}
+
+// groupSpacing returns the largest spacing between neighbouring children, measured
+// from layout boxes when available and the declared gap otherwise
+func groupSpacing(parent *types.Component, boxes map[string]render.LayoutBox) int {
+	if boxes == nil || len(parent.Children) < 2 {
+		return parent.Layout.Gap
+	}
+
+	spacing := 0
+	for i := 1; i < len(parent.Children); i++ {
+		measured, ok := measuredSpacing(boxes, parent.Children[i-1].ID, parent.Children[i].ID)
+		if !ok {
+			return parent.Layout.Gap
+		}
+		spacing = max(spacing, measured)
+	}
+	return spacing
+}
+
 // detectGroupsByProximity detects component groups based on spacing patterns
-func detectGroupsByProximity(structure *types.Structure, rule GestaltRule) map[string][]*types.Component {
+func detectGroupsByProximity(structure *types.Structure, boxes map[string]render.LayoutBox, rule GestaltRule) map[string][]*types.Component {
 	groups := make(map[string][]*types.Component)
 	
 	var traverse func(parent *types.Component, parentID string)
@@ -318,7 +449,7 @@ func detectGroupsByProximity(structure *types.Structure, rule GestaltRule) map[s
 			}
 			
 			// If spacing is tight (close to intra-group spacing), consider it a group
-			if parent.Layout.Gap <= rule.IntraGroupSpacing*2 {
+			if groupSpacing(parent, boxes) <= rule.IntraGroupSpacing*2 {
 				groups[groupID] = make([]*types.Component, len(parent.Children))
 				for i := range parent.Children {
 					groups[groupID][i] = &parent.Children[i]
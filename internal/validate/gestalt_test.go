@@ -1,9 +1,11 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -311,3 +313,159 @@ func TestValidateGestalt_ValidStructure(t *testing.T) {
 		t.Error("Expected info messages about well-formed groups")
 	}
 }
+
+func TestValidateGestaltWithLayout_ComputedSpacing(t *testing.T) {
+	// Related items pushed apart by space-between look grouped by their declared gap
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "summary",
+				Type: "box",
+				Layout: types.ComponentLayout{
+					Display:        "flex",
+					Direction:      "horizontal",
+					JustifyContent: "space-between",
+					Gap:            8,
+				},
+				Children: []types.Component{
+					{ID: "price-label", Type: "text", Content: "Total", Layout: types.ComponentLayout{Width: 100, Height: 20}},
+					{ID: "price-value", Type: "text", Content: "$42", Layout: types.ComponentLayout{Width: 100, Height: 20}},
+				},
+			},
+		},
+	}
+
+	declared := ValidateGestalt(structure, DefaultGestaltRule())
+	if !declared.Passed {
+		t.Fatalf("Expected declared 8px gap to pass, got %+v", declared.Issues)
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 800, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	computed := ValidateGestaltWithLayout(structure, boxes, DefaultGestaltRule())
+	if computed.Passed {
+		t.Fatal("Expected computed spacing to flag the related pair")
+	}
+
+	found := false
+	for _, issue := range computed.Issues {
+		if issue.Severity == "warning" && strings.Contains(issue.Message, "'price-label' and 'price-value' have large spacing (600px)") {
+			found = true
+		}
+		if issue.Component == "summary" && strings.Contains(issue.Message, "well-formed group") {
+			t.Errorf("Expected the spread-out row not to be reported as a group, got %q", issue.Message)
+		}
+	}
+	if !found {
+		t.Errorf("Expected a proximity warning with the measured 600px gap, got %+v", computed.Issues)
+	}
+}
+
+func TestValidateGestaltWithLayout_AdjacentSiblingsOnly(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "form",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "name-label", Type: "text"},
+					{ID: "name-input", Type: "input"},
+					{ID: "name-hint", Type: "text"},
+				},
+			},
+		},
+	}
+	boxes := map[string]render.LayoutBox{
+		"form":       {X: 0, Y: 0, Width: 400, Height: 120},
+		"name-label": {X: 0, Y: 0, Width: 400, Height: 20},
+		"name-input": {X: 0, Y: 28, Width: 400, Height: 44},
+		"name-hint":  {X: 0, Y: 80, Width: 400, Height: 20},
+	}
+
+	// label→hint spans the input (60px) but is not a neighbouring pair
+	result := ValidateGestaltWithLayout(structure, boxes, DefaultGestaltRule())
+	if !result.Passed {
+		t.Errorf("Expected neighbouring 8px gaps to pass, got %+v", result.Issues)
+	}
+}
+
+func TestMeasuredSpacing(t *testing.T) {
+	boxes := map[string]render.LayoutBox{
+		"a":       {X: 0, Y: 0, Width: 100, Height: 20},
+		"below":   {X: 0, Y: 36, Width: 100, Height: 20},
+		"right":   {X: 124, Y: 0, Width: 50, Height: 20},
+		"overlap": {X: 50, Y: 10, Width: 100, Height: 20},
+	}
+
+	tests := []struct {
+		id       string
+		expected int
+	}{
+		{"below", 16},
+		{"right", 24},
+		{"overlap", 0},
+	}
+	for _, tt := range tests {
+		got, ok := measuredSpacing(boxes, "a", tt.id)
+		if !ok || got != tt.expected {
+			t.Errorf("measuredSpacing(a, %s) = %d, %v; expected %d", tt.id, got, ok, tt.expected)
+		}
+	}
+
+	if _, ok := measuredSpacing(boxes, "a", "missing"); ok {
+		t.Error("Expected no measurement for a component without a box")
+	}
+}
+
+func TestValidateGestalt_InconsistentSiblingDecoration(t *testing.T) {
+	card := func(id, border string) types.Component {
+		return types.Component{
+			ID:     id,
+			Type:   "card",
+			Layout: types.ComponentLayout{Padding: 16, Border: border, Background: "#FFFFFF"},
+		}
+	}
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "plans",
+				Type:   "box",
+				Layout: types.ComponentLayout{Direction: "horizontal", Gap: 16},
+				Children: []types.Component{
+					card("basic", "1px solid #E5E5E5"),
+					card("pro", ""),
+					card("team", "1px solid #E5E5E5"),
+				},
+			},
+			// A card elsewhere on the page is not a sibling and is not compared
+			card("promo", ""),
+		},
+	}
+
+	result := ValidateGestalt(structure, DefaultGestaltRule())
+
+	var found []GestaltIssue
+	for _, issue := range result.Issues {
+		if strings.Contains(issue.Message, "same decoration") {
+			found = append(found, issue)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("Expected one decoration warning, got %v", found)
+	}
+	issue := found[0]
+	if issue.Severity != "warning" || issue.Component != "pro" ||
+		!strings.Contains(issue.Message, "siblings under 'plans' have a border on 'basic', 'team' but not on 'pro'") {
+		t.Errorf("Unexpected decoration warning: %+v", issue)
+	}
+
+	// Consistent borders raise nothing
+	structure.Components[0].Children[1].Layout.Border = "1px solid #E5E5E5"
+	for _, issue := range ValidateGestalt(structure, DefaultGestaltRule()).Issues {
+		if strings.Contains(issue.Message, "same decoration") {
+			t.Errorf("Expected consistent cards to pass, got: %s", issue.Message)
+		}
+	}
+}
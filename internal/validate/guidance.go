@@ -0,0 +1,54 @@
+package validate
+
+import "reflect"
+
+// categoryGuidance explains the principle behind each issue category and how to
+// fix it, for output aimed at designers who are still learning the rules
+var categoryGuidance = map[string]string{
+	// Choice overload (Hick's Law)
+	"navigation_overload":   "Hick's Law: decision time grows with the number of choices, so long menus slow every visit. Keep 7 or fewer top-level items and group the rest under a \"More\" menu or secondary navigation.",
+	"form_overload":         "Long forms feel like work and raise abandonment. Split the form into steps or sections of about 5 fields, and drop or defer anything not needed right now.",
+	"button_group_overload": "Many side-by-side buttons compete for attention and blur the primary action. Keep one primary button, demote the rest to secondary or tertiary, and move rare actions into an overflow menu.",
+	"card_grid_overload":    "Large card grids make users scan everything before choosing. Paginate, filter or group the cards, and surface the most relevant ones first.",
+
+	// Color contrast (WCAG)
+	"contrast_fail": "WCAG 1.4.3: text needs 4.5:1 contrast with its background (3:1 for large text) to be readable by people with low vision and on poor screens. Darken the text or lighten the background until the ratio passes.",
+	"contrast_aaa":  "WCAG 1.4.6 (AAA) asks for 7:1 contrast (4.5:1 for large text). It is the target for long reading and accessibility-critical products; push the colors further apart to reach it.",
+
+	// Spacing scale (8pt grid)
+	"off_grid":            "An 8pt grid keeps spacing consistent and rhythm predictable across screens. Round the value to the nearest multiple of 8, or 4 for tight spacing inside small components.",
+	"excessive_half_step": "4px half-steps are for fine-tuning small components. When they dominate, the rhythm gets muddy; prefer full 8px steps for layout spacing.",
+	"too_many_values":     "A spacing system works when a few values are reused everywhere. Pick a short scale (e.g. 8, 16, 24, 32, 48) and map every spacing onto it.",
+	"near_duplicate":      "Values a few pixels apart look like mistakes rather than intent. Merge them into a single value from the spacing scale.",
+}
+
+// Guidance returns the explanation and remediation tip for an issue category
+func Guidance(category string) (string, bool) {
+	text, ok := categoryGuidance[category]
+	return text, ok
+}
+
+// IssueCategories returns the distinct Category values of a validator's issue slice
+// that have guidance, in the order they first appear
+func IssueCategories(issues interface{}) []string {
+	v := reflect.ValueOf(issues)
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	categories := []string{}
+	seen := map[string]bool{}
+	for i := 0; i < v.Len(); i++ {
+		item := reflect.Indirect(v.Index(i))
+		if item.Kind() != reflect.Struct {
+			continue
+		}
+		category := stringField(item, "Category")
+		if _, ok := categoryGuidance[category]; !ok || seen[category] {
+			continue
+		}
+		seen[category] = true
+		categories = append(categories, category)
+	}
+	return categories
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGuidance_CoversEveryIssueCategory(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatalf("Failed to list package files: %v", err)
+	}
+
+	// Category literals on validator issues; suggestions carry their own advice
+	literal := regexp.MustCompile(`Category:\s+"(\w+)"`)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") || file == "suggestions.go" {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", file, err)
+		}
+		for _, match := range literal.FindAllStringSubmatch(string(data), -1) {
+			category := match[1]
+			if strings.HasSuffix(category, "suggestion") {
+				continue
+			}
+			if _, ok := Guidance(category); !ok {
+				t.Errorf("%s: category '%s' has no guidance", file, category)
+			}
+		}
+	}
+}
+
+func TestIssueCategories(t *testing.T) {
+	issues := []SpacingIssue{
+		{Category: "off_grid"},
+		{Category: "suggestion"},
+		{Category: "near_duplicate"},
+		{Category: "off_grid"},
+	}
+
+	got := IssueCategories(issues)
+	if strings.Join(got, ",") != "off_grid,near_duplicate" {
+		t.Errorf("Expected off_grid,near_duplicate, got %v", got)
+	}
+
+	// Issues without a Category field have no guidance to show
+	if got := IssueCategories([]DeclaredIssue{{Field: "touch_targets"}}); len(got) != 0 {
+		t.Errorf("Expected no categories, got %v", got)
+	}
+}
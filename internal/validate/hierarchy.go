@@ -4,22 +4,27 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
 // HierarchyRule defines validation rules for visual hierarchy
 type HierarchyRule struct {
-	HeadingScaleRatio float64 // e.g., 1.25 (each level 25% larger)
-	MinPrimaryCTASize int     // e.g., 120px width minimum
-	SpacingScaleRatio float64 // e.g., 1.5 (parent spacing > child spacing)
+	HeadingScaleRatio     float64 // e.g., 1.25 (each level 25% larger)
+	MinPrimaryCTASize     int     // e.g., 120px width minimum
+	SpacingScaleRatio     float64 // e.g., 1.5 (parent spacing > child spacing)
+	CheckPrimaryActionRef bool    // verify that a primary_action naming a component ID points at an interactive element
+	SinglePrimaryCTA      bool    // warn when more than one button in the whole tree is primary
 }
 
 // DefaultHierarchyRule returns the default hierarchy validation rules
 func DefaultHierarchyRule() HierarchyRule {
 	return HierarchyRule{
-		HeadingScaleRatio: 1.25,
-		MinPrimaryCTASize: 120,
-		SpacingScaleRatio: 1.5,
+		HeadingScaleRatio:     1.25,
+		MinPrimaryCTASize:     120,
+		SpacingScaleRatio:     1.5,
+		CheckPrimaryActionRef: true,
+		SinglePrimaryCTA:      true,
 	}
 }
 
@@ -38,6 +43,15 @@ type HierarchyResult struct {
 
 // ValidateHierarchy validates the visual hierarchy of a structure
 func ValidateHierarchy(structure *types.Structure, rule HierarchyRule) HierarchyResult {
+	return ValidateHierarchyWithLayout(structure, nil, rule)
+}
+
+// ValidateHierarchyWithLayout validates the visual hierarchy and also compares the
+// rendered area of primary and secondary buttons from computed layout boxes. A
+// secondary button that stretches to fill its row can dwarf a primary one whose
+// declared width looks fine. Buttons missing from boxes are only compared by
+// declared width; nil boxes behave like ValidateHierarchy.
+func ValidateHierarchyWithLayout(structure *types.Structure, boxes map[string]render.LayoutBox, rule HierarchyRule) HierarchyResult {
 	result := HierarchyResult{
 		Passed: true,
 		Issues: []HierarchyIssue{},
@@ -69,9 +83,14 @@ func ValidateHierarchy(structure *types.Structure, rule HierarchyRule) Hierarchy
 		width     int
 	}{}
 
+	// Index components by ID so primary_action can be resolved
+	componentsByID := map[string]*types.Component{}
+
 	// Traverse components to collect text elements and buttons
 	var traverse func(comp *types.Component, parentSpacing int)
 	traverse = func(comp *types.Component, parentSpacing int) {
+		componentsByID[comp.ID] = comp
+
 		// Check if it's a text element
 		if comp.Type == "text" {
 			size := sizeMap["base"] // default
@@ -179,6 +198,18 @@ func ValidateHierarchy(structure *types.Structure, rule HierarchyRule) Hierarchy
 		traverse(&structure.Components[i], structure.Layout.Spacing)
 	}
 
+	// primary_action is either a component ID or free-text prose; only IDs are checked
+	if rule.CheckPrimaryActionRef && structure.Intent.PrimaryAction != "" {
+		if comp, ok := componentsByID[structure.Intent.PrimaryAction]; ok && !comp.IsInteractive() {
+			result.Issues = append(result.Issues, HierarchyIssue{
+				Severity:  "warning",
+				Message:   fmt.Sprintf("Primary action '%s' refers to a %s, not an interactive element - point it at the primary button", comp.ID, comp.Type),
+				Component: comp.ID,
+			})
+			result.Passed = false
+		}
+	}
+
 	// Validate heading size hierarchy
 	headings := []struct {
 		component *types.Component
@@ -246,6 +277,20 @@ func ValidateHierarchy(structure *types.Structure, rule HierarchyRule) Hierarchy
 		}
 	}
 
+	// A screen should have one clear call to action across the entire tree
+	if rule.SinglePrimaryCTA && len(primaryButtons) > 1 {
+		ids := make([]string, len(primaryButtons))
+		for i, btn := range primaryButtons {
+			ids[i] = btn.component.ID
+		}
+		result.Issues = append(result.Issues, HierarchyIssue{
+			Severity:  "warning",
+			Message:   fmt.Sprintf("%d primary buttons found (%s) - keep a single primary CTA and make the others secondary", len(ids), strings.Join(ids, ", ")),
+			Component: ids[1],
+		})
+		result.Passed = false
+	}
+
 	// Check that primary buttons are not smaller than secondary buttons
 	for _, primary := range primaryButtons {
 		for _, secondary := range secondaryButtons {
@@ -256,6 +301,24 @@ func ValidateHierarchy(structure *types.Structure, rule HierarchyRule) Hierarchy
 					Component: primary.component.ID,
 				})
 				result.Passed = false
+				continue
+			}
+
+			// Prominence is about rendered area, which the declared width alone misses
+			primaryBox, ok1 := boxes[primary.component.ID]
+			secondaryBox, ok2 := boxes[secondary.component.ID]
+			if !ok1 || !ok2 {
+				continue
+			}
+			primaryArea := primaryBox.Width * primaryBox.Height
+			secondaryArea := secondaryBox.Width * secondaryBox.Height
+			if secondaryArea > primaryArea {
+				result.Issues = append(result.Issues, HierarchyIssue{
+					Severity:  "warning",
+					Message:   fmt.Sprintf("Secondary button '%s' renders at %dx%dpx, larger than primary button '%s' at %dx%dpx - the primary action should be the most prominent", secondary.component.ID, secondaryBox.Width, secondaryBox.Height, primary.component.ID, primaryBox.Width, primaryBox.Height),
+					Component: primary.component.ID,
+				})
+				result.Passed = false
 			}
 		}
 	}
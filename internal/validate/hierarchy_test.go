@@ -1,9 +1,11 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -197,3 +199,147 @@ func TestValidateHierarchy_ValidStructure(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateHierarchy_PrimaryActionReference(t *testing.T) {
+	newStructure := func(primaryAction string) *types.Structure {
+		return &types.Structure{
+			Intent: types.Intent{Purpose: "Test", PrimaryAction: primaryAction},
+			Layout: types.Layout{Type: "stack"},
+			Components: []types.Component{
+				{ID: "title", Type: "text", Content: "Sign up"},
+				{ID: "signup-btn", Type: "button", Content: "Create account", Layout: types.ComponentLayout{Width: 160}},
+			},
+		}
+	}
+
+	hasRefIssue := func(result HierarchyResult) bool {
+		for _, issue := range result.Issues {
+			if strings.HasPrefix(issue.Message, "Primary action") {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		name          string
+		primaryAction string
+		expectIssue   bool
+	}{
+		{"references button", "signup-btn", false},
+		{"references non-interactive component", "title", true},
+		{"free text", "Create an account", false},
+		{"empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateHierarchy(newStructure(tt.primaryAction), DefaultHierarchyRule())
+			if got := hasRefIssue(result); got != tt.expectIssue {
+				t.Errorf("Expected primary action issue=%v, got %v (issues: %v)", tt.expectIssue, got, result.Issues)
+			}
+			if tt.expectIssue && result.Passed {
+				t.Error("Expected validation to fail when primary action references a non-interactive component")
+			}
+		})
+	}
+
+	// The check is optional
+	rule := DefaultHierarchyRule()
+	rule.CheckPrimaryActionRef = false
+	if hasRefIssue(ValidateHierarchy(newStructure("title"), rule)) {
+		t.Error("Expected no primary action issue when the check is disabled")
+	}
+}
+
+func TestValidateHierarchy_SinglePrimaryCTA(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "save-primary", Type: "button", Layout: types.ComponentLayout{Width: 160}},
+			{
+				ID:   "footer",
+				Type: "box",
+				Children: []types.Component{
+					// Nested deep in the tree, still counts towards the whole screen
+					{ID: "signup", Type: "button", Role: "primary", Layout: types.ComponentLayout{Width: 160}},
+				},
+			},
+		},
+	}
+
+	result := ValidateHierarchy(structure, DefaultHierarchyRule())
+	if result.Passed {
+		t.Error("Expected validation to fail with two primary buttons")
+	}
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && strings.Contains(issue.Message, "2 primary buttons") {
+			found = true
+			if issue.Component != "signup" {
+				t.Errorf("Expected the extra primary 'signup' to be flagged, got '%s'", issue.Component)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Expected a single-primary warning, got %+v", result.Issues)
+	}
+
+	// A single primary passes
+	structure.Components[1].Children[0].Role = ""
+	if result := ValidateHierarchy(structure, DefaultHierarchyRule()); !result.Passed {
+		t.Errorf("Expected a single primary button to pass, got %+v", result.Issues)
+	}
+}
+
+func TestValidateHierarchyWithLayout_PrimaryDwarfedBySecondary(t *testing.T) {
+	// Declared widths look fine, but the secondary button is a flex item and
+	// stretches across the whole form
+	structure := &types.Structure{
+		Intent: types.Intent{PrimaryAction: "submit"},
+		Components: []types.Component{
+			{
+				ID:   "form",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "submit", Type: "button", Content: "Submit", Layout: types.ComponentLayout{Width: 160, Height: 44}},
+					{ID: "cancel", Type: "button", Content: "Cancel", Layout: types.ComponentLayout{Flex: 1}},
+				},
+			},
+		},
+	}
+
+	declared := ValidateHierarchy(structure, DefaultHierarchyRule())
+	if !declared.Passed {
+		t.Fatalf("Expected declared widths to pass, got: %v", declared.Issues)
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	result := ValidateHierarchyWithLayout(structure, boxes, DefaultHierarchyRule())
+	if result.Passed {
+		t.Error("Expected a primary dwarfed by a secondary button to fail")
+	}
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Component == "submit" && issue.Severity == "warning" && strings.Contains(issue.Message, "Secondary button 'cancel' renders at") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a rendered-area warning for 'cancel', got: %v", result.Issues)
+	}
+
+	// Once the secondary is smaller than the primary, the check passes
+	structure.Components[0].Children[1].Layout = types.ComponentLayout{Width: 120, Height: 44}
+	boxes, err = render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	if result := ValidateHierarchyWithLayout(structure, boxes, DefaultHierarchyRule()); !result.Passed {
+		t.Errorf("Expected a smaller secondary button to pass, got: %v", result.Issues)
+	}
+}
@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// ImageIssue represents an image dimension validation issue
+type ImageIssue struct {
+	ComponentID string  `json:"component_id"`
+	Message     string  `json:"message"`
+	Severity    string  `json:"severity"` // "warning", "info"
+	Width       int     `json:"width"`
+	Height      int     `json:"height"`
+	AspectRatio float64 `json:"aspect_ratio"`
+}
+
+// ImageResult contains the validation results
+type ImageResult struct {
+	Passed bool         `json:"passed"`
+	Issues []ImageIssue `json:"issues"`
+}
+
+// ImageRule defines the image dimension validation rules
+type ImageRule struct {
+	MaxAspectRatio float64 // Longest/shortest side ratio above which an image slot is suspicious (default: 10)
+}
+
+// DefaultImageRule returns the default image dimension validation rules
+func DefaultImageRule() ImageRule {
+	return ImageRule{
+		MaxAspectRatio: 10,
+	}
+}
+
+// ValidateImages flags image components whose explicit width and height give an
+// extreme aspect ratio, which usually means a width or height was mistyped
+func ValidateImages(structure *types.Structure, rule ImageRule) ImageResult {
+	result := ImageResult{
+		Passed: true,
+		Issues: []ImageIssue{},
+	}
+
+	var checkComponent func(comp *types.Component)
+	checkComponent = func(comp *types.Component) {
+		width, height := comp.Layout.Width, comp.Layout.Height
+		if comp.BaseType() == "image" && width > 0 && height > 0 {
+			long, short := width, height
+			if short > long {
+				long, short = short, long
+			}
+			ratio := float64(long) / float64(short)
+
+			if ratio > rule.MaxAspectRatio {
+				result.Issues = append(result.Issues, ImageIssue{
+					ComponentID: comp.ID,
+					Message:     fmt.Sprintf("Image: '%s' is %dx%dpx (%.0f:1 aspect ratio) - this usually indicates a layout error", comp.ID, width, height, ratio),
+					Severity:    "warning",
+					Width:       width,
+					Height:      height,
+					AspectRatio: ratio,
+				})
+				result.Passed = false
+			}
+		}
+
+		for i := range comp.Children {
+			checkComponent(&comp.Children[i])
+		}
+	}
+
+	for i := range structure.Components {
+		checkComponent(&structure.Components[i])
+	}
+
+	return result
+}
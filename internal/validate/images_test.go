@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestValidateImages_AspectRatio(t *testing.T) {
+	tests := []struct {
+		name        string
+		layout      types.ComponentLayout
+		expectIssue bool
+	}{
+		{"landscape photo", types.ComponentLayout{Width: 640, Height: 360}, false},
+		{"wide banner within limit", types.ComponentLayout{Width: 1200, Height: 150}, false},
+		{"extremely wide", types.ComponentLayout{Width: 1200, Height: 60}, true},
+		{"extremely tall", types.ComponentLayout{Width: 20, Height: 400}, true},
+		{"no explicit height", types.ComponentLayout{Width: 1200}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			structure := &types.Structure{
+				Components: []types.Component{{
+					ID:       "gallery",
+					Type:     "box",
+					Children: []types.Component{{ID: "hero", Type: "image", Layout: tt.layout}},
+				}},
+			}
+
+			result := ValidateImages(structure, DefaultImageRule())
+
+			if got := len(result.Issues) > 0; got != tt.expectIssue {
+				t.Fatalf("Expected issue=%v, got %v", tt.expectIssue, result.Issues)
+			}
+			if tt.expectIssue {
+				if result.Passed {
+					t.Error("Expected validation to fail")
+				}
+				if result.Issues[0].ComponentID != "hero" || result.Issues[0].Severity != "warning" {
+					t.Errorf("Unexpected issue: %+v", result.Issues[0])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateImages_IgnoresNonImages(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "divider", Type: "box", Layout: types.ComponentLayout{Width: 1200, Height: 1}},
+		},
+	}
+
+	if result := ValidateImages(structure, DefaultImageRule()); len(result.Issues) != 0 {
+		t.Errorf("Expected no issues for non-image components, got %v", result.Issues)
+	}
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite holds one test case per audit validator
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase is a single validator's outcome
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitMessage is a failure or skipped element with a summary and optional detail
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the audit report as JUnit XML, one test case per validator.
+// Failing validators become failures listing their errors and warnings; validators
+// left out by the selection are skipped; remaining (info) issues go to system-out.
+func WriteJUnit(w io.Writer, suiteName string, report AuditReport) error {
+	suite := junitTestSuite{Name: suiteName}
+
+	for _, entry := range report.Entries {
+		testCase := junitTestCase{Name: entry.Title, ClassName: "prism.audit." + entry.Name}
+
+		var failures, output []string
+		for _, issue := range entry.IssueList() {
+			line := fmt.Sprintf("[%s] %s", issue.Severity, issue.Message)
+			if issue.ComponentID != "" {
+				line += fmt.Sprintf(" (%s)", issue.ComponentID)
+			}
+			if !entry.Passed && (issue.Severity == "error" || issue.Severity == "warning") {
+				failures = append(failures, line)
+			} else {
+				output = append(output, line)
+			}
+		}
+
+		if !entry.Passed {
+			testCase.Failure = &junitMessage{
+				Message: fmt.Sprintf("%s scored %d/100 (%d errors, %d warnings)", entry.Title, entry.Score, entry.Errors, entry.Warnings),
+				Type:    "DesignAuditFailure",
+				Body:    strings.Join(failures, "\n"),
+			}
+			suite.Failures++
+		}
+		testCase.SystemOut = strings.Join(output, "\n")
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	for _, name := range report.Skipped {
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name:      name,
+			ClassName: "prism.audit." + name,
+			Skipped:   &junitMessage{Message: "not selected for this audit"},
+		})
+		suite.Skipped++
+	}
+	suite.Tests = len(suite.Cases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Skipped:  suite.Skipped,
+		Suites:   []junitTestSuite{suite},
+	}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
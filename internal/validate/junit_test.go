@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestWriteJUnit(t *testing.T) {
+	report := AuditReport{
+		Entries: []AuditEntry{
+			{
+				Name: "contrast", Title: "Color Contrast", Passed: false, Score: 85, Errors: 1,
+				Issues: []ContrastIssue{
+					{Severity: "error", Message: "Contrast: 'caption' fails WCAG AA", ComponentID: "caption"},
+					{Severity: "info", Message: "Suggestion: use #595959"},
+				},
+			},
+			{
+				Name: "spacing", Title: "Spacing Scale (8pt Grid)", Passed: true, Score: 100,
+				Issues: []SpacingIssue{{Severity: "info", Message: "Spacing: all values on the grid"}},
+			},
+		},
+		Skipped: []string{"dark_mode"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJUnit(&buf, "v2.json", report); err != nil {
+		t.Fatalf("WriteJUnit failed: %v", err)
+	}
+
+	var parsed junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &parsed); err != nil {
+		t.Fatalf("Output is not valid XML: %v\n%s", err, buf.String())
+	}
+	if parsed.Tests != 3 || parsed.Failures != 1 || parsed.Skipped != 1 {
+		t.Errorf("Unexpected totals: tests=%d failures=%d skipped=%d", parsed.Tests, parsed.Failures, parsed.Skipped)
+	}
+
+	cases := parsed.Suites[0].Cases
+	contrast := cases[0]
+	if contrast.Failure == nil || !strings.Contains(contrast.Failure.Body, "[error] Contrast: 'caption' fails WCAG AA (caption)") {
+		t.Errorf("Expected the contrast error as a failure, got %+v", contrast.Failure)
+	}
+	if strings.Contains(contrast.Failure.Body, "Suggestion") || !strings.Contains(contrast.SystemOut, "Suggestion") {
+		t.Error("Expected info issues in system-out, not in the failure")
+	}
+	if cases[1].Failure != nil || cases[1].ClassName != "prism.audit.spacing" {
+		t.Errorf("Expected spacing to pass, got %+v", cases[1])
+	}
+	if cases[2].Skipped == nil || cases[2].Name != "dark_mode" {
+		t.Errorf("Expected dark_mode to be skipped, got %+v", cases[2])
+	}
+}
@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// LineLengthIssue represents body text set in lines too long to read comfortably
+type LineLengthIssue struct {
+	ComponentID  string `json:"component_id"`
+	Message      string `json:"message"`
+	Severity     string `json:"severity"` // "warning"
+	CharsPerLine int    `json:"chars_per_line"`
+	Width        int    `json:"width"` // computed text width in pixels
+}
+
+// LineLengthResult contains the validation results
+type LineLengthResult struct {
+	Passed bool              `json:"passed"`
+	Issues []LineLengthIssue `json:"issues"`
+}
+
+// LineLengthRule defines the line length validation rules
+type LineLengthRule struct {
+	MaxChars    int // Characters per line above which body text is flagged (default: 80)
+	TargetChars int // Comfortable measure the suggested max-width is based on (default: 75)
+}
+
+// DefaultLineLengthRule returns the default line length validation rules
+func DefaultLineLengthRule() LineLengthRule {
+	return LineLengthRule{
+		MaxChars:    80,
+		TargetChars: 75,
+	}
+}
+
+// headingSizes are text sizes read as headings rather than body text
+var headingSizes = map[string]bool{"xl": true, "2xl": true, "3xl": true, "4xl": true}
+
+// ValidateLineLength estimates how many characters fit on each line of body text at
+// its computed width, using the shared text-width model, and warns when lines run
+// past rule.MaxChars. Text that wraps at its box width fills lines of width divided
+// by the character width; shorter lines keep their own length. Readability is best
+// at about 50-75 characters per line.
+func ValidateLineLength(structure *types.Structure, boxes map[string]render.LayoutBox, rule LineLengthRule) LineLengthResult {
+	result := LineLengthResult{
+		Passed: true,
+		Issues: []LineLengthIssue{},
+	}
+	if boxes == nil {
+		return result
+	}
+
+	var walk func(components []types.Component)
+	walk = func(components []types.Component) {
+		for i := range components {
+			comp := &components[i]
+			walk(comp.Children)
+
+			if comp.BaseType() != "text" || headingSizes[comp.Size] {
+				continue
+			}
+			box, ok := boxes[comp.ID]
+			if !ok {
+				continue
+			}
+			width := box.Width - comp.Layout.Padding*2
+			charWidth := types.TextCharWidth(comp.Size)
+			if width <= 0 {
+				continue
+			}
+
+			perLine := width / charWidth
+			if longest := comp.EstimatedTextWidth() / charWidth; longest < perLine {
+				perLine = longest
+			}
+			if perLine <= rule.MaxChars {
+				continue
+			}
+
+			suggested := rule.TargetChars*charWidth + comp.Layout.Padding*2
+			result.Issues = append(result.Issues, LineLengthIssue{
+				ComponentID:  comp.ID,
+				Message:      fmt.Sprintf("Line Length: '%s' sets ~%d characters per line at %dpx - aim for 50-75; narrow its container or give it a max_width of about %dpx", comp.ID, perLine, width, suggested),
+				Severity:     "warning",
+				CharsPerLine: perLine,
+				Width:        width,
+			})
+			result.Passed = false
+		}
+	}
+	walk(structure.Components)
+
+	return result
+}
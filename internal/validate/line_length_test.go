@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func lineLengthBoxes(t *testing.T, structure *types.Structure) map[string]render.LayoutBox {
+	t.Helper()
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	return boxes
+}
+
+var wideParagraph = strings.Repeat("Prism keeps wireframes readable at every width. ", 8)
+
+func TestValidateLineLength_WideParagraph(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "intro", Type: "text", Content: wideParagraph},
+		},
+	}
+
+	result := ValidateLineLength(structure, lineLengthBoxes(t, structure), DefaultLineLengthRule())
+	if result.Passed || len(result.Issues) != 1 {
+		t.Fatalf("Expected the full-width paragraph to be flagged, got %+v", result.Issues)
+	}
+	issue := result.Issues[0]
+	if issue.ComponentID != "intro" || issue.Severity != "warning" {
+		t.Errorf("Unexpected issue: %+v", issue)
+	}
+	// 1200px of base text at 7px per character
+	if issue.CharsPerLine != 171 {
+		t.Errorf("Expected 171 characters per line, got %d", issue.CharsPerLine)
+	}
+	if !strings.Contains(issue.Message, "max_width of about 525px") {
+		t.Errorf("Expected a max-width recommendation, got %q", issue.Message)
+	}
+}
+
+func TestValidateLineLength_NarrowContainer(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "column",
+				Type:   "box",
+				Layout: types.ComponentLayout{Display: "flex", Width: 520},
+				Children: []types.Component{
+					{ID: "intro", Type: "text", Content: wideParagraph},
+				},
+			},
+		},
+	}
+
+	result := ValidateLineLength(structure, lineLengthBoxes(t, structure), DefaultLineLengthRule())
+	if !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected text in a 520px column to pass, got %+v", result.Issues)
+	}
+}
+
+func TestValidateLineLength_SkipsShortAndHeadingText(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "label", Type: "text", Content: "Short caption"},
+			{ID: "title", Type: "text", Size: "3xl", Content: wideParagraph},
+		},
+	}
+
+	result := ValidateLineLength(structure, lineLengthBoxes(t, structure), DefaultLineLengthRule())
+	if !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected short text and headings to pass, got %+v", result.Issues)
+	}
+}
+
+func TestValidateLineLength_NoBoxes(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "intro", Type: "text", Content: wideParagraph},
+		},
+	}
+
+	if result := ValidateLineLength(structure, nil, DefaultLineLengthRule()); !result.Passed {
+		t.Errorf("Expected no issues without layout boxes, got %+v", result.Issues)
+	}
+}
@@ -11,6 +11,7 @@ type LoadingStateRule struct {
 	ValidStates        []string // Valid state values
 	RequireSkeleton    bool     // Require skeleton config for loading state
 	RequireEmptyMessage bool    // Require message for empty state
+	CheckConsistency   bool     // Warn when a component's content contradicts its declared state
 }
 
 // LoadingStateIssue represents a loading state validation issue
@@ -32,6 +33,7 @@ func DefaultLoadingStateRule() LoadingStateRule {
 		ValidStates:        []string{"loading", "error", "empty", "default", ""},
 		RequireSkeleton:    false, // Optional but recommended
 		RequireEmptyMessage: false, // Optional but recommended
+		CheckConsistency:   true,
 	}
 }
 
@@ -102,6 +104,10 @@ func validateComponentStates(components []types.Component, rule LoadingStateRule
 			}
 		}
 
+		if rule.CheckConsistency {
+			validateStateConsistency(comp, result)
+		}
+
 		// Recursively validate children
 		if len(comp.Children) > 0 {
 			validateComponentStates(comp.Children, rule, result)
@@ -109,6 +115,50 @@ func validateComponentStates(components []types.Component, rule LoadingStateRule
 	}
 }
 
+// validateStateConsistency warns when a component shows more than one state at once:
+// a loading component that already has its content, or an error/empty component
+// that carries a skeleton or has children but no message among them
+func validateStateConsistency(comp types.Component, result *LoadingStateResult) {
+	switch comp.State {
+	case "loading":
+		if comp.Content != "" || comp.Value != "" {
+			result.Issues = append(result.Issues, LoadingStateIssue{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("Loading State: '%s' is loading but already shows content '%s' - show a skeleton or placeholder until it loads", comp.ID, firstNonEmpty(comp.Value, comp.Content)),
+				Severity:    "warning",
+			})
+		}
+	case "error", "empty":
+		if comp.Skeleton != nil {
+			result.Issues = append(result.Issues, LoadingStateIssue{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("Loading State: '%s' is in %s state but has a skeleton - skeletons belong to the loading state", comp.ID, comp.State),
+				Severity:    "warning",
+			})
+		}
+		if comp.Content == "" && len(comp.Children) > 0 && !hasMessage(comp.Children) {
+			result.Issues = append(result.Issues, LoadingStateIssue{
+				ComponentID: comp.ID,
+				Message:     fmt.Sprintf("Loading State: '%s' is in %s state but none of its children is a message - explain what happened and what to do next", comp.ID, comp.State),
+				Severity:    "warning",
+			})
+		}
+	}
+}
+
+// hasMessage reports whether any descendant is text with content
+func hasMessage(components []types.Component) bool {
+	for _, comp := range components {
+		if comp.BaseType() == "text" && comp.Content != "" {
+			return true
+		}
+		if hasMessage(comp.Children) {
+			return true
+		}
+	}
+	return false
+}
+
 func isValidState(state string, validStates []string) bool {
 	for _, valid := range validStates {
 		if state == valid {
@@ -175,6 +225,69 @@ func validateSkeleton(comp types.Component, result *LoadingStateResult) {
 			})
 		}
 	}
+
+	validateSkeletonCoverage(comp, result)
+}
+
+// validateSkeletonCoverage warns when a skeleton doesn't resemble the content it stands in for
+func validateSkeletonCoverage(comp types.Component, result *LoadingStateResult) {
+	leaves := collectContentLeaves(comp.Children)
+	if len(leaves) == 0 {
+		return
+	}
+
+	elements := len(comp.Skeleton.Elements)
+	if elements*2 < len(leaves) || elements > len(leaves)*2 {
+		result.Issues = append(result.Issues, LoadingStateIssue{
+			ComponentID: comp.ID,
+			Message:     fmt.Sprintf("Loading State: '%s' skeleton has %d elements but content has %d - skeleton should resemble the loaded layout", comp.ID, elements, len(leaves)),
+			Severity:    "warning",
+		})
+	}
+
+	// Compare the kinds of placeholders against the kinds of real content
+	skeletonKinds := make(map[string]bool)
+	for _, elem := range comp.Skeleton.Elements {
+		skeletonKinds[elem.Type] = true
+	}
+
+	hasText, hasMedia := false, false
+	for _, leaf := range leaves {
+		switch leaf.Type {
+		case "text":
+			hasText = true
+		case "image":
+			hasMedia = true
+		}
+	}
+
+	if hasText && !skeletonKinds["text"] {
+		result.Issues = append(result.Issues, LoadingStateIssue{
+			ComponentID: comp.ID,
+			Message:     fmt.Sprintf("Loading State: '%s' content has text but skeleton has no text placeholders", comp.ID),
+			Severity:    "info",
+		})
+	}
+	if hasMedia && !skeletonKinds["circle"] && !skeletonKinds["rect"] {
+		result.Issues = append(result.Issues, LoadingStateIssue{
+			ComponentID: comp.ID,
+			Message:     fmt.Sprintf("Loading State: '%s' content has images but skeleton has no circle/rect placeholders", comp.ID),
+			Severity:    "info",
+		})
+	}
+}
+
+// collectContentLeaves flattens children to the leaf components a skeleton would stand in for
+func collectContentLeaves(components []types.Component) []types.Component {
+	var leaves []types.Component
+	for _, comp := range components {
+		if len(comp.Children) > 0 {
+			leaves = append(leaves, collectContentLeaves(comp.Children)...)
+		} else {
+			leaves = append(leaves, comp)
+		}
+	}
+	return leaves
 }
 
 func isValidSkeletonType(skeletonType string) bool {
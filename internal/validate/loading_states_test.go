@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -366,3 +367,143 @@ func TestValidateLoadingStates_MissingSkeletonDimensions(t *testing.T) {
 		t.Errorf("Expected at least 2 warnings about missing dimensions, got %d", warningCount)
 	}
 }
+
+func TestValidateLoadingStates_SkeletonCoverageMismatch(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:    "card",
+				Type:  "box",
+				State: "loading",
+				Skeleton: &types.SkeletonConfig{
+					Elements: []types.SkeletonElement{
+						{Type: "rect", Width: "100%", Height: "120px"},
+					},
+				},
+				Children: []types.Component{
+					{ID: "card-title", Type: "text", Content: "Title"},
+					{ID: "card-line-1", Type: "text", Content: "Line one"},
+					{ID: "card-line-2", Type: "text", Content: "Line two"},
+				},
+			},
+			{
+				ID:    "profile",
+				Type:  "box",
+				State: "loading",
+				Skeleton: &types.SkeletonConfig{
+					Elements: []types.SkeletonElement{
+						{Type: "circle", Size: 48},
+						{Type: "text", Width: "60%"},
+					},
+				},
+				Children: []types.Component{
+					{ID: "avatar", Type: "image"},
+					{ID: "name", Type: "text", Content: "Jane"},
+				},
+			},
+		},
+	}
+
+	result := ValidateLoadingStates(structure, DefaultLoadingStateRule())
+
+	foundCountMismatch := false
+	foundMissingText := false
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "card" && issue.Severity == "warning" {
+			foundCountMismatch = true
+		}
+		if issue.ComponentID == "card" && issue.Severity == "info" {
+			foundMissingText = true
+		}
+		if issue.ComponentID == "profile" {
+			t.Errorf("Expected matching skeleton on 'profile' to produce no issues, got: %s", issue.Message)
+		}
+	}
+
+	if !foundCountMismatch {
+		t.Error("Expected warning about skeleton element count mismatch")
+	}
+	if !foundMissingText {
+		t.Error("Expected info about missing text placeholders")
+	}
+}
+
+func TestValidateLoadingStates_LoadingWithContent(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "balance", Type: "text", State: "loading", Content: "$1,204.00"},
+			{ID: "spinner", Type: "text", State: "loading"},
+		},
+	}
+
+	result := ValidateLoadingStates(structure, DefaultLoadingStateRule())
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "balance" && issue.Severity == "warning" && strings.Contains(issue.Message, "already shows content") {
+			found = true
+		}
+		if issue.ComponentID == "spinner" && strings.Contains(issue.Message, "already shows content") {
+			t.Errorf("Expected no contradiction for a loading component without content, got: %s", issue.Message)
+		}
+	}
+	if !found {
+		t.Error("Expected warning about a loading component that already shows content")
+	}
+
+	rule := DefaultLoadingStateRule()
+	rule.CheckConsistency = false
+	for _, issue := range ValidateLoadingStates(structure, rule).Issues {
+		if strings.Contains(issue.Message, "already shows content") {
+			t.Error("Expected no consistency warnings when CheckConsistency is off")
+		}
+	}
+}
+
+func TestValidateLoadingStates_ErrorWithoutMessage(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:    "results",
+				Type:  "box",
+				State: "error",
+				Skeleton: &types.SkeletonConfig{
+					Elements: []types.SkeletonElement{{Type: "text"}},
+				},
+				Children: []types.Component{
+					{ID: "retry", Type: "button", Content: "Retry"},
+				},
+			},
+			{
+				ID:    "inbox",
+				Type:  "box",
+				State: "empty",
+				Children: []types.Component{
+					{ID: "inbox-message", Type: "text", Content: "No messages yet"},
+				},
+			},
+		},
+	}
+
+	result := ValidateLoadingStates(structure, DefaultLoadingStateRule())
+
+	foundMessage := false
+	foundSkeleton := false
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "results" && strings.Contains(issue.Message, "none of its children is a message") {
+			foundMessage = true
+		}
+		if issue.ComponentID == "results" && strings.Contains(issue.Message, "has a skeleton") {
+			foundSkeleton = true
+		}
+		if issue.ComponentID == "inbox" {
+			t.Errorf("Expected no issues for an empty state with a message, got: %s", issue.Message)
+		}
+	}
+	if !foundMessage {
+		t.Error("Expected warning about an error state with no message child")
+	}
+	if !foundSkeleton {
+		t.Error("Expected warning about a skeleton on an error state")
+	}
+}
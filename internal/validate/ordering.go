@@ -0,0 +1,58 @@
+package validate
+
+import "sort"
+
+// severityRank orders severities from most to least severe
+func severityRank(severity string) int {
+	switch severity {
+	case "error":
+		return 0
+	case "warning":
+		return 1
+	case "info":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// issueLess orders issues by component ID, then severity, then message
+func issueLess(idA, severityA, messageA, idB, severityB, messageB string) bool {
+	if idA != idB {
+		return idA < idB
+	}
+	if rankA, rankB := severityRank(severityA), severityRank(severityB); rankA != rankB {
+		return rankA < rankB
+	}
+	return messageA < messageB
+}
+
+// sortGestaltIssues sorts Gestalt issues into a stable order
+func sortGestaltIssues(issues []GestaltIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issueLess(issues[i].Component, issues[i].Severity, issues[i].Message,
+			issues[j].Component, issues[j].Severity, issues[j].Message)
+	})
+}
+
+// sortResponsiveIssues sorts responsive issues into a stable order
+func sortResponsiveIssues(issues []ResponsiveIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issueLess(issues[i].ComponentID, issues[i].Severity, issues[i].Message,
+			issues[j].ComponentID, issues[j].Severity, issues[j].Message)
+	})
+}
+
+// sortSuggestions sorts suggestions by component ID, then type, then message
+func sortSuggestions(suggestions []Suggestion) {
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.ComponentID != b.ComponentID {
+			return a.ComponentID < b.ComponentID
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Message < b.Message
+	})
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// orderingStructure has several groups, viewports and suggestion categories so map order matters
+func orderingStructure() *types.Structure {
+	return &types.Structure{
+		Layout: types.Layout{MaxWidth: 1200},
+		Components: []types.Component{
+			{
+				ID:     "nav",
+				Type:   "box",
+				Role:   "navigation",
+				Layout: types.ComponentLayout{Width: 900},
+				Children: []types.Component{
+					{ID: "nav-home", Type: "button", Size: "sm", Layout: types.ComponentLayout{Width: 30, Height: 30}},
+					{ID: "nav-about", Type: "button", Size: "lg", Layout: types.ComponentLayout{Width: 30, Height: 30}},
+				},
+			},
+			{
+				ID:   "form",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "email-label", Type: "text", Size: "sm", Content: "Email"},
+					{ID: "email-input", Type: "input", Layout: types.ComponentLayout{MarginBottom: 40}},
+					{ID: "title-a", Type: "text", Size: "xl", Color: "#000000"},
+					{ID: "title-b", Type: "text", Size: "lg", Color: "#737373"},
+					{ID: "submit", Type: "button", Role: "primary", Content: "Submit"},
+				},
+			},
+		},
+	}
+}
+
+func TestValidators_DeterministicOrdering(t *testing.T) {
+	firstGestalt := ValidateGestalt(orderingStructure(), DefaultGestaltRule())
+	firstResponsive := ValidateResponsive(orderingStructure(), DefaultResponsiveRule())
+	firstSuggestions := GenerateSuggestions(orderingStructure(), CategoryAll)
+
+	for i := 0; i < 20; i++ {
+		if got := ValidateGestalt(orderingStructure(), DefaultGestaltRule()); !reflect.DeepEqual(got, firstGestalt) {
+			t.Fatalf("Gestalt issues changed order between runs:\n%v\n%v", firstGestalt.Issues, got.Issues)
+		}
+		if got := ValidateResponsive(orderingStructure(), DefaultResponsiveRule()); !reflect.DeepEqual(got, firstResponsive) {
+			t.Fatalf("Responsive issues changed order between runs:\n%v\n%v", firstResponsive.Issues, got.Issues)
+		}
+		if got := GenerateSuggestions(orderingStructure(), CategoryAll); !reflect.DeepEqual(got, firstSuggestions) {
+			t.Fatalf("Suggestions changed order between runs:\n%v\n%v", firstSuggestions.Categories, got.Categories)
+		}
+	}
+}
+
+func TestSortResponsiveIssues(t *testing.T) {
+	issues := []ResponsiveIssue{
+		{ComponentID: "b", Severity: "warning", Message: "x"},
+		{ComponentID: "a", Severity: "info", Message: "z"},
+		{ComponentID: "a", Severity: "warning", Message: "y"},
+		{ComponentID: "a", Severity: "warning", Message: "b"},
+	}
+
+	sortResponsiveIssues(issues)
+
+	expected := []string{"a/warning/b", "a/warning/y", "a/info/z", "b/warning/x"}
+	for i, issue := range issues {
+		if got := issue.ComponentID + "/" + issue.Severity + "/" + issue.Message; got != expected[i] {
+			t.Errorf("issues[%d] = %s, expected %s", i, got, expected[i])
+		}
+	}
+}
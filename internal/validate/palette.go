@@ -0,0 +1,110 @@
+package validate
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// PaletteRule defines palette adherence for Phase 2 designs
+type PaletteRule struct {
+	Colors []string // allowed colors, used when the structure declares no palette of its own
+}
+
+// DefaultPaletteRule returns the default palette rule (no palette beyond the structure's)
+func DefaultPaletteRule() PaletteRule {
+	return PaletteRule{}
+}
+
+// PaletteIssue represents a palette adherence issue
+type PaletteIssue struct {
+	ComponentID string `json:"component_id"`
+	Field       string `json:"field,omitempty"` // "color" or "layout.background"
+	Color       string `json:"color,omitempty"`
+	Message     string `json:"message"`
+	Severity    string `json:"severity"` // "error", "warning", "info"
+}
+
+// PaletteResult represents the result of palette validation
+type PaletteResult struct {
+	Passed bool           `json:"passed"`
+	Issues []PaletteIssue `json:"issues"`
+}
+
+// ValidatePalette checks that every component color and background comes from the
+// declared palette: the structure's own palette, else rule.Colors. Colors are
+// compared by value, so "#fff" matches "#FFFFFF"; fully transparent is always allowed.
+func ValidatePalette(structure *types.Structure, rule PaletteRule) PaletteResult {
+	result := PaletteResult{
+		Passed: true,
+		Issues: []PaletteIssue{},
+	}
+
+	declared := structure.Palette
+	if len(declared) == 0 {
+		declared = rule.Colors
+	}
+	if len(declared) == 0 {
+		result.Issues = append(result.Issues, PaletteIssue{
+			ComponentID: "structure",
+			Message:     "Palette: No palette declared in the structure or config - skipping palette adherence",
+			Severity:    "info",
+		})
+		return result
+	}
+
+	allowed := map[color.NRGBA]bool{}
+	for _, value := range declared {
+		c, ok := render.ParseColor(value)
+		if !ok {
+			result.Issues = append(result.Issues, PaletteIssue{
+				ComponentID: "structure",
+				Color:       value,
+				Message:     fmt.Sprintf("Palette: Palette entry '%s' is not a recognized color", value),
+				Severity:    "warning",
+			})
+			continue
+		}
+		allowed[c] = true
+	}
+
+	var check func(components []types.Component)
+	check = func(components []types.Component) {
+		for i := range components {
+			comp := &components[i]
+			checkPaletteColor(&result, comp.ID, "color", comp.Color, allowed)
+			checkPaletteColor(&result, comp.ID, "layout.background", comp.Layout.Background, allowed)
+			check(comp.Children)
+		}
+	}
+	check(structure.Components)
+
+	return result
+}
+
+// checkPaletteColor records an error when a set color is missing from the allowed palette
+func checkPaletteColor(result *PaletteResult, componentID, field, value string, allowed map[color.NRGBA]bool) {
+	if value == "" {
+		return
+	}
+
+	c, ok := render.ParseColor(value)
+	if ok && (c.A == 0 || allowed[c]) {
+		return
+	}
+
+	message := fmt.Sprintf("Palette: '%s' %s '%s' is not in the palette", componentID, field, value)
+	if !ok {
+		message = fmt.Sprintf("Palette: '%s' %s '%s' is not a recognized color", componentID, field, value)
+	}
+	result.Issues = append(result.Issues, PaletteIssue{
+		ComponentID: componentID,
+		Field:       field,
+		Color:       value,
+		Message:     message,
+		Severity:    "error",
+	})
+	result.Passed = false
+}
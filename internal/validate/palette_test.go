@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestValidatePalette_OffPalette(t *testing.T) {
+	structure := &types.Structure{
+		Phase:   "design",
+		Palette: []string{"#111827", "#2563EB", "#F9FAFB"},
+		Components: []types.Component{
+			{
+				ID:     "card",
+				Type:   "box",
+				Layout: types.ComponentLayout{Background: "#f9fafb"},
+				Children: []types.Component{
+					{ID: "title", Type: "text", Color: "#111827"},
+					{ID: "cta", Type: "button", Layout: types.ComponentLayout{Background: "#22C55E"}},
+					{ID: "overlay", Type: "box", Layout: types.ComponentLayout{Background: "transparent"}},
+				},
+			},
+		},
+	}
+
+	result := ValidatePalette(structure, DefaultPaletteRule())
+
+	if result.Passed {
+		t.Error("Expected validation to fail with an off-palette background")
+	}
+	if len(result.Issues) != 1 {
+		t.Fatalf("Expected exactly one issue, got %v", result.Issues)
+	}
+	issue := result.Issues[0]
+	if issue.ComponentID != "cta" || issue.Field != "layout.background" || issue.Color != "#22C55E" {
+		t.Errorf("Expected the cta background to be flagged, got %+v", issue)
+	}
+}
+
+func TestValidatePalette_ConfigPalette(t *testing.T) {
+	structure := &types.Structure{
+		Phase: "design",
+		Components: []types.Component{
+			{ID: "title", Type: "text", Color: "#000000"},
+		},
+	}
+
+	// Without any palette there is nothing to enforce
+	result := ValidatePalette(structure, DefaultPaletteRule())
+	if !result.Passed || len(result.Issues) != 1 || result.Issues[0].Severity != "info" {
+		t.Errorf("Expected a passing info result without a palette, got %+v", result)
+	}
+
+	result = ValidatePalette(structure, PaletteRule{Colors: []string{"#111827"}})
+	if result.Passed {
+		t.Error("Expected the configured palette to be enforced")
+	}
+
+	// A palette declared in the structure takes precedence over the config
+	structure.Palette = []string{"#000"}
+	result = ValidatePalette(structure, PaletteRule{Colors: []string{"#111827"}})
+	if !result.Passed {
+		t.Errorf("Expected the structure's palette to win, got %v", result.Issues)
+	}
+}
+
+func TestValidatePalette_UnrecognizedColor(t *testing.T) {
+	structure := &types.Structure{
+		Palette:    []string{"#111827"},
+		Components: []types.Component{{ID: "title", Type: "text", Color: "brand-blue"}},
+	}
+
+	result := ValidatePalette(structure, DefaultPaletteRule())
+	if result.Passed || len(result.Issues) != 1 || !strings.Contains(result.Issues[0].Message, "not a recognized color") {
+		t.Errorf("Expected an unrecognized color error, got %+v", result)
+	}
+}
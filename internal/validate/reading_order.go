@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// ReadingOrderIssue represents a component read out of its visual order
+type ReadingOrderIssue struct {
+	ComponentID string `json:"component_id"`
+	Message     string `json:"message"`
+	Severity    string `json:"severity"` // "warning"
+	Before      string `json:"before"`   // the earlier sibling it appears ahead of on screen
+}
+
+// ReadingOrderResult contains the validation results
+type ReadingOrderResult struct {
+	Passed bool                `json:"passed"`
+	Issues []ReadingOrderIssue `json:"issues"`
+}
+
+// ValidateReadingOrder compares the document order of sibling components with their
+// visual order in the computed layout (top to bottom, then left to right). Screen
+// readers follow the document, so a component that is declared after a sibling but
+// drawn clearly above it, or to its left on the same row, is read out of place.
+func ValidateReadingOrder(structure *types.Structure, boxes map[string]render.LayoutBox) ReadingOrderResult {
+	result := ReadingOrderResult{
+		Passed: true,
+		Issues: []ReadingOrderIssue{},
+	}
+	if boxes == nil {
+		return result
+	}
+
+	var checkSiblings func(components []types.Component)
+	checkSiblings = func(components []types.Component) {
+		for j := range components {
+			later, ok := boxes[components[j].ID]
+			if !ok || later.Width <= 0 || later.Height <= 0 {
+				continue
+			}
+
+			// Report the first earlier sibling the component jumps ahead of
+			for i := 0; i < j; i++ {
+				earlier, ok := boxes[components[i].ID]
+				if !ok || earlier.Width <= 0 || earlier.Height <= 0 {
+					continue
+				}
+				if visuallyBefore(later, earlier) {
+					result.Issues = append(result.Issues, ReadingOrderIssue{
+						ComponentID: components[j].ID,
+						Message:     fmt.Sprintf("Reading Order: '%s' is drawn before '%s' but comes after it in the document - screen readers will read them in the wrong order; reorder the components to match the layout", components[j].ID, components[i].ID),
+						Severity:    "warning",
+						Before:      components[i].ID,
+					})
+					result.Passed = false
+					break
+				}
+			}
+		}
+
+		for i := range components {
+			checkSiblings(components[i].Children)
+		}
+	}
+
+	checkSiblings(structure.Components)
+
+	return result
+}
+
+// visuallyBefore reports whether box a clearly reads before box b: entirely above it,
+// or entirely to its left while sharing a row
+func visuallyBefore(a, b render.LayoutBox) bool {
+	if a.Y+a.Height <= b.Y {
+		return true
+	}
+	sameRow := a.Y < b.Y+b.Height && b.Y < a.Y+a.Height
+	return sameRow && a.X+a.Width <= b.X
+}
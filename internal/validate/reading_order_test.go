@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+func TestValidateReadingOrder_MatchingOrder(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "title", Type: "text", Content: "Sign in"},
+			{
+				ID:     "actions",
+				Type:   "box",
+				Layout: types.ComponentLayout{Display: "flex", Direction: "horizontal"},
+				Children: []types.Component{
+					{ID: "cancel", Type: "button", Content: "Cancel"},
+					{ID: "submit", Type: "button", Content: "Sign in"},
+				},
+			},
+		},
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 800, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	result := ValidateReadingOrder(structure, boxes)
+	if !result.Passed || len(result.Issues) != 0 {
+		t.Errorf("Expected flow layout to read in visual order, got %+v", result.Issues)
+	}
+}
+
+func TestValidateReadingOrder_Reordered(t *testing.T) {
+	top := 0
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:     "page",
+				Type:   "box",
+				Layout: types.ComponentLayout{Display: "flex", Padding: 40},
+				Children: []types.Component{
+					{ID: "body", Type: "text", Content: "Your order has shipped."},
+					{ID: "footer", Type: "text", Content: "Questions? Contact us."},
+					// Declared last but pinned above the padded content
+					{
+						ID:      "heading",
+						Type:    "text",
+						Content: "Order update",
+						Layout:  types.ComponentLayout{Position: "absolute", Top: &top, Left: &top},
+					},
+				},
+			},
+		},
+	}
+
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(structure, 800, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+
+	result := ValidateReadingOrder(structure, boxes)
+	if result.Passed {
+		t.Error("Expected a component drawn above its earlier siblings to fail")
+	}
+	if len(result.Issues) != 1 {
+		t.Fatalf("Expected one issue, got %+v", result.Issues)
+	}
+	issue := result.Issues[0]
+	if issue.ComponentID != "heading" || issue.Before != "body" || issue.Severity != "warning" {
+		t.Errorf("Expected 'heading' flagged ahead of 'body', got %+v", issue)
+	}
+}
+
+func TestValidateReadingOrder_SameRow(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "next", Type: "button", Content: "Next"},
+			{ID: "back", Type: "button", Content: "Back"},
+		},
+	}
+
+	// Next is drawn to the right of Back on one row, but comes first in the document
+	boxes := map[string]render.LayoutBox{
+		"next": {X: 140, Y: 0, Width: 120, Height: 44},
+		"back": {X: 0, Y: 4, Width: 120, Height: 44},
+	}
+
+	result := ValidateReadingOrder(structure, boxes)
+	if len(result.Issues) != 1 || result.Issues[0].ComponentID != "back" {
+		t.Errorf("Expected 'back' flagged as read after 'next', got %+v", result.Issues)
+	}
+
+	if result := ValidateReadingOrder(structure, nil); !result.Passed {
+		t.Error("Expected no issues without a layout")
+	}
+}
@@ -0,0 +1,215 @@
+package validate
+
+import (
+	"slices"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// ValidatorInfo describes a validator: how it is selected on the command line,
+// which phase it targets and which function implements it
+type ValidatorInfo struct {
+	Name        string `json:"name"`           // machine name, e.g. "touch_targets"
+	Flag        string `json:"flag,omitempty"` // validate flag, e.g. "touch-targets"; empty for API-only validators
+	Title       string `json:"title"`          // display name, e.g. "Touch Targets (Fitts's Law)"
+	Phase       int    `json:"phase"`          // 1 for structural checks, 2 for visual design
+	Description string `json:"description"`
+	Audit       bool   `json:"audit"`              // whether prism audit runs it
+	Function    string `json:"function,omitempty"` // the Validate* function behind it; empty for render notes
+
+	// run executes an audit validator and returns whether it passed and its issue slice
+	run func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{})
+	// applies reports whether the audit has anything for the validator to check;
+	// nil means always. Validators that don't apply are left out of the score.
+	applies func(structure *types.Structure, rules AuditRules) bool
+}
+
+// validators is the registry of every validator; audit validators are listed in run order
+var validators = []ValidatorInfo{
+	{
+		Name: "hierarchy", Flag: "hierarchy", Title: "Visual Hierarchy", Phase: 1, Audit: true,
+		Description: "Visual hierarchy (heading scale, nesting depth, button prominence)",
+		Function:    "ValidateHierarchy",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateHierarchyWithLayout(structure, boxes, DefaultHierarchyRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "touch_targets", Flag: "touch-targets", Title: "Touch Targets (Fitts's Law)", Phase: 1, Audit: true,
+		Description: "Touch target sizing (44x44px minimum) and spacing between targets",
+		Function:    "ValidateTouchTargets",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateTouchTargetsWithLayout(structure, boxes, DefaultTouchTargetRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "gestalt", Flag: "gestalt", Title: "Gestalt Principles", Phase: 1, Audit: true,
+		Description: "Gestalt principles (proximity, similarity, continuity)",
+		Function:    "ValidateGestalt",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateGestaltWithLayout(structure, boxes, DefaultGestaltRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "accessibility", Flag: "accessibility", Title: "Accessibility (WCAG)", Phase: 1, Audit: true,
+		Description: "WCAG compliance (labels, heading order, focus states)",
+		Function:    "ValidateAccessibility",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateAccessibility(structure, DefaultA11yRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "choice_overload", Flag: "choice-overload", Title: "Choice Overload (Hick's Law)", Phase: 1, Audit: true,
+		Description: "Choice overload (Hick's Law, max 7 nav items)",
+		Function:    "ValidateChoiceOverload",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateChoiceOverload(structure, rules.ChoiceOverload)
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "contrast", Flag: "contrast", Title: "Color Contrast", Phase: 2, Audit: true,
+		Description: "Color contrast (WCAG AA: 4.5:1 text, 3:1 UI)",
+		Function:    "ValidateContrast",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateContrast(structure, rules.Contrast)
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "spacing", Flag: "spacing", Title: "Spacing Scale (8pt Grid)", Phase: 2, Audit: true,
+		Description: "8pt grid compliance (multiples of 4 or 8)",
+		Function:    "ValidateSpacing",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateSpacing(structure, rules.Spacing)
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "typography", Flag: "typography", Title: "Typography Scale", Phase: 2, Audit: true,
+		Description: "Typography scale (consistent ratios, 8-10 sizes)",
+		Function:    "ValidateTypography",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateTypography(structure, rules.Typography)
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "elevation", Flag: "elevation", Title: "Shadow & Elevation", Phase: 2, Audit: true,
+		Description: "Shadow/elevation system (3-4 levels)",
+		Function:    "ValidateElevation",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateElevation(structure, DefaultElevationRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "loading_states", Flag: "loading-states", Title: "Loading States", Phase: 2, Audit: true,
+		Description: "Loading indicators, skeleton screens and empty/error states",
+		Function:    "ValidateLoadingStates",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateLoadingStates(structure, DefaultLoadingStateRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "responsive", Flag: "responsive", Title: "Responsive Breakpoints", Phase: 2, Audit: true,
+		Description: "Responsive breakpoints (mobile, tablet, desktop)",
+		Function:    "ValidateResponsive",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateResponsive(structure, DefaultResponsiveRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "focus", Flag: "focus", Title: "Focus Indicators", Phase: 2, Audit: true,
+		Description: "Focus indicator visibility (2px outline, 3:1 contrast)",
+		Function:    "ValidateFocus",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateFocus(structure, DefaultFocusRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "dark_mode", Flag: "dark-mode", Title: "Dark Mode Support", Phase: 2, Audit: true,
+		Description: "Dark mode support (separate palette, contrast)",
+		Function:    "ValidateDarkMode",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidateDarkMode(structure, DefaultDarkModeRule())
+			return result.Passed, result.Issues
+		},
+	},
+	{
+		Name: "palette", Flag: "palette", Title: "Palette Adherence", Phase: 2, Audit: true,
+		Description: "Colors and backgrounds outside the declared palette (structure, else config)",
+		Function:    "ValidatePalette",
+		run: func(structure *types.Structure, boxes map[string]render.LayoutBox, rules AuditRules) (bool, interface{}) {
+			result := ValidatePalette(structure, rules.Palette)
+			return result.Passed, result.Issues
+		},
+		applies: func(structure *types.Structure, rules AuditRules) bool {
+			return len(structure.Palette) > 0 || len(rules.Palette.Colors) > 0
+		},
+	},
+	{
+		Name: "layout", Flag: "layout", Title: "Layout Notes", Phase: 1,
+		Description: "Layout notes from the render engine (ragged grid rows)",
+	},
+	{
+		Name: "content_length", Flag: "content-length", Title: "Content Length", Phase: 1,
+		Description: "Text content that is too long for its component's width",
+		Function:    "ValidateContentLength",
+	},
+	{
+		Name: "images", Flag: "images", Title: "Image Dimensions", Phase: 1,
+		Description: "Image slots with an extreme aspect ratio (e.g. 20:1)",
+		Function:    "ValidateImages",
+	},
+	{
+		Name: "reading_order", Flag: "reading-order", Title: "Reading Order", Phase: 1,
+		Description: "Document order that differs from the visual order screen readers should follow",
+		Function:    "ValidateReadingOrder",
+	},
+	{
+		Name: "line_length", Flag: "line-length", Title: "Line Length", Phase: 1,
+		Description: "Body text wider than about 80 characters per line at its computed width",
+		Function:    "ValidateLineLength",
+	},
+	{
+		Name: "declared", Title: "Declared Validation", Phase: 1,
+		Description: "Checks the validation block claims passed that the live validators now fail",
+		Function:    "ValidateDeclared",
+	},
+}
+
+// Validators returns every known validator, audit validators first in run order
+func Validators() []ValidatorInfo {
+	return slices.Clone(validators)
+}
+
+// ValidatorFlags returns the validate command flags that select a validator
+func ValidatorFlags() []string {
+	flags := []string{}
+	for _, v := range validators {
+		if v.Flag != "" {
+			flags = append(flags, v.Flag)
+		}
+	}
+	return flags
+}
+
+// registeredAuditNames returns the machine names of the audit validators in run order
+func registeredAuditNames() []string {
+	names := []string{}
+	for _, v := range validators {
+		if v.Audit {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
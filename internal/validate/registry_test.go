@@ -0,0 +1,118 @@
+package validate
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// structureValidators parses the package source and returns the name of every
+// exported Validate* function that takes a structure, with WithLayout variants
+// folded into their plain name
+func structureValidators(t *testing.T) map[string]bool {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse package: %v", err)
+	}
+
+	names := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Validate") {
+					continue
+				}
+				params := fn.Type.Params.List
+				if len(params) == 0 {
+					continue
+				}
+				star, ok := params[0].Type.(*ast.StarExpr)
+				if !ok {
+					continue
+				}
+				if sel, ok := star.X.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Structure" {
+					continue
+				}
+				names[strings.TrimSuffix(fn.Name.Name, "WithLayout")] = true
+			}
+		}
+	}
+	return names
+}
+
+func TestValidators_CoverEveryValidateFunction(t *testing.T) {
+	functions := structureValidators(t)
+	if len(functions) == 0 {
+		t.Fatal("Expected to find Validate* functions in the package")
+	}
+
+	registered := map[string]bool{}
+	for _, v := range Validators() {
+		if v.Function != "" {
+			registered[v.Function] = true
+			if !functions[v.Function] {
+				t.Errorf("Validator '%s' names %s, which does not exist", v.Name, v.Function)
+			}
+		}
+	}
+
+	for name := range functions {
+		if !registered[name] {
+			t.Errorf("%s is not listed in the validator registry", name)
+		}
+	}
+}
+
+func TestValidators_Registry(t *testing.T) {
+	seenNames := map[string]bool{}
+	seenFlags := map[string]bool{}
+	for _, v := range Validators() {
+		if v.Name == "" || v.Title == "" || v.Description == "" {
+			t.Errorf("Validator %+v is missing a name, title or description", v)
+		}
+		if v.Phase != 1 && v.Phase != 2 {
+			t.Errorf("Validator '%s' has phase %d, expected 1 or 2", v.Name, v.Phase)
+		}
+		if seenNames[v.Name] {
+			t.Errorf("Validator name '%s' is registered twice", v.Name)
+		}
+		seenNames[v.Name] = true
+		if v.Flag != "" {
+			if seenFlags[v.Flag] {
+				t.Errorf("Validator flag '%s' is registered twice", v.Flag)
+			}
+			seenFlags[v.Flag] = true
+			if v.Flag != strings.ReplaceAll(v.Name, "_", "-") {
+				t.Errorf("Validator '%s' has flag '%s', expected the hyphenated name", v.Name, v.Flag)
+			}
+		}
+		if v.Audit != (v.run != nil) {
+			t.Errorf("Validator '%s' must have a run function exactly when it is part of the audit", v.Name)
+		}
+	}
+
+	if got := len(AuditValidatorNames()); got != 14 {
+		t.Errorf("Expected 14 audit validators, got %d", got)
+	}
+	// A declared palette makes every audit validator apply
+	report := RunAudit(&types.Structure{
+		Palette:    []string{"#000000"},
+		Components: []types.Component{{ID: "title", Type: "text", Content: "Title"}},
+	})
+	for i, name := range AuditValidatorNames() {
+		if report.Entries[i].Name != name {
+			t.Errorf("Expected audit entry %d to be '%s', got '%s'", i, name, report.Entries[i].Name)
+		}
+	}
+}
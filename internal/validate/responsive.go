@@ -1,7 +1,10 @@
 package validate
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/johanbellander/prism/internal/types"
 )
@@ -26,6 +29,8 @@ type ResponsiveRule struct {
 	MinTouchTarget    int            // Minimum touch target size for mobile
 	CheckOverflow     bool           // Whether to check for content overflow
 	CheckTouchTargets bool           // Whether to validate touch targets at each breakpoint
+	CheckChanges      bool           // Whether to report responsive changes that are no-ops or target nothing
+	ViewportWidth     int            // Target viewport width; wider fixed-width components are errors (0 to skip)
 }
 
 // DefaultResponsiveRule returns the default responsive validation rules
@@ -39,6 +44,7 @@ func DefaultResponsiveRule() ResponsiveRule {
 		MinTouchTarget:    44,
 		CheckOverflow:     true,
 		CheckTouchTargets: true,
+		CheckChanges:      true,
 	}
 }
 
@@ -70,6 +76,18 @@ func ValidateResponsive(structure *types.Structure, rule ResponsiveRule) Respons
 		}
 	}
 
+	if rule.ViewportWidth > 0 {
+		validateViewportFit(&result, structure.Components, rule)
+	}
+
+	if rule.CheckChanges {
+		validateResponsiveChanges(&result, structure, "mobile", structure.Responsive.Mobile.Changes)
+		validateResponsiveChanges(&result, structure, "tablet", structure.Responsive.Tablet.Changes)
+	}
+
+	// Breakpoints are a map, so sort for stable output
+	sortResponsiveIssues(result.Issues)
+
 	// If no errors found, mark as passed
 	if len(result.Issues) == 0 {
 		result.Passed = true
@@ -106,8 +124,9 @@ func validateComponentAtViewport(result *ResponsiveResult, component *types.Comp
 		}
 	}
 
-	// Check width if defined
-	if width > 0 && rule.CheckOverflow && width > viewportWidth {
+	// Check width if defined; at the target viewport the fit check reports it as an error
+	targetOverflow := rule.ViewportWidth > 0 && viewportWidth == rule.ViewportWidth && width > rule.ViewportWidth
+	if width > 0 && rule.CheckOverflow && width > viewportWidth && !targetOverflow {
 		result.Issues = append(result.Issues, ResponsiveIssue{
 			ComponentID: component.ID,
 			Message:     fmt.Sprintf("Component '%s' width (%dpx) exceeds %s viewport (%dpx)", component.ID, width, viewport, viewportWidth),
@@ -137,3 +156,83 @@ func validateComponentAtViewport(result *ResponsiveResult, component *types.Comp
 		validateComponentAtViewport(result, &child, viewport, viewportWidth, rule, parentX+width, parentY+height)
 	}
 }
+
+// validateViewportFit reports components whose declared width exceeds the target
+// viewport width: a fixed width does not shrink, so the page scrolls horizontally
+func validateViewportFit(result *ResponsiveResult, components []types.Component, rule ResponsiveRule) {
+	viewport := fmt.Sprintf("%dpx", rule.ViewportWidth)
+	for name, width := range rule.Breakpoints {
+		if width == rule.ViewportWidth {
+			viewport = name
+		}
+	}
+
+	var walk func(components []types.Component)
+	walk = func(components []types.Component) {
+		for i := range components {
+			comp := &components[i]
+			if comp.Layout.Width > rule.ViewportWidth {
+				result.Issues = append(result.Issues, ResponsiveIssue{
+					ComponentID: comp.ID,
+					Message:     fmt.Sprintf("Component '%s' has a fixed width of %dpx, wider than the %s viewport (%dpx) - it will scroll horizontally; drop the width or use max_width instead", comp.ID, comp.Layout.Width, viewport, rule.ViewportWidth),
+					Severity:    "error",
+					Viewport:    viewport,
+				})
+			}
+			walk(comp.Children)
+		}
+	}
+	walk(components)
+}
+
+// validateResponsiveChanges resolves each responsive change path against the base
+// structure, reporting changes that target nothing (warning) or repeat the base value (info)
+func validateResponsiveChanges(result *ResponsiveResult, structure *types.Structure, viewport string, changes map[string]interface{}) {
+	paths := make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		base, err := structure.ResolvePath(path)
+		if err != nil {
+			result.Issues = append(result.Issues, ResponsiveIssue{
+				ComponentID: "responsive",
+				Message:     fmt.Sprintf("Responsive change '%s' has no effect: %v", path, err),
+				Severity:    "warning",
+				Viewport:    viewport,
+			})
+			continue
+		}
+
+		if sameJSONValue(base, changes[path]) {
+			result.Issues = append(result.Issues, ResponsiveIssue{
+				ComponentID: "responsive",
+				Message:     fmt.Sprintf("Responsive change '%s' sets %v, the same as the base value - remove it", path, changes[path]),
+				Severity:    "info",
+				Viewport:    viewport,
+			})
+		}
+	}
+}
+
+// sameJSONValue reports whether two values encode to the same JSON, so 16 (int)
+// and 16 (float64 from a decoded change) compare equal
+func sameJSONValue(a, b interface{}) bool {
+	normalize := func(v interface{}) (interface{}, bool) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, false
+		}
+		var out interface{}
+		if err := json.Unmarshal(data, &out); err != nil {
+			return nil, false
+		}
+		return out, true
+	}
+
+	na, okA := normalize(a)
+	nb, okB := normalize(b)
+	return okA && okB && reflect.DeepEqual(na, nb)
+}
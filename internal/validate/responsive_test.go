@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -307,3 +308,130 @@ func TestValidateResponsive_CustomRule(t *testing.T) {
 		t.Error("Expected warning for button smaller than custom touch target")
 	}
 }
+
+func TestValidateResponsive_UnusedChanges(t *testing.T) {
+	structure := &types.Structure{
+		Layout: types.Layout{Padding: 16},
+		Components: []types.Component{
+			{ID: "metrics-grid", Type: "box", Layout: types.ComponentLayout{Direction: "horizontal"}},
+		},
+		Responsive: types.Responsive{
+			Mobile: types.ResponsiveBreakpoint{
+				Breakpoint: 640,
+				Changes: map[string]interface{}{
+					"layout.padding":                float64(16), // same as the base
+					"metrics-grid.layout.direction": "vertical",  // a real change
+					"sidebar.layout.padding":        float64(8),  // no such component
+				},
+			},
+			Tablet: types.ResponsiveBreakpoint{
+				Breakpoint: 1024,
+				Changes: map[string]interface{}{
+					"metrics-grid.layout.grid_columns": float64(4), // no such field
+				},
+			},
+		},
+	}
+
+	result := ValidateResponsive(structure, DefaultResponsiveRule())
+	if !result.Passed {
+		t.Error("Expected unused changes to be reported without failing")
+	}
+
+	byPath := map[string]ResponsiveIssue{}
+	for _, issue := range result.Issues {
+		for _, path := range []string{"layout.padding", "metrics-grid.layout.direction", "sidebar.layout.padding", "metrics-grid.layout.grid_columns"} {
+			if strings.Contains(issue.Message, "'"+path+"'") {
+				byPath[path] = issue
+			}
+		}
+	}
+
+	if issue, ok := byPath["layout.padding"]; !ok || issue.Severity != "info" || issue.Viewport != "mobile" {
+		t.Errorf("Expected a mobile no-op info for layout.padding, got %+v", issue)
+	}
+	if _, ok := byPath["metrics-grid.layout.direction"]; ok {
+		t.Error("Expected a real change not to be reported")
+	}
+	if issue, ok := byPath["sidebar.layout.padding"]; !ok || issue.Severity != "warning" {
+		t.Errorf("Expected a warning for a missing component, got %+v", issue)
+	}
+	if issue, ok := byPath["metrics-grid.layout.grid_columns"]; !ok || issue.Severity != "warning" || issue.Viewport != "tablet" {
+		t.Errorf("Expected a tablet warning for an unknown field, got %+v", issue)
+	}
+}
+
+func TestValidateResponsive_EmptyPathSegment(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{{ID: "c1", Type: "text", Content: "Hi"}},
+		Responsive: types.Responsive{
+			Mobile: types.ResponsiveBreakpoint{
+				Breakpoint: 640,
+				Changes: map[string]interface{}{
+					"c1.":        "x", // used to match the unexported raw field and panic
+					"c1..layout": "x",
+				},
+			},
+		},
+	}
+
+	result := ValidateResponsive(structure, DefaultResponsiveRule())
+
+	warned := 0
+	for _, issue := range result.Issues {
+		if issue.Severity == "warning" && strings.Contains(issue.Message, "empty path segment") {
+			warned++
+		}
+	}
+	if warned != 2 {
+		t.Errorf("Expected both paths to be reported as unused, got %+v", result.Issues)
+	}
+}
+
+func TestValidateResponsive_ViewportWidth(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "page",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "table", Type: "box", Layout: types.ComponentLayout{Width: 500, Height: 200}},
+					{ID: "card", Type: "box", Layout: types.ComponentLayout{Width: 343, Height: 200}},
+				},
+			},
+		},
+	}
+
+	// Without a target viewport a wide component is only a warning
+	if result := ValidateResponsive(structure, DefaultResponsiveRule()); !result.Passed {
+		t.Errorf("Expected warnings only without a viewport width, got %+v", result.Issues)
+	}
+
+	rule := DefaultResponsiveRule()
+	rule.ViewportWidth = 375
+	result := ValidateResponsive(structure, rule)
+	if result.Passed {
+		t.Error("Expected a 500px component to fail at the 375px mobile viewport")
+	}
+
+	errors := 0
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "card" {
+			t.Errorf("Expected the 343px card to fit, got %+v", issue)
+		}
+		if issue.ComponentID != "table" || issue.Viewport != "mobile" {
+			continue
+		}
+		if issue.Severity != "error" {
+			t.Errorf("Expected the mobile overflow to be an error, got %+v", issue)
+			continue
+		}
+		errors++
+		if !strings.Contains(issue.Message, "500px") || !strings.Contains(issue.Message, "375px") {
+			t.Errorf("Expected the widths in the message, got %q", issue.Message)
+		}
+	}
+	if errors != 1 {
+		t.Errorf("Expected exactly one mobile error for 'table', got %d: %+v", errors, result.Issues)
+	}
+}
@@ -3,27 +3,32 @@ package validate
 import (
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/johanbellander/prism/internal/types"
 )
 
 // SpacingRule defines validation rules for spacing (8pt grid system)
 type SpacingRule struct {
-	BaseUnit         int     // 8px base unit
-	AllowedScale     []int   // Allowed spacing values: 0, 4, 8, 12, 16, 24, 32, 48, 64, 96, 128
-	WarnOffGrid      bool    // Warn when values are off-grid
-	AllowHalfStep    bool    // Allow 4px for fine-tuning
-	MaxHalfStepUsage int     // Maximum number of 4px usages before warning
+	BaseUnit          int   // 8px base unit
+	AllowedScale      []int // Allowed spacing values: 0, 4, 8, 12, 16, 24, 32, 48, 64, 96, 128
+	WarnOffGrid       bool  // Warn when values are off-grid
+	AllowHalfStep     bool  // Allow 4px for fine-tuning
+	MaxHalfStepUsage  int   // Maximum number of 4px usages before warning
+	MaxDistinctValues int   // Maximum distinct spacing values before recommending consolidation
+	NearDuplicateGap  int   // Values this close (in px) are reported as near-duplicates
 }
 
 // DefaultSpacingRule returns the default 8pt grid validation rules
 func DefaultSpacingRule() SpacingRule {
 	return SpacingRule{
-		BaseUnit:         8,
-		AllowedScale:     []int{0, 4, 8, 12, 16, 24, 32, 48, 64, 96, 128},
-		WarnOffGrid:      true,
-		AllowHalfStep:    true,
-		MaxHalfStepUsage: 5,
+		BaseUnit:          8,
+		AllowedScale:      []int{0, 4, 8, 12, 16, 24, 32, 48, 64, 96, 128},
+		WarnOffGrid:       true,
+		AllowHalfStep:     true,
+		MaxHalfStepUsage:  5,
+		MaxDistinctValues: 8,
+		NearDuplicateGap:  2,
 	}
 }
 
@@ -53,100 +58,47 @@ func ValidateSpacing(structure *types.Structure, rule SpacingRule) SpacingResult
 
 	halfStepCount := 0
 
-	// Analyze all components for spacing values
-	var analyzeComponent func(comp *types.Component, depth int)
-	analyzeComponent = func(comp *types.Component, depth int) {
-		// Check layout padding
-		if comp.Layout.Padding > 0 {
-			if !isOnGrid(comp.Layout.Padding, rule.AllowedScale) {
-				suggested := findNearestGridValue(comp.Layout.Padding, rule.AllowedScale)
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "warning",
-					Category:    "off_grid",
-					Message:     fmt.Sprintf("Spacing: '%s' padding uses %dpx (not on 8pt grid)", comp.ID, comp.Layout.Padding),
-					ComponentID: comp.ID,
-					Property:    "padding",
-					Value:       comp.Layout.Padding,
-					Suggested:   suggested,
-				})
-				result.Passed = false
-				
-				// Add suggestion
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "info",
-					Category:    "suggestion",
-					Message:     fmt.Sprintf("   Suggestion: Use %dpx for consistency", suggested),
-					ComponentID: comp.ID,
-					Property:    "padding",
-					Suggested:   suggested,
-				})
-				
-				// Track half-step usage
-				if comp.Layout.Padding%4 == 0 && comp.Layout.Padding%8 != 0 {
-					halfStepCount++
-				}
-			}
+	// checkComponentValue reports a component spacing property that is off the grid
+	checkComponentValue := func(comp *types.Component, property string, value int) {
+		// Half-steps count whether or not they are on the allowed scale (4 usually is)
+		if isHalfStep(value) {
+			halfStepCount++
 		}
-
-		// Check layout gap
-		if comp.Layout.Gap > 0 {
-			if !isOnGrid(comp.Layout.Gap, rule.AllowedScale) {
-				suggested := findNearestGridValue(comp.Layout.Gap, rule.AllowedScale)
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "warning",
-					Category:    "off_grid",
-					Message:     fmt.Sprintf("Spacing: '%s' gap uses %dpx (not on 8pt grid)", comp.ID, comp.Layout.Gap),
-					ComponentID: comp.ID,
-					Property:    "gap",
-					Value:       comp.Layout.Gap,
-					Suggested:   suggested,
-				})
-				result.Passed = false
-				
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "info",
-					Category:    "suggestion",
-					Message:     fmt.Sprintf("   Suggestion: Use %dpx for consistency", suggested),
-					ComponentID: comp.ID,
-					Property:    "gap",
-					Suggested:   suggested,
-				})
-				
-				if comp.Layout.Gap%4 == 0 && comp.Layout.Gap%8 != 0 {
-					halfStepCount++
-				}
-			}
+		if value <= 0 || isOnGrid(value, rule.AllowedScale) {
+			return
 		}
 
-		// Check margin bottom
-		if comp.Layout.MarginBottom > 0 {
-			if !isOnGrid(comp.Layout.MarginBottom, rule.AllowedScale) {
-				suggested := findNearestGridValue(comp.Layout.MarginBottom, rule.AllowedScale)
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "warning",
-					Category:    "off_grid",
-					Message:     fmt.Sprintf("Spacing: '%s' margin_bottom uses %dpx (not on 8pt grid)", comp.ID, comp.Layout.MarginBottom),
-					ComponentID: comp.ID,
-					Property:    "margin_bottom",
-					Value:       comp.Layout.MarginBottom,
-					Suggested:   suggested,
-				})
-				result.Passed = false
-				
-				result.Issues = append(result.Issues, SpacingIssue{
-					Severity:    "info",
-					Category:    "suggestion",
-					Message:     fmt.Sprintf("   Suggestion: Use %dpx for consistency", suggested),
-					ComponentID: comp.ID,
-					Property:    "margin_bottom",
-					Suggested:   suggested,
-				})
-				
-				if comp.Layout.MarginBottom%4 == 0 && comp.Layout.MarginBottom%8 != 0 {
-					halfStepCount++
-				}
-			}
-		}
+		suggested := findNearestGridValue(value, rule.AllowedScale)
+		result.Issues = append(result.Issues, SpacingIssue{
+			Severity:    "warning",
+			Category:    "off_grid",
+			Message:     fmt.Sprintf("Spacing: '%s' %s uses %dpx (not on 8pt grid)", comp.ID, property, value),
+			ComponentID: comp.ID,
+			Property:    property,
+			Value:       value,
+			Suggested:   suggested,
+		})
+		result.Passed = false
+
+		// Add suggestion
+		result.Issues = append(result.Issues, SpacingIssue{
+			Severity:    "info",
+			Category:    "suggestion",
+			Message:     fmt.Sprintf("   Suggestion: Use %dpx for consistency", suggested),
+			ComponentID: comp.ID,
+			Property:    property,
+			Suggested:   suggested,
+		})
+	}
+
+	// Analyze all components for spacing values
+	var analyzeComponent func(comp *types.Component, depth int)
+	analyzeComponent = func(comp *types.Component, depth int) {
+		checkComponentValue(comp, "padding", comp.Layout.Padding)
+		checkComponentValue(comp, "gap", comp.Layout.Gap)
+		checkComponentValue(comp, "row_gap", comp.Layout.RowGap)
+		checkComponentValue(comp, "column_gap", comp.Layout.ColumnGap)
+		checkComponentValue(comp, "margin_bottom", comp.Layout.MarginBottom)
 
 		// Recurse into children
 		for i := range comp.Children {
@@ -155,6 +107,9 @@ func ValidateSpacing(structure *types.Structure, rule SpacingRule) SpacingResult
 	}
 
 	// Check top-level layout spacing
+	if isHalfStep(structure.Layout.Spacing) {
+		halfStepCount++
+	}
 	if structure.Layout.Spacing > 0 {
 		if !isOnGrid(structure.Layout.Spacing, rule.AllowedScale) {
 			suggested := findNearestGridValue(structure.Layout.Spacing, rule.AllowedScale)
@@ -177,14 +132,13 @@ func ValidateSpacing(structure *types.Structure, rule SpacingRule) SpacingResult
 				Property:    "spacing",
 				Suggested:   suggested,
 			})
-			
-			if structure.Layout.Spacing%4 == 0 && structure.Layout.Spacing%8 != 0 {
-				halfStepCount++
-			}
 		}
 	}
 
 	// Check top-level layout padding
+	if isHalfStep(structure.Layout.Padding) {
+		halfStepCount++
+	}
 	if structure.Layout.Padding > 0 {
 		if !isOnGrid(structure.Layout.Padding, rule.AllowedScale) {
 			suggested := findNearestGridValue(structure.Layout.Padding, rule.AllowedScale)
@@ -207,10 +161,6 @@ func ValidateSpacing(structure *types.Structure, rule SpacingRule) SpacingResult
 				Property:    "padding",
 				Suggested:   suggested,
 			})
-			
-			if structure.Layout.Padding%4 == 0 && structure.Layout.Padding%8 != 0 {
-				halfStepCount++
-			}
 		}
 	}
 
@@ -228,9 +178,110 @@ func ValidateSpacing(structure *types.Structure, rule SpacingRule) SpacingResult
 		})
 	}
 
+	result.Issues = append(result.Issues, checkSpacingConsistency(structure, rule)...)
+
 	return result
 }
 
+// isHalfStep reports whether a spacing value is a 4px half-step: a multiple of 4 but not of 8
+func isHalfStep(value int) bool {
+	return value > 0 && value%4 == 0 && value%8 != 0
+}
+
+// checkSpacingConsistency reports designs that use too many distinct spacing values
+// and pairs of values so close together that they are likely meant to be the same
+func checkSpacingConsistency(structure *types.Structure, rule SpacingRule) []SpacingIssue {
+	issues := []SpacingIssue{}
+	values := types.ComputeStats(structure).SpacingValues
+
+	if rule.MaxDistinctValues > 0 && len(values) > rule.MaxDistinctValues {
+		issues = append(issues, SpacingIssue{
+			Severity: "warning",
+			Category: "too_many_values",
+			Message:  fmt.Sprintf("Spacing: %d distinct spacing values in use (%s) - a tight scale reads as more consistent", len(values), formatPixelValues(values)),
+		})
+		issues = append(issues, SpacingIssue{
+			Severity: "info",
+			Category: "suggestion",
+			Message:  fmt.Sprintf("   Suggestion: Consolidate to %d or fewer values from the scale", rule.MaxDistinctValues),
+		})
+	}
+
+	if rule.NearDuplicateGap > 0 {
+		for i := 1; i < len(values); i++ {
+			if values[i]-values[i-1] <= rule.NearDuplicateGap {
+				issues = append(issues, SpacingIssue{
+					Severity:  "info",
+					Category:  "near_duplicate",
+					Message:   fmt.Sprintf("Spacing: %dpx and %dpx are nearly identical - pick one", values[i-1], values[i]),
+					Value:     values[i],
+					Suggested: findNearestGridValue(values[i], rule.AllowedScale),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// formatPixelValues formats spacing values as a comma-separated list of pixel sizes
+func formatPixelValues(values []int) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = fmt.Sprintf("%dpx", value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SpacingFix describes a spacing value that was snapped to the grid
+type SpacingFix struct {
+	ComponentID string `json:"component_id"`
+	Property    string `json:"property"`
+	From        int    `json:"from"`
+	To          int    `json:"to"`
+}
+
+// FixSpacing snaps all off-grid spacing values in the structure to their nearest grid value.
+// The structure is modified in place and the applied fixes are returned.
+func FixSpacing(structure *types.Structure, rule SpacingRule) []SpacingFix {
+	fixes := []SpacingFix{}
+
+	snap := func(componentID, property string, value *int) {
+		if *value > 0 && !isOnGrid(*value, rule.AllowedScale) {
+			suggested := findNearestGridValue(*value, rule.AllowedScale)
+			fixes = append(fixes, SpacingFix{
+				ComponentID: componentID,
+				Property:    property,
+				From:        *value,
+				To:          suggested,
+			})
+			*value = suggested
+		}
+	}
+
+	snap("layout", "spacing", &structure.Layout.Spacing)
+	snap("layout", "padding", &structure.Layout.Padding)
+
+	var fixComponent func(comp *types.Component)
+	fixComponent = func(comp *types.Component) {
+		snap(comp.ID, "padding", &comp.Layout.Padding)
+		snap(comp.ID, "gap", &comp.Layout.Gap)
+		snap(comp.ID, "row_gap", &comp.Layout.RowGap)
+		snap(comp.ID, "column_gap", &comp.Layout.ColumnGap)
+		snap(comp.ID, "margin_bottom", &comp.Layout.MarginBottom)
+
+		for i := range comp.Children {
+			fixComponent(&comp.Children[i])
+		}
+	}
+
+	for i := range structure.Components {
+		fixComponent(&structure.Components[i])
+	}
+
+	return fixes
+}
+
 // isOnGrid checks if a value is on the allowed spacing scale
 func isOnGrid(value int, allowedScale []int) bool {
 	for _, allowed := range allowedScale {
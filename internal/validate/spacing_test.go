@@ -1,6 +1,8 @@
 package validate
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -379,3 +381,183 @@ func TestValidateSpacing_ZeroValues(t *testing.T) {
 		t.Errorf("Expected validation to pass for zero spacing values, but got %d issues", len(result.Issues))
 	}
 }
+
+func TestFixSpacing(t *testing.T) {
+	structure := &types.Structure{
+		Layout: types.Layout{
+			Spacing: 15,
+			Padding: 24,
+		},
+		Components: []types.Component{
+			{
+				ID:   "container",
+				Type: "box",
+				Layout: types.ComponentLayout{
+					Padding: 18,
+					Gap:     16,
+				},
+				Children: []types.Component{
+					{
+						ID:   "item",
+						Type: "box",
+						Layout: types.ComponentLayout{
+							MarginBottom: 30,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fixes := FixSpacing(structure, DefaultSpacingRule())
+
+	if len(fixes) != 3 {
+		t.Fatalf("Expected 3 fixes, got %d", len(fixes))
+	}
+	if structure.Layout.Spacing != 16 {
+		t.Errorf("Expected layout spacing snapped to 16, got %d", structure.Layout.Spacing)
+	}
+	if structure.Components[0].Layout.Padding != 16 {
+		t.Errorf("Expected container padding snapped to 16, got %d", structure.Components[0].Layout.Padding)
+	}
+	if structure.Components[0].Children[0].Layout.MarginBottom != 32 {
+		t.Errorf("Expected item margin_bottom snapped to 32, got %d", structure.Components[0].Children[0].Layout.MarginBottom)
+	}
+
+	result := ValidateSpacing(structure, DefaultSpacingRule())
+	if !result.Passed {
+		t.Errorf("Expected fixed structure to pass spacing validation")
+	}
+}
+
+func TestValidateSpacing_TooManyDistinctValues(t *testing.T) {
+	// Ten distinct on-grid values: no off-grid warnings, but far from a tight scale
+	values := []int{4, 8, 12, 16, 24, 32, 48, 64, 96, 128}
+	children := make([]types.Component, len(values))
+	for i, value := range values {
+		children[i] = types.Component{ID: fmt.Sprintf("item-%d", i), Type: "box", Layout: types.ComponentLayout{Padding: value}}
+	}
+	structure := &types.Structure{
+		Layout:     types.Layout{Type: "stack", Spacing: 16},
+		Components: []types.Component{{ID: "root", Type: "box", Children: children}},
+	}
+
+	result := ValidateSpacing(structure, DefaultSpacingRule())
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Category == "off_grid" {
+			t.Errorf("Expected no off-grid issues, got: %s", issue.Message)
+		}
+		if issue.Category == "too_many_values" {
+			found = true
+			if !strings.Contains(issue.Message, "10 distinct spacing values") || !strings.Contains(issue.Message, "4px, 8px, 12px") {
+				t.Errorf("Expected message to list the distinct values, got: %s", issue.Message)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected a too_many_values warning")
+	}
+
+	// A compact scale is not flagged
+	rule := DefaultSpacingRule()
+	rule.MaxDistinctValues = 10
+	for _, issue := range ValidateSpacing(structure, rule).Issues {
+		if issue.Category == "too_many_values" {
+			t.Errorf("Expected no too_many_values issue at the limit, got: %s", issue.Message)
+		}
+	}
+}
+
+func TestValidateSpacing_NearDuplicateValues(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "a", Type: "box", Layout: types.ComponentLayout{Padding: 15}},
+			{ID: "b", Type: "box", Layout: types.ComponentLayout{Padding: 16}},
+			{ID: "c", Type: "box", Layout: types.ComponentLayout{Padding: 24}},
+		},
+	}
+
+	result := ValidateSpacing(structure, DefaultSpacingRule())
+
+	var nearDuplicates []SpacingIssue
+	for _, issue := range result.Issues {
+		if issue.Category == "near_duplicate" {
+			nearDuplicates = append(nearDuplicates, issue)
+		}
+	}
+	if len(nearDuplicates) != 1 {
+		t.Fatalf("Expected 1 near-duplicate issue, got %d", len(nearDuplicates))
+	}
+	if !strings.Contains(nearDuplicates[0].Message, "15px and 16px") {
+		t.Errorf("Unexpected message: %s", nearDuplicates[0].Message)
+	}
+}
+
+func TestValidateSpacing_RowAndColumnGap(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "grid", Type: "box", Layout: types.ComponentLayout{Display: "grid", RowGap: 24, ColumnGap: 10}},
+		},
+	}
+
+	result := ValidateSpacing(structure, DefaultSpacingRule())
+
+	if result.Passed {
+		t.Error("Expected validation to fail for an off-grid column gap")
+	}
+
+	var offGrid []SpacingIssue
+	for _, issue := range result.Issues {
+		if issue.Category == "off_grid" {
+			offGrid = append(offGrid, issue)
+		}
+	}
+	if len(offGrid) != 1 || offGrid[0].Property != "column_gap" || offGrid[0].Suggested != 8 {
+		t.Errorf("Expected a single column_gap issue suggesting 8px, got %+v", offGrid)
+	}
+
+	fixes := FixSpacing(structure, DefaultSpacingRule())
+	if len(fixes) != 1 || structure.Components[0].Layout.ColumnGap != 8 || structure.Components[0].Layout.RowGap != 24 {
+		t.Errorf("Expected FixSpacing to snap only column_gap, got %+v", fixes)
+	}
+}
+
+func TestValidateSpacing_ExcessiveOnGridHalfSteps(t *testing.T) {
+	// Six 4px values: all on the allowed scale, but over the half-step budget of 5
+	children := make([]types.Component, 6)
+	for i := range children {
+		children[i] = types.Component{ID: fmt.Sprintf("chip-%d", i), Type: "box", Layout: types.ComponentLayout{Padding: 4}}
+	}
+	structure := &types.Structure{
+		Layout:     types.Layout{Type: "stack", Spacing: 16},
+		Components: []types.Component{{ID: "chips", Type: "box", Children: children}},
+	}
+
+	result := ValidateSpacing(structure, DefaultSpacingRule())
+
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Category == "off_grid" {
+			t.Errorf("Expected 4px to be on-grid, got: %s", issue.Message)
+		}
+		if issue.Category == "excessive_half_step" {
+			found = true
+			if !strings.Contains(issue.Message, "6 occurrences") {
+				t.Errorf("Expected six half-steps to be counted, got: %s", issue.Message)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected an excessive_half_step warning for six 4px values")
+	}
+
+	// Five stays within the budget
+	structure.Components[0].Children = children[:5]
+	for _, issue := range ValidateSpacing(structure, DefaultSpacingRule()).Issues {
+		if issue.Category == "excessive_half_step" {
+			t.Errorf("Expected no warning at the limit, got: %s", issue.Message)
+		}
+	}
+}
@@ -97,12 +97,22 @@ func GenerateSuggestions(structure *types.Structure, category SuggestionCategory
 		}
 	}
 
+	// Keep each category in a stable order for reproducible output
+	for _, suggestions := range result.Categories {
+		sortSuggestions(suggestions)
+	}
+
 	return result
 }
 
 // analyzeFormPatterns provides suggestions for form components
 func analyzeFormPatterns(structure *types.Structure) []Suggestion {
 	var suggestions []Suggestion
+
+	// Forms nested anywhere in the tree need a way to submit them
+	for _, comp := range structure.Components {
+		checkFormSubmit(comp, &suggestions)
+	}
 	
 	formComponents := findComponentsByType(structure, "form", "input", "text_input", "select", "checkbox", "radio")
 	
@@ -205,6 +215,45 @@ func analyzeFormPatterns(structure *types.Structure) []Suggestion {
 	return suggestions
 }
 
+// checkFormSubmit flags form containers (role "form", or two or more inputs) with no
+// descendant button. Only the innermost container holding the inputs is treated as the
+// form, so a page-level button does not mask a form without a submit. It reports whether
+// comp or one of its descendants was treated as a form.
+func checkFormSubmit(comp types.Component, suggestions *[]Suggestion) bool {
+	nested := false
+	for _, child := range comp.Children {
+		if checkFormSubmit(child, suggestions) {
+			nested = true
+		}
+	}
+
+	descendants := getAllChildren(comp)
+	inputs := 0
+	hasButton := false
+	for _, child := range descendants {
+		if isInputField(child.Type) {
+			inputs++
+		}
+		if child.BaseType() == "button" {
+			hasButton = true
+		}
+	}
+
+	if comp.Role != "form" && (nested || inputs < 2) {
+		return nested
+	}
+
+	if !hasButton {
+		*suggestions = append(*suggestions, Suggestion{
+			Category:    "forms",
+			Type:        "suggestion",
+			Message:     fmt.Sprintf("Form '%s' has %d input(s) but no button. Add a submit button so users can complete it", comp.ID, inputs),
+			ComponentID: comp.ID,
+		})
+	}
+	return true
+}
+
 // analyzeNavigationPatterns provides suggestions for navigation components
 func analyzeNavigationPatterns(structure *types.Structure) []Suggestion {
 	var suggestions []Suggestion
@@ -510,15 +559,9 @@ func analyzeModalPatterns(structure *types.Structure) []Suggestion {
 
 	// Check for close button
 	hasCloseButton := false
-	for _, modal := range modals {
-		for _, child := range modal.Children {
-			if strings.Contains(strings.ToLower(child.ID), "close") ||
-			   strings.Contains(strings.ToLower(child.Type), "close") {
-				hasCloseButton = true
-				break
-			}
-		}
-		if hasCloseButton {
+	for i := range modals {
+		if hasCloseAffordance(&modals[i]) {
+			hasCloseButton = true
 			break
 		}
 	}
@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johanbellander/prism/internal/types"
+)
+
+// formSubmitSuggestions returns the forms suggestions that flag a missing submit button
+func formSubmitSuggestions(structure *types.Structure) []Suggestion {
+	var missing []Suggestion
+	for _, s := range analyzeFormPatterns(structure) {
+		if strings.Contains(s.Message, "no button") {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+func TestAnalyzeFormPatterns_FormWithoutButton(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				ID:   "page",
+				Type: "box",
+				Children: []types.Component{
+					{
+						ID:   "signup",
+						Type: "box",
+						Role: "form",
+						Children: []types.Component{
+							{ID: "email", Type: "input"},
+							{ID: "password", Type: "input"},
+						},
+					},
+					// A button elsewhere on the page does not submit the form
+					{ID: "help", Type: "button", Content: "Help"},
+				},
+			},
+		},
+	}
+
+	missing := formSubmitSuggestions(structure)
+	if len(missing) != 1 {
+		t.Fatalf("Expected 1 missing-submit suggestion, got %d: %+v", len(missing), missing)
+	}
+	if missing[0].ComponentID != "signup" || missing[0].Category != "forms" || missing[0].Type != "suggestion" {
+		t.Errorf("Unexpected suggestion: %+v", missing[0])
+	}
+}
+
+func TestAnalyzeFormPatterns_FormWithButton(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{
+				// No form role: two inputs are enough to treat the container as a form
+				ID:   "login",
+				Type: "box",
+				Children: []types.Component{
+					{ID: "email", Type: "input"},
+					{ID: "password", Type: "input"},
+					{
+						ID:       "actions",
+						Type:     "box",
+						Children: []types.Component{{ID: "submit", Type: "button", Content: "Log in"}},
+					},
+				},
+			},
+		},
+	}
+
+	if missing := formSubmitSuggestions(structure); len(missing) != 0 {
+		t.Errorf("Expected no missing-submit suggestion, got %+v", missing)
+	}
+}
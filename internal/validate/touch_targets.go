@@ -2,8 +2,8 @@ package validate
 
 import (
 	"fmt"
-	"strings"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -52,6 +52,15 @@ type ComponentPosition struct {
 
 // ValidateTouchTargets validates touch targets and spacing
 func ValidateTouchTargets(structure *types.Structure, rule TouchTargetRule) TouchTargetResult {
+	return ValidateTouchTargetsWithLayout(structure, nil, rule)
+}
+
+// ValidateTouchTargetsWithLayout validates touch targets, measuring the gaps between
+// interactive components from computed layout boxes (at scale 1) instead of positions
+// estimated from declared sizes. Targets closer than MinSpacing are easy to mis-tap.
+// Sizes are still checked against the declared dimensions. Components missing from
+// boxes keep their estimated position; nil boxes behave like ValidateTouchTargets.
+func ValidateTouchTargetsWithLayout(structure *types.Structure, boxes map[string]render.LayoutBox, rule TouchTargetRule) TouchTargetResult {
 	result := TouchTargetResult{
 		Passed: true,
 		Issues: []TouchTargetIssue{},
@@ -62,7 +71,7 @@ func ValidateTouchTargets(structure *types.Structure, rule TouchTargetRule) Touc
 	
 	var traverse func(comp *types.Component, offsetX, offsetY int)
 	traverse = func(comp *types.Component, offsetX, offsetY int) {
-		isInteractive := isInteractiveElement(comp)
+		isInteractive := comp.IsInteractive()
 		
 		if isInteractive {
 			width := comp.Layout.Width
@@ -76,7 +85,7 @@ func ValidateTouchTargets(structure *types.Structure, rule TouchTargetRule) Touc
 				height = 44 // Default to minimum touch target
 			}
 			
-			isDangerous := isDangerousAction(comp)
+			isDangerous := comp.IsDestructive()
 			
 			positions = append(positions, ComponentPosition{
 				ID:           comp.ID,
@@ -132,6 +141,14 @@ func ValidateTouchTargets(structure *types.Structure, rule TouchTargetRule) Touc
 		startY += structure.Components[i].Layout.Height + structure.Layout.Spacing
 	}
 	
+	// Measured boxes replace the estimated positions for the spacing check
+	for i := range positions {
+		if box, ok := boxes[positions[i].ID]; ok {
+			positions[i].X, positions[i].Y = box.X, box.Y
+			positions[i].Width, positions[i].Height = box.Width, box.Height
+		}
+	}
+
 	// Check spacing between interactive elements
 	for i := 0; i < len(positions); i++ {
 		for j := i + 1; j < len(positions); j++ {
@@ -207,32 +224,6 @@ func ValidateTouchTargets(structure *types.Structure, rule TouchTargetRule) Touc
 	return result
 }
 
-// isInteractiveElement checks if a component is interactive
-func isInteractiveElement(comp *types.Component) bool {
-	interactiveTypes := map[string]bool{
-		"button": true,
-		"input":  true,
-	}
-	
-	return interactiveTypes[comp.Type]
-}
-
-// isDangerousAction checks if a component represents a dangerous/destructive action
-func isDangerousAction(comp *types.Component) bool {
-	idLower := strings.ToLower(comp.ID)
-	roleLower := strings.ToLower(comp.Role)
-	
-	dangerousKeywords := []string{"delete", "remove", "destroy", "clear", "reset", "cancel"}
-	
-	for _, keyword := range dangerousKeywords {
-		if strings.Contains(idLower, keyword) || strings.Contains(roleLower, keyword) {
-			return true
-		}
-	}
-	
-	return false
-}
-
 // calculateSpacing calculates the minimum spacing between two components
 func calculateSpacing(pos1, pos2 ComponentPosition) int {
 	// Calculate edges
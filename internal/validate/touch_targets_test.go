@@ -1,9 +1,11 @@
 package validate
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/johanbellander/prism/internal/render"
 	"github.com/johanbellander/prism/internal/types"
 )
 
@@ -174,56 +176,48 @@ func TestValidateTouchTargets_ValidStructure(t *testing.T) {
 	}
 }
 
-func TestIsDangerousAction(t *testing.T) {
-	tests := []struct {
-		id       string
-		role     string
-		expected bool
-	}{
-		{"delete-btn", "", true},
-		{"remove-item", "", true},
-		{"cancel-action", "", true},
-		{"submit-form", "", false},
-		{"save-btn", "", false},
-		{"", "delete", true},
-		{"", "primary", false},
-	}
-
-	for _, test := range tests {
-		comp := &types.Component{
-			ID:   test.id,
-			Role: test.role,
-			Type: "button",
+func TestValidateTouchTargetsWithLayout_CrampedTargets(t *testing.T) {
+	structureWithGap := func(gap int) *types.Structure {
+		return &types.Structure{
+			Components: []types.Component{
+				{
+					ID:     "actions",
+					Type:   "box",
+					Layout: types.ComponentLayout{Direction: "horizontal", Gap: gap},
+					Children: []types.Component{
+						{ID: "save", Type: "button", Content: "Save"},
+						{ID: "share", Type: "button", Content: "Share"},
+					},
+				},
+			},
 		}
+	}
 
-		result := isDangerousAction(comp)
-		if result != test.expected {
-			t.Errorf("isDangerousAction(%s, %s) = %v, expected %v", test.id, test.role, result, test.expected)
-		}
+	cramped := structureWithGap(2)
+	boxes, err := render.NewLayoutEngine(1).CalculateLayout(cramped, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
 	}
-}
 
-func TestIsInteractiveElement(t *testing.T) {
-	tests := []struct {
-		compType string
-		expected bool
-	}{
-		{"button", true},
-		{"input", true},
-		{"text", false},
-		{"box", false},
-		{"image", false},
-	}
-
-	for _, test := range tests {
-		comp := &types.Component{
-			ID:   "test",
-			Type: test.compType,
+	result := ValidateTouchTargetsWithLayout(cramped, boxes, DefaultTouchTargetRule())
+	found := false
+	for _, issue := range result.Issues {
+		if issue.Component == "save" && issue.Severity == "warning" && strings.Contains(issue.Message, "only 2px from 'share'") {
+			found = true
 		}
+	}
+	if !found {
+		t.Errorf("Expected buttons 2px apart to be flagged, got: %v", result.Issues)
+	}
 
-		result := isInteractiveElement(comp)
-		if result != test.expected {
-			t.Errorf("isInteractiveElement(%s) = %v, expected %v", test.compType, result, test.expected)
+	spaced := structureWithGap(16)
+	boxes, err = render.NewLayoutEngine(1).CalculateLayout(spaced, 1200, 0)
+	if err != nil {
+		t.Fatalf("CalculateLayout failed: %v", err)
+	}
+	for _, issue := range ValidateTouchTargetsWithLayout(spaced, boxes, DefaultTouchTargetRule()).Issues {
+		if strings.HasPrefix(issue.Message, "Spacing:") {
+			t.Errorf("Buttons 16px apart should not be flagged: %s", issue.Message)
 		}
 	}
 }
@@ -3,6 +3,7 @@ package validate
 import (
 	"fmt"
 	"math"
+	"sort"
 
 	"github.com/johanbellander/prism/internal/types"
 )
@@ -13,6 +14,9 @@ type TypographyRule struct {
 	BaseSize   float64            // base font size in pixels
 	Sizes      map[string]float64 // expected sizes for each scale level
 	Tolerance  float64            // acceptable deviation (e.g., 0.5px)
+
+	MaxSkippedSteps     int // most scale steps that may be skipped between adjacent used sizes
+	SingleSizeThreshold int // warn when at least this many text elements all share one size (0 disables)
 }
 
 // TypographyIssue represents a typography validation issue
@@ -48,6 +52,9 @@ func DefaultTypographyRule() TypographyRule {
 			"4xl":  49,  // 16 * 1.25^5 ≈ 48.83 → 49
 		},
 		Tolerance: 0.5, // Allow 0.5px deviation for rounding
+
+		MaxSkippedSteps:     2,
+		SingleSizeThreshold: 6,
 	}
 }
 
@@ -74,10 +81,66 @@ func ValidateTypography(structure *types.Structure, rule TypographyRule) Typogra
 
 	// Validate all components recursively
 	validateComponentTypography(structure.Components, rule, &result)
+	validateTypeRamp(structure.Components, rule, &result)
 
 	return result
 }
 
+// validateTypeRamp checks that the sizes in use form a coherent subset of the scale:
+// no large jumps between adjacent used sizes (xs straight to 4xl) and more than one
+// size when there is a lot of text. Text without a size renders at base.
+func validateTypeRamp(components []types.Component, rule TypographyRule, result *TypographyResult) {
+	firstUse := map[string]string{} // size token -> first component using it
+	textCount := 0
+	var collect func(components []types.Component)
+	collect = func(components []types.Component) {
+		for _, comp := range components {
+			if comp.Type == "text" {
+				size := comp.Size
+				if size == "" {
+					size = "base"
+				}
+				if _, ok := rule.Sizes[size]; ok {
+					textCount++
+					if _, seen := firstUse[size]; !seen {
+						firstUse[size] = comp.ID
+					}
+				}
+			}
+			collect(comp.Children)
+		}
+	}
+	collect(components)
+
+	// Walk the scale from smallest to largest, measuring the steps between used sizes
+	scale := getValidSizeTokens(rule)
+	previous, previousStep := "", -1
+	for step, token := range scale {
+		if _, used := firstUse[token]; !used {
+			continue
+		}
+		if previousStep >= 0 {
+			if skipped := step - previousStep - 1; skipped > rule.MaxSkippedSteps {
+				result.Passed = false
+				result.Issues = append(result.Issues, TypographyIssue{
+					ComponentID: firstUse[token],
+					Message:     fmt.Sprintf("Typography: the type ramp jumps from '%s' to '%s', skipping %d sizes (%v) - add intermediate sizes or bring them closer", previous, token, skipped, scale[previousStep+1:step]),
+					Severity:    "warning",
+				})
+			}
+		}
+		previous, previousStep = token, step
+	}
+
+	if rule.SingleSizeThreshold > 0 && len(firstUse) == 1 && textCount >= rule.SingleSizeThreshold {
+		result.Passed = false
+		result.Issues = append(result.Issues, TypographyIssue{
+			Message:  fmt.Sprintf("Typography: all %d text elements use size '%s' - vary sizes to establish a hierarchy", textCount, previous),
+			Severity: "warning",
+		})
+	}
+}
+
 func validateComponentTypography(components []types.Component, rule TypographyRule, result *TypographyResult) {
 	for _, comp := range components {
 		// Only validate text components
@@ -153,5 +216,12 @@ func getValidSizeTokens(rule TypographyRule) []string {
 	for token := range rule.Sizes {
 		tokens = append(tokens, token)
 	}
+	// List tokens from smallest to largest so messages are stable
+	sort.Slice(tokens, func(i, j int) bool {
+		if rule.Sizes[tokens[i]] != rule.Sizes[tokens[j]] {
+			return rule.Sizes[tokens[i]] < rule.Sizes[tokens[j]]
+		}
+		return tokens[i] < tokens[j]
+	})
 	return tokens
 }
@@ -1,6 +1,8 @@
 package validate
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/johanbellander/prism/internal/types"
@@ -362,3 +364,61 @@ func TestGetScaleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateTypography_ScaleGap(t *testing.T) {
+	structure := &types.Structure{
+		Components: []types.Component{
+			{ID: "caption", Type: "text", Size: "xs"},
+			{ID: "hero", Type: "text", Size: "4xl"},
+		},
+	}
+
+	result := ValidateTypography(structure, DefaultTypographyRule())
+	if result.Passed {
+		t.Error("Expected a jump from xs to 4xl to fail")
+	}
+	found := false
+	for _, issue := range result.Issues {
+		if issue.ComponentID == "hero" && issue.Severity == "warning" && strings.Contains(issue.Message, "jumps from 'xs' to '4xl', skipping 7 sizes") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected a type ramp gap warning on 'hero', got: %v", result.Issues)
+	}
+
+	// Filling in the ramp removes the gap
+	structure.Components = append(structure.Components,
+		types.Component{ID: "body", Type: "text", Size: "base"},
+		types.Component{ID: "section", Type: "text", Size: "xl"},
+		types.Component{ID: "title", Type: "text", Size: "2xl"},
+	)
+	if result := ValidateTypography(structure, DefaultTypographyRule()); !result.Passed {
+		t.Errorf("Expected a contiguous ramp to pass, got: %v", result.Issues)
+	}
+}
+
+func TestValidateTypography_SingleSize(t *testing.T) {
+	structure := &types.Structure{}
+	for i := 0; i < 6; i++ {
+		// Text without a size renders at base
+		structure.Components = append(structure.Components, types.Component{ID: fmt.Sprintf("line-%d", i), Type: "text"})
+	}
+
+	result := ValidateTypography(structure, DefaultTypographyRule())
+	if result.Passed || len(result.Issues) != 1 || !strings.Contains(result.Issues[0].Message, "all 6 text elements use size 'base'") {
+		t.Errorf("Expected a single-size warning, got: %v", result.Issues)
+	}
+
+	// A few text elements of one size are fine
+	structure.Components = structure.Components[:5]
+	if result := ValidateTypography(structure, DefaultTypographyRule()); !result.Passed {
+		t.Errorf("Expected 5 text elements of one size to pass, got: %v", result.Issues)
+	}
+
+	// A second size establishes a hierarchy
+	structure.Components = append(structure.Components, types.Component{ID: "title", Type: "text", Size: "xl"})
+	if result := ValidateTypography(structure, DefaultTypographyRule()); !result.Passed {
+		t.Errorf("Expected two sizes to pass, got: %v", result.Issues)
+	}
+}
@@ -0,0 +1,65 @@
+package prism_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/johanbellander/prism/pkg/prism"
+)
+
+const exampleJSON = `{
+  "version": "v1",
+  "phase": "structure",
+  "intent": {"purpose": "Sign in", "primary_action": "submit"},
+  "layout": {"type": "stack", "spacing": 16, "padding": 24},
+  "components": [
+    {"id": "title", "type": "text", "size": "2xl", "content": "Welcome back"},
+    {"id": "email", "type": "input", "role": "email", "content": "Email", "layout": {"height": 44}},
+    {"id": "submit", "type": "button", "content": "Sign in", "layout": {"width": 160, "height": 44}}
+  ]
+}`
+
+func ExampleParseAndValidateStructure() {
+	structure, err := prism.ParseAndValidateStructure([]byte(exampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(structure.Version, len(structure.Components))
+	// Output: v1 3
+}
+
+func ExampleParseAndValidateStructure_invalid() {
+	_, err := prism.ParseAndValidateStructure([]byte(`{"version": "v1", "phase": "design"}`))
+	fmt.Println(err)
+	// Output: validation failed: invalid phase: expected 'structure', got 'design'
+}
+
+func ExampleRender() {
+	structure, err := prism.ParseAndValidateStructure([]byte(exampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := prism.Render(structure, prism.RenderOptions{Width: 400, Height: 300, Scale: 2})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// result.SavePNG("sign-in.png") writes the image to disk
+	fmt.Println(result.Width, result.Height)
+	// Output: 800 600
+}
+
+func ExampleRunFullAudit() {
+	structure, err := prism.ParseAndValidateStructure([]byte(exampleJSON))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	report := prism.RunFullAudit(structure)
+	touchTargets, _ := report.Entry("touch_targets")
+
+	fmt.Println(len(report.Entries), touchTargets.Name)
+	// Output: 13 touch_targets
+}
@@ -0,0 +1,119 @@
+// Package prism is the public Go API for PRISM. It lets other programs parse
+// Phase 1 structures, render them to images and run the design audit without
+// going through the CLI.
+//
+// The types are aliases of PRISM's internal types, so values returned here can
+// be used with every function in this package.
+package prism
+
+import (
+	"io"
+
+	"github.com/johanbellander/prism/internal/render"
+	"github.com/johanbellander/prism/internal/types"
+	"github.com/johanbellander/prism/internal/validate"
+)
+
+// Structure is a complete Phase 1 structure document
+type Structure = types.Structure
+
+// Component is a single UI component within a structure
+type Component = types.Component
+
+// RenderOptions controls canvas size, scale and overlays when rendering
+type RenderOptions = render.RenderOptions
+
+// RenderResult holds a rendered image and any layout notes produced while rendering
+type RenderResult = render.RenderResult
+
+// Flow is an ordered list of screens read from a project's flow.json
+type Flow = types.Flow
+
+// FlowStep is one labeled screen passed to RenderFlow
+type FlowStep = render.FlowStep
+
+// FlowResult holds a composed flow diagram and each screen's render
+type FlowResult = render.FlowResult
+
+// AuditReport is the scored result of running every validator on a structure
+type AuditReport = validate.AuditReport
+
+// AuditEntry is the outcome of a single validator within an audit
+type AuditEntry = validate.AuditEntry
+
+// AuditIssue is a validator issue reduced to severity, message and component
+type AuditIssue = validate.AuditIssue
+
+// AuditRules holds the spacing, typography and choice overload rules used by an audit
+type AuditRules = validate.AuditRules
+
+// ValidatorInfo describes a validator's flag, phase and description
+type ValidatorInfo = validate.ValidatorInfo
+
+// PaletteRule lists the colors a Phase 2 design may use when it declares no palette itself
+type PaletteRule = validate.PaletteRule
+
+// PaletteResult is the outcome of palette adherence validation
+type PaletteResult = validate.PaletteResult
+
+// ParseStructure parses structure JSON without checking Phase 1 constraints
+func ParseStructure(data []byte) (*Structure, error) {
+	return types.ParseStructure(data)
+}
+
+// ParseAndValidateStructure parses structure JSON and checks it against the Phase 1 constraints
+func ParseAndValidateStructure(data []byte) (*Structure, error) {
+	return types.ParseAndValidateStructure(data)
+}
+
+// JSONSchema returns the JSON Schema (draft-07) describing structure files
+func JSONSchema() []byte {
+	return types.JSONSchema()
+}
+
+// Render renders a structure to an image. Zero-valued options fall back to a
+// 1200px-wide desktop canvas at 1x scale with auto height.
+func Render(structure *Structure, opts RenderOptions) (*RenderResult, error) {
+	return render.NewRenderer(opts).Render(structure)
+}
+
+// ParseFlow parses flow.json, defaulting each screen's name to its file name
+func ParseFlow(data []byte) (*Flow, error) {
+	return types.ParseFlow(data)
+}
+
+// RenderFlow renders each screen and composes them left to right with arrows between them
+func RenderFlow(steps []FlowStep, opts RenderOptions) (*FlowResult, error) {
+	return render.RenderFlow(steps, opts)
+}
+
+// RunFullAudit runs all validators with their default rules and scores each one
+func RunFullAudit(structure *Structure) AuditReport {
+	return validate.RunAudit(structure)
+}
+
+// DefaultAuditRules returns the rules RunFullAudit uses
+func DefaultAuditRules() AuditRules {
+	return validate.DefaultAuditRules()
+}
+
+// RunAudit runs all validators, using the given rules for the configurable ones
+func RunAudit(structure *Structure, rules AuditRules) AuditReport {
+	return validate.RunAuditWithRules(structure, rules)
+}
+
+// Validators lists every validator, audit validators first in run order
+func Validators() []ValidatorInfo {
+	return validate.Validators()
+}
+
+// WriteJUnit writes an audit report as JUnit XML, one test case per validator, so
+// CI systems can show design audits alongside unit tests
+func WriteJUnit(w io.Writer, suiteName string, report AuditReport) error {
+	return validate.WriteJUnit(w, suiteName, report)
+}
+
+// ValidatePalette checks a Phase 2 design's colors and backgrounds against its declared palette
+func ValidatePalette(structure *Structure, rule PaletteRule) PaletteResult {
+	return validate.ValidatePalette(structure, rule)
+}